@@ -27,10 +27,12 @@ var (
 	fsDir      string
 	wwwDir     string
 	dbURI      string
+
+	searchService bool
 )
 
 func init() {
-	CMD.AddCommand(VersionCMD, MonolithicCMD, ServiceCMD)
+	CMD.AddCommand(VersionCMD, MonolithicCMD, ServiceCMD, IndexCMD)
 }
 
 var CMD = &cobra.Command{
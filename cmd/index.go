@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/omecodes/store/common"
+	"github.com/spf13/cobra"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var (
+	serverURL      string
+	appAuth        string
+	adminPassword  string
+	collection     string
+	repairIndex    bool
+	indexBatchSize int
+)
+
+func init() {
+	IndexCMD.AddCommand(checkIndexCMD)
+
+	flags := checkIndexCMD.PersistentFlags()
+	flags.StringVar(&serverURL, "server", "http://localhost:8080", "Store server base URL")
+	flags.StringVar(&appAuth, "app", "", "Admin client app credentials as key:secret")
+	flags.StringVar(&adminPassword, "admin", "", "Admin user password")
+	flags.StringVar(&collection, "collection", "", "Collection whose index is checked")
+	flags.BoolVar(&repairIndex, "repair", false, "Repair the differences found")
+	flags.IntVar(&indexBatchSize, "batch-size", 0, "Number of ids checked at once")
+
+	for _, name := range []string{"app", "admin", "collection"} {
+		err := cobra.MarkFlagRequired(flags, name)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(-1)
+		}
+	}
+}
+
+var IndexCMD = &cobra.Command{
+	Use:   "index",
+	Short: "Manage the search index of a running store",
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := cmd.Help(); err != nil {
+			fmt.Println(err)
+		}
+	},
+}
+
+var checkIndexCMD = &cobra.Command{
+	Use:   "check",
+	Short: "Checks that the search index of a collection matches its objects",
+	Run: func(cmd *cobra.Command, args []string) {
+		params := url.Values{}
+		if repairIndex {
+			params.Set("repair", "true")
+		}
+		if indexBatchSize > 0 {
+			params.Set("batch_size", strconv.Itoa(indexBatchSize))
+		}
+
+		route := strings.Replace(common.ApiCheckIndexRoute, "{id}", url.PathEscape(collection), 1)
+		endpoint := strings.TrimSuffix(serverURL, "/") + common.ApiDefaultLocation + route
+		if len(params) > 0 {
+			endpoint += "?" + params.Encode()
+		}
+
+		req, err := http.NewRequest(http.MethodPost, endpoint, nil)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(-1)
+		}
+		req.Header.Set(common.HttpHeaderAppAuthorization, "Basic "+base64.StdEncoding.EncodeToString([]byte(appAuth)))
+		req.SetBasicAuth("admin", adminPassword)
+
+		rsp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(-1)
+		}
+		defer func() {
+			_ = rsp.Body.Close()
+		}()
+
+		body, err := ioutil.ReadAll(rsp.Body)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(-1)
+		}
+
+		if rsp.StatusCode != http.StatusOK {
+			fmt.Println("index check failed:", rsp.Status)
+			os.Exit(-1)
+		}
+
+		var out bytes.Buffer
+		if err = json.Indent(&out, body, "", "  "); err != nil {
+			fmt.Println(string(body))
+			return
+		}
+		fmt.Println(out.String())
+	},
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	ServiceCMD.AddCommand(frontServiceCMD, aclServiceCMD, accessServiceCMD, sourcesServiceCMD, filesServiceCMD, objectsServiceCMD)
+	ServiceCMD.AddCommand(frontServiceCMD, aclServiceCMD, accessServiceCMD, sourcesServiceCMD, filesServiceCMD, objectsServiceCMD, searchServiceCMD)
 }
 
 var ServiceCMD = &cobra.Command{
@@ -158,12 +158,21 @@ func init() {
 		os.Exit(-1)
 	}
 
+	flags.BoolVar(&searchService, "search-service", false, "Index and search objects with the registered search engine services")
+
 	flags = sourcesServiceCMD.PersistentFlags()
 	err = parseServiceFlags(flags, true)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(-1)
 	}
+
+	flags = searchServiceCMD.PersistentFlags()
+	err = parseServiceFlags(flags, true)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(-1)
+	}
 }
 
 var aclServiceCMD = &cobra.Command{
@@ -285,6 +294,7 @@ var objectsServiceCMD = &cobra.Command{
 			CACertFilename:  caCert,
 			RegistryAddress: registryAddress,
 			Database:        dbURI,
+			SearchService:   searchService,
 		}
 
 		fs := service.NewObjects(config)
@@ -294,7 +304,37 @@ var objectsServiceCMD = &cobra.Command{
 			os.Exit(-1)
 		}
 
-		//defer as.Stop()
+		defer func() {
+			if err := fs.Stop(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+		<-prompt.QuitSignal()
+	},
+}
+
+var searchServiceCMD = &cobra.Command{
+	Use:   "search",
+	Short: "Runs search engine service",
+	Run: func(cmd *cobra.Command, args []string) {
+		config := service.SearchConfig{
+			Name:            name,
+			Domain:          domains[0],
+			IP:              ip,
+			CAAddress:       caAddress,
+			CASecret:        caSecret,
+			CACertFilename:  caCert,
+			RegistryAddress: registryAddress,
+			Database:        dbURI,
+		}
+
+		ss := service.NewSearch(config)
+		err := ss.Start()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(-1)
+		}
+
 		<-prompt.QuitSignal()
 	},
 }
@@ -12,6 +12,10 @@ const (
 	HttpHeaderAccessControlAllowOrigin = "Access-Control-Allow-Origin"
 	HttpHeaderUserAuthorization        = "Authorization"
 	HttpHeaderAppAuthorization         = "X-STORE-CLIENT-APP-AUTHENTICATION"
+
+	HttpHeaderWebhookEvent     = "X-STORE-EVENT"
+	HttpHeaderWebhookDelivery  = "X-STORE-DELIVERY"
+	HttpHeaderWebhookSignature = "X-STORE-SIGNATURE"
 )
 
 const (
@@ -26,9 +30,15 @@ const (
 	ApiAuthRoutePrefix     = "/api/auth"
 	ApiAccountsRoutePrefix = "/api/accounts"
 	ApiSettingsRoutePrefix = "/api/settings"
+	ApiWebhooksRoutePrefix = "/api/webhooks"
 
 	ApiParamName = "name"
 
+	ApiParamHook   = "hook"
+	ApiParamStatus = "status"
+	ApiParamOffset = "offset"
+	ApiParamCount  = "count"
+
 	ApiParamUsername = "username"
 	ApiParamPassword = "password"
 
@@ -40,6 +50,10 @@ const (
 
 	ApiRouteVarCollectionName = "collection"
 
+	ApiRouteVarObjectName = "object"
+
+	ApiRouteVarTokenName = "token"
+
 	// API Routes
 	//
 
@@ -69,6 +83,12 @@ const (
 	ApiListCollectionRoute   = "/objects/collections"
 	ApiGetCollectionRoute    = "/objects/collections/{id}"
 	ApiDeleteCollectionRoute = "/objects/collections/{id}"
+	ApiDryRunWriteRulesRoute = "/objects/collections/{id}/rules/dry-run"
+	ApiCheckIndexRoute       = "/objects/collections/{id}/index/check"
+	ApiObjectTokensRoute     = "/objects/collections/{id}/index/objects/{object}"
+	ApiTokenDocumentsRoute   = "/objects/collections/{id}/index/tokens/{token}"
+	ApiAnalyzeRoute          = "/objects/collections/{id}/index/analyze"
+	ApiExplainSearchRoute    = "/objects/collections/{id}/index/explain"
 	ApiPutObjectRoute        = "/objects/data/{collection}"
 	ApiPatchObjectRoute      = "/objects/data/{collection}/{id}"
 	ApiMoveObjectRoute       = "/objects/data/{collection}/{id}"
@@ -77,6 +97,13 @@ const (
 	ApiListObjectsRoute      = "/objects/data/{collection}"
 	ApiSearchObjectsRoute    = "/objects/data/{collection}"
 
+	ApiSearchCollectionsRoute = "/objects/search"
+	ApiSuggestRoute           = "/objects/suggestions/{collection}"
+
+	ApiSaveSynonymSetRoute   = "/objects/synonyms"
+	ApiListSynonymSetsRoute  = "/objects/synonyms"
+	ApiDeleteSynonymSetRoute = "/objects/synonyms/{id}"
+
 	ApiCreateFileAccess          = "/files/accesses"
 	ApiListFileAccesses          = "/files/accesses"
 	ApiGetFileAccess             = "/files/accesses/{id}"
@@ -84,4 +111,12 @@ const (
 	ApiFileTreeRoutePrefix       = "/files/tree"
 	ApiFileAttributesRoutePrefix = "/files/attributes"
 	ApiFileDataRoutePrefix       = "/files/data"
+
+	ApiSaveWebhookRoute     = "/webhooks/hooks"
+	ApiListWebhooksRoute    = "/webhooks/hooks"
+	ApiGetWebhookRoute      = "/webhooks/hooks/{id}"
+	ApiDeleteWebhookRoute   = "/webhooks/hooks/{id}"
+	ApiListDeliveriesRoute  = "/webhooks/deliveries"
+	ApiListDeadLettersRoute = "/webhooks/dead-letters"
+	ApiRetryDeliveryRoute   = "/webhooks/deliveries/{id}/retry"
 )
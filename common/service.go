@@ -6,6 +6,7 @@ const (
 	ServiceTypeFileSources    = 3
 	ServiceTypeObjectsStorage = 4
 	ServiceTypeFilesStorage   = 5
+	ServiceTypeSearchEngine   = 6
 )
 
 const (
@@ -0,0 +1,63 @@
+package files
+
+import (
+	"context"
+	"github.com/omecodes/store/webhooks"
+	"io"
+	"path"
+)
+
+// EventsHandler notifies registered webhooks of successful file mutations
+type EventsHandler struct {
+	BaseHandler
+}
+
+func (h *EventsHandler) WriteFileContent(ctx context.Context, accessID string, filename string, content io.Reader, size int64, opts WriteOptions) error {
+	err := h.next.WriteFileContent(ctx, accessID, filename, content, size, opts)
+	if err == nil {
+		webhooks.Notify(ctx, &webhooks.Event{
+			Type:     webhooks.EventFileWrite,
+			AccessID: accessID,
+			Filename: filename,
+		})
+	}
+	return err
+}
+
+func (h *EventsHandler) DeleteFile(ctx context.Context, accessID string, filename string, opts DeleteFileOptions) error {
+	err := h.next.DeleteFile(ctx, accessID, filename, opts)
+	if err == nil {
+		webhooks.Notify(ctx, &webhooks.Event{
+			Type:     webhooks.EventFileDelete,
+			AccessID: accessID,
+			Filename: filename,
+		})
+	}
+	return err
+}
+
+func (h *EventsHandler) RenameFile(ctx context.Context, accessID string, filename string, newName string, opts RenameFileOptions) error {
+	err := h.next.RenameFile(ctx, accessID, filename, newName, opts)
+	if err == nil {
+		webhooks.Notify(ctx, &webhooks.Event{
+			Type:     webhooks.EventFileMove,
+			AccessID: accessID,
+			Filename: filename,
+			Target:   path.Join(path.Dir(filename), newName),
+		})
+	}
+	return err
+}
+
+func (h *EventsHandler) MoveFile(ctx context.Context, accessID string, filename string, dirname string, opts MoveFileOptions) error {
+	err := h.next.MoveFile(ctx, accessID, filename, dirname, opts)
+	if err == nil {
+		webhooks.Notify(ctx, &webhooks.Event{
+			Type:     webhooks.EventFileMove,
+			AccessID: accessID,
+			Filename: filename,
+			Target:   path.Join(dirname, path.Base(filename)),
+		})
+	}
+	return err
+}
@@ -39,6 +39,12 @@ func getRoute(opts ...RouteOption) (handler Handler) {
 
 	handler = &ExecHandler{}
 
+	if !routes.skipEvents {
+		handler = &EventsHandler{BaseHandler: BaseHandler{
+			next: handler,
+		}}
+	}
+
 	if !routes.skipEncryption {
 		handler = &EncryptionHandler{BaseHandler: BaseHandler{
 			next: handler,
@@ -63,6 +69,7 @@ type routesOptions struct {
 	skipPolicies   bool
 	skipParams     bool
 	skipEncryption bool
+	skipEvents     bool
 }
 
 type RouteOption func(*routesOptions)
@@ -25,6 +25,106 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// IndexStore selects where the search index of a collection is kept: in tables of the objects database, or in
+// segment files on the local disk
+type IndexStore int32
+
+const (
+	IndexStore_SQL  IndexStore = 0
+	IndexStore_Disk IndexStore = 1
+)
+
+// Enum value maps for IndexStore.
+var (
+	IndexStore_name = map[int32]string{
+		0: "SQL",
+		1: "Disk",
+	}
+	IndexStore_value = map[string]int32{
+		"SQL":  0,
+		"Disk": 1,
+	}
+)
+
+func (x IndexStore) Enum() *IndexStore {
+	p := new(IndexStore)
+	*p = x
+	return p
+}
+
+func (x IndexStore) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IndexStore) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_objects_proto_enumTypes[0].Descriptor()
+}
+
+func (IndexStore) Type() protoreflect.EnumType {
+	return &file_proto_objects_proto_enumTypes[0]
+}
+
+func (x IndexStore) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IndexStore.Descriptor instead.
+func (IndexStore) EnumDescriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{0}
+}
+
+type WriteRuleAction int32
+
+const (
+	WriteRuleAction_Unspecified WriteRuleAction = 0
+	WriteRuleAction_Reject      WriteRuleAction = 1
+	WriteRuleAction_SetDefault  WriteRuleAction = 2
+	WriteRuleAction_Compute     WriteRuleAction = 3
+)
+
+// Enum value maps for WriteRuleAction.
+var (
+	WriteRuleAction_name = map[int32]string{
+		0: "Unspecified",
+		1: "Reject",
+		2: "SetDefault",
+		3: "Compute",
+	}
+	WriteRuleAction_value = map[string]int32{
+		"Unspecified": 0,
+		"Reject":      1,
+		"SetDefault":  2,
+		"Compute":     3,
+	}
+)
+
+func (x WriteRuleAction) Enum() *WriteRuleAction {
+	p := new(WriteRuleAction)
+	*p = x
+	return p
+}
+
+func (x WriteRuleAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WriteRuleAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_objects_proto_enumTypes[1].Descriptor()
+}
+
+func (WriteRuleAction) Type() protoreflect.EnumType {
+	return &file_proto_objects_proto_enumTypes[1]
+}
+
+func (x WriteRuleAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WriteRuleAction.Descriptor instead.
+func (WriteRuleAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{1}
+}
+
 type Collection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -38,6 +138,9 @@ type Collection struct {
 	FieldsIndex           *PropertiesIndex `protobuf:"bytes,6,opt,name=fields_index,json=fieldsIndex,proto3" json:"fields_index,omitempty"`
 	ActionAuthorizedUsers *PathAccessRules `protobuf:"bytes,7,opt,name=action_authorized_users,json=actionAuthorizedUsers,proto3" json:"action_authorized_users,omitempty"`
 	AclConfig             *ACLConfig       `protobuf:"bytes,8,opt,name=acl_config,json=aclConfig,proto3" json:"acl_config,omitempty"`
+	WriteRules            []*WriteRule     `protobuf:"bytes,9,rep,name=write_rules,json=writeRules,proto3" json:"write_rules,omitempty"`
+	IndexStore            IndexStore       `protobuf:"varint,10,opt,name=index_store,json=indexStore,proto3,enum=IndexStore" json:"index_store,omitempty"`
+	GeoIndex              *GeoIndex        `protobuf:"bytes,11,opt,name=geo_index,json=geoIndex,proto3" json:"geo_index,omitempty"`
 }
 
 func (x *Collection) Reset() {
@@ -128,6 +231,116 @@ func (x *Collection) GetAclConfig() *ACLConfig {
 	return nil
 }
 
+func (x *Collection) GetWriteRules() []*WriteRule {
+	if x != nil {
+		return x.WriteRules
+	}
+	return nil
+}
+
+func (x *Collection) GetIndexStore() IndexStore {
+	if x != nil {
+		return x.IndexStore
+	}
+	return IndexStore_SQL
+}
+
+func (x *Collection) GetGeoIndex() *GeoIndex {
+	if x != nil {
+		return x.GeoIndex
+	}
+	return nil
+}
+
+// WriteRule is evaluated against objects before they are saved in a collection.
+// condition and expression are gval expressions in which $ refers to the object being written
+type WriteRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Action     WriteRuleAction `protobuf:"varint,2,opt,name=action,proto3,enum=WriteRuleAction" json:"action,omitempty"`
+	Condition  string          `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	Path       string          `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Expression string          `protobuf:"bytes,5,opt,name=expression,proto3" json:"expression,omitempty"`
+	Message    string          `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *WriteRule) Reset() {
+	*x = WriteRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_objects_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteRule) ProtoMessage() {}
+
+func (x *WriteRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_objects_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteRule.ProtoReflect.Descriptor instead.
+func (*WriteRule) Descriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WriteRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WriteRule) GetAction() WriteRuleAction {
+	if x != nil {
+		return x.Action
+	}
+	return WriteRuleAction_Unspecified
+}
+
+func (x *WriteRule) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *WriteRule) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *WriteRule) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *WriteRule) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type ACLConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -140,7 +353,7 @@ type ACLConfig struct {
 func (x *ACLConfig) Reset() {
 	*x = ACLConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[1]
+		mi := &file_proto_objects_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -153,7 +366,7 @@ func (x *ACLConfig) String() string {
 func (*ACLConfig) ProtoMessage() {}
 
 func (x *ACLConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[1]
+	mi := &file_proto_objects_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,7 +379,7 @@ func (x *ACLConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ACLConfig.ProtoReflect.Descriptor instead.
 func (*ACLConfig) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{1}
+	return file_proto_objects_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ACLConfig) GetNamespace() string {
@@ -196,7 +409,7 @@ type ObjectActionsUsers struct {
 func (x *ObjectActionsUsers) Reset() {
 	*x = ObjectActionsUsers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[2]
+		mi := &file_proto_objects_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -209,7 +422,7 @@ func (x *ObjectActionsUsers) String() string {
 func (*ObjectActionsUsers) ProtoMessage() {}
 
 func (x *ObjectActionsUsers) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[2]
+	mi := &file_proto_objects_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -222,7 +435,7 @@ func (x *ObjectActionsUsers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectActionsUsers.ProtoReflect.Descriptor instead.
 func (*ObjectActionsUsers) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{2}
+	return file_proto_objects_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ObjectActionsUsers) GetView() *SubjectSet {
@@ -257,7 +470,7 @@ type PathAccessRules struct {
 func (x *PathAccessRules) Reset() {
 	*x = PathAccessRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[3]
+		mi := &file_proto_objects_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -270,7 +483,7 @@ func (x *PathAccessRules) String() string {
 func (*PathAccessRules) ProtoMessage() {}
 
 func (x *PathAccessRules) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[3]
+	mi := &file_proto_objects_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,7 +496,7 @@ func (x *PathAccessRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PathAccessRules.ProtoReflect.Descriptor instead.
 func (*PathAccessRules) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{3}
+	return file_proto_objects_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PathAccessRules) GetAccessRules() map[string]*ObjectActionsUsers {
@@ -308,7 +521,7 @@ type AccessRules struct {
 func (x *AccessRules) Reset() {
 	*x = AccessRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[4]
+		mi := &file_proto_objects_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -321,7 +534,7 @@ func (x *AccessRules) String() string {
 func (*AccessRules) ProtoMessage() {}
 
 func (x *AccessRules) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[4]
+	mi := &file_proto_objects_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,7 +547,7 @@ func (x *AccessRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessRules.ProtoReflect.Descriptor instead.
 func (*AccessRules) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{4}
+	return file_proto_objects_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AccessRules) GetLabel() string {
@@ -382,12 +595,15 @@ type Header struct {
 	CreatedAt                     int64                          `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Size                          int64                          `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
 	ActionAuthorizedUsersForPaths map[string]*ObjectActionsUsers `protobuf:"bytes,5,rep,name=action_authorized_users_for_paths,json=actionAuthorizedUsersForPaths,proto3" json:"action_authorized_users_for_paths,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	UpdatedBy                     string                         `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt                     int64                          `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Edits                         int64                          `protobuf:"varint,8,opt,name=edits,proto3" json:"edits,omitempty"`
 }
 
 func (x *Header) Reset() {
 	*x = Header{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[5]
+		mi := &file_proto_objects_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -400,7 +616,7 @@ func (x *Header) String() string {
 func (*Header) ProtoMessage() {}
 
 func (x *Header) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[5]
+	mi := &file_proto_objects_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,7 +629,7 @@ func (x *Header) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Header.ProtoReflect.Descriptor instead.
 func (*Header) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{5}
+	return file_proto_objects_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Header) GetId() string {
@@ -451,19 +667,47 @@ func (x *Header) GetActionAuthorizedUsersForPaths() map[string]*ObjectActionsUse
 	return nil
 }
 
+func (x *Header) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *Header) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Header) GetEdits() int64 {
+	if x != nil {
+		return x.Edits
+	}
+	return 0
+}
+
 type Object struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Data   string  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Header     *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Data       string         `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Score      float64        `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	Highlights []*Highlight   `protobuf:"bytes,4,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	Facets     []*FacetResult `protobuf:"bytes,5,rep,name=facets,proto3" json:"facets,omitempty"`
+	// collection is the collection of objects found by searches over several collections
+	Collection string `protobuf:"bytes,6,opt,name=collection,proto3" json:"collection,omitempty"`
+	// distance is the number of meters between the search origin and the object when searches are sorted by distance
+	Distance float64 `protobuf:"fixed64,7,opt,name=distance,proto3" json:"distance,omitempty"`
 }
 
 func (x *Object) Reset() {
 	*x = Object{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[6]
+		mi := &file_proto_objects_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -476,7 +720,7 @@ func (x *Object) String() string {
 func (*Object) ProtoMessage() {}
 
 func (x *Object) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[6]
+	mi := &file_proto_objects_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,7 +733,7 @@ func (x *Object) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object.ProtoReflect.Descriptor instead.
 func (*Object) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{6}
+	return file_proto_objects_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Object) GetHeader() *Header {
@@ -506,6 +750,41 @@ func (x *Object) GetData() string {
 	return ""
 }
 
+func (x *Object) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Object) GetHighlights() []*Highlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+func (x *Object) GetFacets() []*FacetResult {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *Object) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *Object) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
 type Patch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -519,7 +798,7 @@ type Patch struct {
 func (x *Patch) Reset() {
 	*x = Patch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[7]
+		mi := &file_proto_objects_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -532,7 +811,7 @@ func (x *Patch) String() string {
 func (*Patch) ProtoMessage() {}
 
 func (x *Patch) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[7]
+	mi := &file_proto_objects_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,7 +824,7 @@ func (x *Patch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Patch.ProtoReflect.Descriptor instead.
 func (*Patch) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{7}
+	return file_proto_objects_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Patch) GetObjectId() string {
@@ -582,7 +861,7 @@ type ObjectList struct {
 func (x *ObjectList) Reset() {
 	*x = ObjectList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[8]
+		mi := &file_proto_objects_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -595,7 +874,7 @@ func (x *ObjectList) String() string {
 func (*ObjectList) ProtoMessage() {}
 
 func (x *ObjectList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[8]
+	mi := &file_proto_objects_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -608,7 +887,7 @@ func (x *ObjectList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectList.ProtoReflect.Descriptor instead.
 func (*ObjectList) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{8}
+	return file_proto_objects_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ObjectList) GetOffset() int64 {
@@ -643,7 +922,7 @@ type CreateCollectionRequest struct {
 func (x *CreateCollectionRequest) Reset() {
 	*x = CreateCollectionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[9]
+		mi := &file_proto_objects_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -656,7 +935,7 @@ func (x *CreateCollectionRequest) String() string {
 func (*CreateCollectionRequest) ProtoMessage() {}
 
 func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[9]
+	mi := &file_proto_objects_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +948,7 @@ func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
 func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{9}
+	return file_proto_objects_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateCollectionRequest) GetCollection() *Collection {
@@ -688,7 +967,7 @@ type CreateCollectionResponse struct {
 func (x *CreateCollectionResponse) Reset() {
 	*x = CreateCollectionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[10]
+		mi := &file_proto_objects_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -701,7 +980,7 @@ func (x *CreateCollectionResponse) String() string {
 func (*CreateCollectionResponse) ProtoMessage() {}
 
 func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[10]
+	mi := &file_proto_objects_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,7 +993,7 @@ func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCollectionResponse.ProtoReflect.Descriptor instead.
 func (*CreateCollectionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{10}
+	return file_proto_objects_proto_rawDescGZIP(), []int{11}
 }
 
 type GetCollectionRequest struct {
@@ -728,7 +1007,7 @@ type GetCollectionRequest struct {
 func (x *GetCollectionRequest) Reset() {
 	*x = GetCollectionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[11]
+		mi := &file_proto_objects_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -741,7 +1020,7 @@ func (x *GetCollectionRequest) String() string {
 func (*GetCollectionRequest) ProtoMessage() {}
 
 func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[11]
+	mi := &file_proto_objects_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +1033,7 @@ func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCollectionRequest.ProtoReflect.Descriptor instead.
 func (*GetCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{11}
+	return file_proto_objects_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetCollectionRequest) GetId() string {
@@ -775,7 +1054,7 @@ type GetCollectionResponse struct {
 func (x *GetCollectionResponse) Reset() {
 	*x = GetCollectionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[12]
+		mi := &file_proto_objects_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -788,7 +1067,7 @@ func (x *GetCollectionResponse) String() string {
 func (*GetCollectionResponse) ProtoMessage() {}
 
 func (x *GetCollectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[12]
+	mi := &file_proto_objects_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -801,7 +1080,7 @@ func (x *GetCollectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCollectionResponse.ProtoReflect.Descriptor instead.
 func (*GetCollectionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{12}
+	return file_proto_objects_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetCollectionResponse) GetCollection() *Collection {
@@ -820,7 +1099,7 @@ type ListCollectionsRequest struct {
 func (x *ListCollectionsRequest) Reset() {
 	*x = ListCollectionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[13]
+		mi := &file_proto_objects_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -833,7 +1112,7 @@ func (x *ListCollectionsRequest) String() string {
 func (*ListCollectionsRequest) ProtoMessage() {}
 
 func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[13]
+	mi := &file_proto_objects_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,7 +1125,7 @@ func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
 func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{13}
+	return file_proto_objects_proto_rawDescGZIP(), []int{14}
 }
 
 type ListCollectionsResponse struct {
@@ -860,7 +1139,7 @@ type ListCollectionsResponse struct {
 func (x *ListCollectionsResponse) Reset() {
 	*x = ListCollectionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[14]
+		mi := &file_proto_objects_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -873,7 +1152,7 @@ func (x *ListCollectionsResponse) String() string {
 func (*ListCollectionsResponse) ProtoMessage() {}
 
 func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[14]
+	mi := &file_proto_objects_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,7 +1165,7 @@ func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
 func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{14}
+	return file_proto_objects_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListCollectionsResponse) GetCollections() []*Collection {
@@ -907,7 +1186,7 @@ type DeleteCollectionRequest struct {
 func (x *DeleteCollectionRequest) Reset() {
 	*x = DeleteCollectionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[15]
+		mi := &file_proto_objects_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -920,7 +1199,7 @@ func (x *DeleteCollectionRequest) String() string {
 func (*DeleteCollectionRequest) ProtoMessage() {}
 
 func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[15]
+	mi := &file_proto_objects_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -933,7 +1212,7 @@ func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{15}
+	return file_proto_objects_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeleteCollectionRequest) GetId() string {
@@ -952,7 +1231,7 @@ type DeleteCollectionResponse struct {
 func (x *DeleteCollectionResponse) Reset() {
 	*x = DeleteCollectionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[16]
+		mi := &file_proto_objects_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -965,7 +1244,7 @@ func (x *DeleteCollectionResponse) String() string {
 func (*DeleteCollectionResponse) ProtoMessage() {}
 
 func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[16]
+	mi := &file_proto_objects_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,7 +1257,7 @@ func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{16}
+	return file_proto_objects_proto_rawDescGZIP(), []int{17}
 }
 
 type PutObjectRequest struct {
@@ -990,12 +1269,14 @@ type PutObjectRequest struct {
 	Object                *Object          `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
 	Indexes               []*TextIndex     `protobuf:"bytes,3,rep,name=indexes,proto3" json:"indexes,omitempty"`
 	ActionAuthorizedUsers *PathAccessRules `protobuf:"bytes,4,opt,name=action_authorized_users,json=actionAuthorizedUsers,proto3" json:"action_authorized_users,omitempty"`
+	// wait_indexed makes the response wait until the object is searchable
+	WaitIndexed bool `protobuf:"varint,5,opt,name=wait_indexed,json=waitIndexed,proto3" json:"wait_indexed,omitempty"`
 }
 
 func (x *PutObjectRequest) Reset() {
 	*x = PutObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[17]
+		mi := &file_proto_objects_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1008,7 +1289,7 @@ func (x *PutObjectRequest) String() string {
 func (*PutObjectRequest) ProtoMessage() {}
 
 func (x *PutObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[17]
+	mi := &file_proto_objects_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1021,7 +1302,7 @@ func (x *PutObjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutObjectRequest.ProtoReflect.Descriptor instead.
 func (*PutObjectRequest) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{17}
+	return file_proto_objects_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PutObjectRequest) GetCollection() string {
@@ -1052,6 +1333,13 @@ func (x *PutObjectRequest) GetActionAuthorizedUsers() *PathAccessRules {
 	return nil
 }
 
+func (x *PutObjectRequest) GetWaitIndexed() bool {
+	if x != nil {
+		return x.WaitIndexed
+	}
+	return false
+}
+
 type PutObjectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1063,7 +1351,7 @@ type PutObjectResponse struct {
 func (x *PutObjectResponse) Reset() {
 	*x = PutObjectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[18]
+		mi := &file_proto_objects_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1076,7 +1364,7 @@ func (x *PutObjectResponse) String() string {
 func (*PutObjectResponse) ProtoMessage() {}
 
 func (x *PutObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[18]
+	mi := &file_proto_objects_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1089,7 +1377,7 @@ func (x *PutObjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutObjectResponse.ProtoReflect.Descriptor instead.
 func (*PutObjectResponse) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{18}
+	return file_proto_objects_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PutObjectResponse) GetObjectId() string {
@@ -1111,7 +1399,7 @@ type PatchObjectRequest struct {
 func (x *PatchObjectRequest) Reset() {
 	*x = PatchObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[19]
+		mi := &file_proto_objects_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1124,7 +1412,7 @@ func (x *PatchObjectRequest) String() string {
 func (*PatchObjectRequest) ProtoMessage() {}
 
 func (x *PatchObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[19]
+	mi := &file_proto_objects_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,7 +1425,7 @@ func (x *PatchObjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchObjectRequest.ProtoReflect.Descriptor instead.
 func (*PatchObjectRequest) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{19}
+	return file_proto_objects_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *PatchObjectRequest) GetCollection() string {
@@ -1163,7 +1451,7 @@ type PatchObjectResponse struct {
 func (x *PatchObjectResponse) Reset() {
 	*x = PatchObjectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[20]
+		mi := &file_proto_objects_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1176,7 +1464,7 @@ func (x *PatchObjectResponse) String() string {
 func (*PatchObjectResponse) ProtoMessage() {}
 
 func (x *PatchObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[20]
+	mi := &file_proto_objects_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1189,7 +1477,7 @@ func (x *PatchObjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchObjectResponse.ProtoReflect.Descriptor instead.
 func (*PatchObjectResponse) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{20}
+	return file_proto_objects_proto_rawDescGZIP(), []int{21}
 }
 
 type MoveObjectRequest struct {
@@ -1206,7 +1494,7 @@ type MoveObjectRequest struct {
 func (x *MoveObjectRequest) Reset() {
 	*x = MoveObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[21]
+		mi := &file_proto_objects_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1219,7 +1507,7 @@ func (x *MoveObjectRequest) String() string {
 func (*MoveObjectRequest) ProtoMessage() {}
 
 func (x *MoveObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[21]
+	mi := &file_proto_objects_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1232,7 +1520,7 @@ func (x *MoveObjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveObjectRequest.ProtoReflect.Descriptor instead.
 func (*MoveObjectRequest) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{21}
+	return file_proto_objects_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *MoveObjectRequest) GetSourceCollection() string {
@@ -1272,7 +1560,7 @@ type MoveObjectResponse struct {
 func (x *MoveObjectResponse) Reset() {
 	*x = MoveObjectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[22]
+		mi := &file_proto_objects_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1285,7 +1573,7 @@ func (x *MoveObjectResponse) String() string {
 func (*MoveObjectResponse) ProtoMessage() {}
 
 func (x *MoveObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[22]
+	mi := &file_proto_objects_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1298,7 +1586,7 @@ func (x *MoveObjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveObjectResponse.ProtoReflect.Descriptor instead.
 func (*MoveObjectResponse) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{22}
+	return file_proto_objects_proto_rawDescGZIP(), []int{23}
 }
 
 type GetObjectRequest struct {
@@ -1315,7 +1603,7 @@ type GetObjectRequest struct {
 func (x *GetObjectRequest) Reset() {
 	*x = GetObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[23]
+		mi := &file_proto_objects_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1328,7 +1616,7 @@ func (x *GetObjectRequest) String() string {
 func (*GetObjectRequest) ProtoMessage() {}
 
 func (x *GetObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[23]
+	mi := &file_proto_objects_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1341,7 +1629,7 @@ func (x *GetObjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetObjectRequest.ProtoReflect.Descriptor instead.
 func (*GetObjectRequest) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{23}
+	return file_proto_objects_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetObjectRequest) GetCollection() string {
@@ -1383,7 +1671,7 @@ type GetObjectResponse struct {
 func (x *GetObjectResponse) Reset() {
 	*x = GetObjectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[24]
+		mi := &file_proto_objects_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1396,7 +1684,7 @@ func (x *GetObjectResponse) String() string {
 func (*GetObjectResponse) ProtoMessage() {}
 
 func (x *GetObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[24]
+	mi := &file_proto_objects_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1409,7 +1697,7 @@ func (x *GetObjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetObjectResponse.ProtoReflect.Descriptor instead.
 func (*GetObjectResponse) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{24}
+	return file_proto_objects_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetObjectResponse) GetObject() *Object {
@@ -1426,12 +1714,14 @@ type DeleteObjectRequest struct {
 
 	Collection string `protobuf:"bytes,1,opt,name=Collection,proto3" json:"Collection,omitempty"`
 	ObjectId   string `protobuf:"bytes,2,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	// wait_indexed makes the response wait until the object is removed from search results
+	WaitIndexed bool `protobuf:"varint,3,opt,name=wait_indexed,json=waitIndexed,proto3" json:"wait_indexed,omitempty"`
 }
 
 func (x *DeleteObjectRequest) Reset() {
 	*x = DeleteObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[25]
+		mi := &file_proto_objects_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1444,7 +1734,7 @@ func (x *DeleteObjectRequest) String() string {
 func (*DeleteObjectRequest) ProtoMessage() {}
 
 func (x *DeleteObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[25]
+	mi := &file_proto_objects_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,7 +1747,7 @@ func (x *DeleteObjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteObjectRequest.ProtoReflect.Descriptor instead.
 func (*DeleteObjectRequest) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{25}
+	return file_proto_objects_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DeleteObjectRequest) GetCollection() string {
@@ -1474,6 +1764,13 @@ func (x *DeleteObjectRequest) GetObjectId() string {
 	return ""
 }
 
+func (x *DeleteObjectRequest) GetWaitIndexed() bool {
+	if x != nil {
+		return x.WaitIndexed
+	}
+	return false
+}
+
 type DeleteObjectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1483,7 +1780,7 @@ type DeleteObjectResponse struct {
 func (x *DeleteObjectResponse) Reset() {
 	*x = DeleteObjectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[26]
+		mi := &file_proto_objects_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1496,7 +1793,7 @@ func (x *DeleteObjectResponse) String() string {
 func (*DeleteObjectResponse) ProtoMessage() {}
 
 func (x *DeleteObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[26]
+	mi := &file_proto_objects_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1509,7 +1806,7 @@ func (x *DeleteObjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteObjectResponse.ProtoReflect.Descriptor instead.
 func (*DeleteObjectResponse) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{26}
+	return file_proto_objects_proto_rawDescGZIP(), []int{27}
 }
 
 type ObjectInfoRequest struct {
@@ -1524,7 +1821,7 @@ type ObjectInfoRequest struct {
 func (x *ObjectInfoRequest) Reset() {
 	*x = ObjectInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[27]
+		mi := &file_proto_objects_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1537,7 +1834,7 @@ func (x *ObjectInfoRequest) String() string {
 func (*ObjectInfoRequest) ProtoMessage() {}
 
 func (x *ObjectInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[27]
+	mi := &file_proto_objects_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1550,7 +1847,7 @@ func (x *ObjectInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectInfoRequest.ProtoReflect.Descriptor instead.
 func (*ObjectInfoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{27}
+	return file_proto_objects_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ObjectInfoRequest) GetCollection() string {
@@ -1578,7 +1875,7 @@ type ObjectInfoResponse struct {
 func (x *ObjectInfoResponse) Reset() {
 	*x = ObjectInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[28]
+		mi := &file_proto_objects_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1591,7 +1888,7 @@ func (x *ObjectInfoResponse) String() string {
 func (*ObjectInfoResponse) ProtoMessage() {}
 
 func (x *ObjectInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[28]
+	mi := &file_proto_objects_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1604,7 +1901,7 @@ func (x *ObjectInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectInfoResponse.ProtoReflect.Descriptor instead.
 func (*ObjectInfoResponse) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{28}
+	return file_proto_objects_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ObjectInfoResponse) GetHeader() *Header {
@@ -1619,15 +1916,24 @@ type ListObjectsRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
-	Offset     int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	At         string `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	Collection    string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Offset        int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	At            string `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	SortBy        string `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	UpdatedBy     string `protobuf:"bytes,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAfter  int64  `protobuf:"varint,6,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	UpdatedBefore int64  `protobuf:"varint,7,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+	CreatedBy     string `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAfter  int64  `protobuf:"varint,9,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore int64  `protobuf:"varint,10,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	MinSize       int64  `protobuf:"varint,11,opt,name=min_size,json=minSize,proto3" json:"min_size,omitempty"`
+	MaxSize       int64  `protobuf:"varint,12,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
 }
 
 func (x *ListObjectsRequest) Reset() {
 	*x = ListObjectsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[29]
+		mi := &file_proto_objects_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1640,7 +1946,7 @@ func (x *ListObjectsRequest) String() string {
 func (*ListObjectsRequest) ProtoMessage() {}
 
 func (x *ListObjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[29]
+	mi := &file_proto_objects_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1653,7 +1959,7 @@ func (x *ListObjectsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListObjectsRequest.ProtoReflect.Descriptor instead.
 func (*ListObjectsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{29}
+	return file_proto_objects_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ListObjectsRequest) GetCollection() string {
@@ -1677,6 +1983,69 @@ func (x *ListObjectsRequest) GetAt() string {
 	return ""
 }
 
+func (x *ListObjectsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetUpdatedAfter() int64 {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return 0
+}
+
+func (x *ListObjectsRequest) GetUpdatedBefore() int64 {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return 0
+}
+
+func (x *ListObjectsRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *ListObjectsRequest) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *ListObjectsRequest) GetMinSize() int64 {
+	if x != nil {
+		return x.MinSize
+	}
+	return 0
+}
+
+func (x *ListObjectsRequest) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
 type ListObjectsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1688,7 +2057,7 @@ type ListObjectsResponse struct {
 func (x *ListObjectsResponse) Reset() {
 	*x = ListObjectsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[30]
+		mi := &file_proto_objects_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1701,7 +2070,7 @@ func (x *ListObjectsResponse) String() string {
 func (*ListObjectsResponse) ProtoMessage() {}
 
 func (x *ListObjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[30]
+	mi := &file_proto_objects_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,7 +2083,7 @@ func (x *ListObjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListObjectsResponse.ProtoReflect.Descriptor instead.
 func (*ListObjectsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{30}
+	return file_proto_objects_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ListObjectsResponse) GetResult() *ObjectList {
@@ -1729,14 +2098,19 @@ type SearchObjectsRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Collection string       `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
-	Query      *SearchQuery `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Collection   string       `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Query        *SearchQuery `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Highlight    bool         `protobuf:"varint,3,opt,name=highlight,proto3" json:"highlight,omitempty"`
+	FragmentSize uint32       `protobuf:"varint,4,opt,name=fragment_size,json=fragmentSize,proto3" json:"fragment_size,omitempty"`
+	Facets       []*Facet     `protobuf:"bytes,5,rep,name=facets,proto3" json:"facets,omitempty"`
+	// sort_by_distance sorts the found objects by their distance to a point, nearest first
+	SortByDistance *GeoPoint `protobuf:"bytes,6,opt,name=sort_by_distance,json=sortByDistance,proto3" json:"sort_by_distance,omitempty"`
 }
 
 func (x *SearchObjectsRequest) Reset() {
 	*x = SearchObjectsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_objects_proto_msgTypes[31]
+		mi := &file_proto_objects_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1749,7 +2123,7 @@ func (x *SearchObjectsRequest) String() string {
 func (*SearchObjectsRequest) ProtoMessage() {}
 
 func (x *SearchObjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_objects_proto_msgTypes[31]
+	mi := &file_proto_objects_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1762,7 +2136,7 @@ func (x *SearchObjectsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchObjectsRequest.ProtoReflect.Descriptor instead.
 func (*SearchObjectsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_objects_proto_rawDescGZIP(), []int{31}
+	return file_proto_objects_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SearchObjectsRequest) GetCollection() string {
@@ -1779,393 +2153,1440 @@ func (x *SearchObjectsRequest) GetQuery() *SearchQuery {
 	return nil
 }
 
-var File_proto_objects_proto protoreflect.FileDescriptor
+func (x *SearchObjectsRequest) GetHighlight() bool {
+	if x != nil {
+		return x.Highlight
+	}
+	return false
+}
 
-var file_proto_objects_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x63, 0x6c,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xde, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a,
-	0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2d,
-	0x0a, 0x0c, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x33, 0x0a,
-	0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x48, 0x0a, 0x17, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x15, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x0a,
-	0x61, 0x63, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0a, 0x2e, 0x41, 0x43, 0x4c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x61, 0x63,
-	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x5d, 0x0a, 0x09, 0x41, 0x43, 0x4c, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77,
-	0x69, 0x74, 0x68, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x13, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x7b, 0x0a, 0x12, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x04,
-	0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x75, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x74, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1f, 0x0a,
-	0x04, 0x65, 0x64, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x75,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x74, 0x52, 0x04, 0x65, 0x64, 0x69, 0x74, 0x12, 0x23,
-	0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x74, 0x52, 0x06, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x2e,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x53, 0x0a,
-	0x10, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0xb8, 0x01, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
-	0x61, 0x64, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x23, 0x0a, 0x0d, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc7, 0x02,
-	0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x74, 0x0a, 0x21, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x1d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x74, 0x68, 0x73,
-	0x1a, 0x65, 0x0a, 0x22, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x74, 0x68,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3d, 0x0a, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x1f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x07, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x48, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12,
-	0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
-	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x5d, 0x0a, 0x0a, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x07,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22,
-	0x46, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0a, 0x63, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x18, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x17, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc3, 0x01, 0x0a,
-	0x10, 0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x07, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x12, 0x24, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52,
-	0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x17, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x61, 0x74, 0x68,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x15, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x22, 0x30, 0x0a, 0x11, 0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x12, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x05, 0x70, 0x61,
-	0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x50, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x22, 0x15, 0x0a, 0x13, 0x50, 0x61, 0x74, 0x63,
-	0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xd0, 0x01, 0x0a, 0x11, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12,
-	0x2b, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x15,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
-	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x61,
-	0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x13, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x66,
-	0x6f, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e,
-	0x66, 0x6f, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x34, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x6f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x52, 0x0a, 0x13,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
-	0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x0a, 0x11, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
-	0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x12, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x07, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x22, 0x5c, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x74, 0x22,
-	0x3a, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x5a, 0x0a, 0x14, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x32, 0xd0, 0x05, 0x0a, 0x07, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0f,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x50,
-	0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x11, 0x2e, 0x50, 0x75, 0x74, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x50, 0x75,
-	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x38, 0x0a, 0x0b, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x13,
-	0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x4d, 0x6f, 0x76,
-	0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4d, 0x6f,
-	0x76, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x32, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x11, 0x2e,
-	0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x35, 0x0a, 0x0a, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x12, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x07, 0x2e, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x07, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x30, 0x01, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6d, 0x65, 0x63, 0x6f, 0x64, 0x65,
-	0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65,
-	0x6e, 0x2f, 0x67, 0x6f, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *SearchObjectsRequest) GetFragmentSize() uint32 {
+	if x != nil {
+		return x.FragmentSize
+	}
+	return 0
 }
 
-var (
-	file_proto_objects_proto_rawDescOnce sync.Once
-	file_proto_objects_proto_rawDescData = file_proto_objects_proto_rawDesc
-)
+func (x *SearchObjectsRequest) GetFacets() []*Facet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
 
-func file_proto_objects_proto_rawDescGZIP() []byte {
-	file_proto_objects_proto_rawDescOnce.Do(func() {
-		file_proto_objects_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_objects_proto_rawDescData)
-	})
-	return file_proto_objects_proto_rawDescData
+func (x *SearchObjectsRequest) GetSortByDistance() *GeoPoint {
+	if x != nil {
+		return x.SortByDistance
+	}
+	return nil
 }
 
-var file_proto_objects_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
-var file_proto_objects_proto_goTypes = []interface{}{
-	(*Collection)(nil),               // 0: Collection
-	(*ACLConfig)(nil),                // 1: ACLConfig
-	(*ObjectActionsUsers)(nil),       // 2: ObjectActionsUsers
-	(*PathAccessRules)(nil),          // 3: PathAccessRules
-	(*AccessRules)(nil),              // 4: AccessRules
-	(*Header)(nil),                   // 5: Header
-	(*Object)(nil),                   // 6: Object
-	(*Patch)(nil),                    // 7: Patch
-	(*ObjectList)(nil),               // 8: ObjectList
-	(*CreateCollectionRequest)(nil),  // 9: CreateCollectionRequest
-	(*CreateCollectionResponse)(nil), // 10: CreateCollectionResponse
-	(*GetCollectionRequest)(nil),     // 11: GetCollectionRequest
-	(*GetCollectionResponse)(nil),    // 12: GetCollectionResponse
-	(*ListCollectionsRequest)(nil),   // 13: ListCollectionsRequest
-	(*ListCollectionsResponse)(nil),  // 14: ListCollectionsResponse
-	(*DeleteCollectionRequest)(nil),  // 15: DeleteCollectionRequest
-	(*DeleteCollectionResponse)(nil), // 16: DeleteCollectionResponse
-	(*PutObjectRequest)(nil),         // 17: PutObjectRequest
-	(*PutObjectResponse)(nil),        // 18: PutObjectResponse
-	(*PatchObjectRequest)(nil),       // 19: PatchObjectRequest
-	(*PatchObjectResponse)(nil),      // 20: PatchObjectResponse
-	(*MoveObjectRequest)(nil),        // 21: MoveObjectRequest
-	(*MoveObjectResponse)(nil),       // 22: MoveObjectResponse
-	(*GetObjectRequest)(nil),         // 23: GetObjectRequest
-	(*GetObjectResponse)(nil),        // 24: GetObjectResponse
-	(*DeleteObjectRequest)(nil),      // 25: DeleteObjectRequest
-	(*DeleteObjectResponse)(nil),     // 26: DeleteObjectResponse
-	(*ObjectInfoRequest)(nil),        // 27: ObjectInfoRequest
-	(*ObjectInfoResponse)(nil),       // 28: ObjectInfoResponse
-	(*ListObjectsRequest)(nil),       // 29: ListObjectsRequest
-	(*ListObjectsResponse)(nil),      // 30: ListObjectsResponse
-	(*SearchObjectsRequest)(nil),     // 31: SearchObjectsRequest
-	nil,                              // 32: PathAccessRules.AccessRulesEntry
-	nil,                              // 33: Header.ActionAuthorizedUsersForPathsEntry
-	(*NumberIndex)(nil),              // 34: NumberIndex
-	(*TextIndex)(nil),                // 35: TextIndex
-	(*PropertiesIndex)(nil),          // 36: PropertiesIndex
-	(*SubjectSet)(nil),               // 37: SubjectSet
-	(*SearchQuery)(nil),              // 38: SearchQuery
+type SearchCollectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// collections are the collections to search, all of them when empty
+	Collections  []string     `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	Query        *SearchQuery `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Highlight    bool         `protobuf:"varint,3,opt,name=highlight,proto3" json:"highlight,omitempty"`
+	FragmentSize uint32       `protobuf:"varint,4,opt,name=fragment_size,json=fragmentSize,proto3" json:"fragment_size,omitempty"`
+	// sort_by_distance sorts the found objects of all the collections by their distance to a point, nearest first
+	SortByDistance *GeoPoint `protobuf:"bytes,5,opt,name=sort_by_distance,json=sortByDistance,proto3" json:"sort_by_distance,omitempty"`
 }
-var file_proto_objects_proto_depIdxs = []int32{
-	34, // 0: Collection.number_index:type_name -> NumberIndex
-	35, // 1: Collection.text_indexes:type_name -> TextIndex
-	36, // 2: Collection.fields_index:type_name -> PropertiesIndex
-	3,  // 3: Collection.action_authorized_users:type_name -> PathAccessRules
-	1,  // 4: Collection.acl_config:type_name -> ACLConfig
-	37, // 5: ObjectActionsUsers.view:type_name -> SubjectSet
-	37, // 6: ObjectActionsUsers.edit:type_name -> SubjectSet
-	37, // 7: ObjectActionsUsers.delete:type_name -> SubjectSet
-	32, // 8: PathAccessRules.access_rules:type_name -> PathAccessRules.AccessRulesEntry
-	33, // 9: Header.action_authorized_users_for_paths:type_name -> Header.ActionAuthorizedUsersForPathsEntry
-	5,  // 10: Object.header:type_name -> Header
-	6,  // 11: ObjectList.objects:type_name -> Object
-	0,  // 12: CreateCollectionRequest.collection:type_name -> Collection
-	0,  // 13: GetCollectionResponse.collection:type_name -> Collection
-	0,  // 14: ListCollectionsResponse.collections:type_name -> Collection
-	6,  // 15: PutObjectRequest.object:type_name -> Object
-	35, // 16: PutObjectRequest.indexes:type_name -> TextIndex
-	3,  // 17: PutObjectRequest.action_authorized_users:type_name -> PathAccessRules
-	7,  // 18: PatchObjectRequest.patch:type_name -> Patch
-	3,  // 19: MoveObjectRequest.access_security_rules:type_name -> PathAccessRules
-	6,  // 20: GetObjectResponse.object:type_name -> Object
-	5,  // 21: ObjectInfoResponse.header:type_name -> Header
-	8,  // 22: ListObjectsResponse.result:type_name -> ObjectList
-	38, // 23: SearchObjectsRequest.query:type_name -> SearchQuery
-	2,  // 24: PathAccessRules.AccessRulesEntry.value:type_name -> ObjectActionsUsers
-	2,  // 25: Header.ActionAuthorizedUsersForPathsEntry.value:type_name -> ObjectActionsUsers
-	9,  // 26: Objects.CreateCollection:input_type -> CreateCollectionRequest
-	11, // 27: Objects.GetCollection:input_type -> GetCollectionRequest
-	13, // 28: Objects.ListCollections:input_type -> ListCollectionsRequest
-	15, // 29: Objects.DeleteCollection:input_type -> DeleteCollectionRequest
-	17, // 30: Objects.PutObject:input_type -> PutObjectRequest
-	19, // 31: Objects.PatchObject:input_type -> PatchObjectRequest
-	21, // 32: Objects.MoveObject:input_type -> MoveObjectRequest
-	23, // 33: Objects.GetObject:input_type -> GetObjectRequest
-	25, // 34: Objects.DeleteObject:input_type -> DeleteObjectRequest
-	27, // 35: Objects.ObjectInfo:input_type -> ObjectInfoRequest
-	29, // 36: Objects.ListObjects:input_type -> ListObjectsRequest
-	31, // 37: Objects.SearchObjects:input_type -> SearchObjectsRequest
-	10, // 38: Objects.CreateCollection:output_type -> CreateCollectionResponse
-	12, // 39: Objects.GetCollection:output_type -> GetCollectionResponse
-	14, // 40: Objects.ListCollections:output_type -> ListCollectionsResponse
-	16, // 41: Objects.DeleteCollection:output_type -> DeleteCollectionResponse
-	18, // 42: Objects.PutObject:output_type -> PutObjectResponse
-	20, // 43: Objects.PatchObject:output_type -> PatchObjectResponse
-	22, // 44: Objects.MoveObject:output_type -> MoveObjectResponse
-	24, // 45: Objects.GetObject:output_type -> GetObjectResponse
-	26, // 46: Objects.DeleteObject:output_type -> DeleteObjectResponse
-	28, // 47: Objects.ObjectInfo:output_type -> ObjectInfoResponse
-	6,  // 48: Objects.ListObjects:output_type -> Object
-	6,  // 49: Objects.SearchObjects:output_type -> Object
-	38, // [38:50] is the sub-list for method output_type
-	26, // [26:38] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+
+func (x *SearchCollectionsRequest) Reset() {
+	*x = SearchCollectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_objects_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func init() { file_proto_objects_proto_init() }
-func file_proto_objects_proto_init() {
-	if File_proto_objects_proto != nil {
-		return
+func (x *SearchCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCollectionsRequest) ProtoMessage() {}
+
+func (x *SearchCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_objects_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	file_proto_se_proto_init()
-	file_proto_acl_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_proto_objects_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Collection); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*SearchCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SearchCollectionsRequest) GetCollections() []string {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *SearchCollectionsRequest) GetQuery() *SearchQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *SearchCollectionsRequest) GetHighlight() bool {
+	if x != nil {
+		return x.Highlight
+	}
+	return false
+}
+
+func (x *SearchCollectionsRequest) GetFragmentSize() uint32 {
+	if x != nil {
+		return x.FragmentSize
+	}
+	return 0
+}
+
+func (x *SearchCollectionsRequest) GetSortByDistance() *GeoPoint {
+	if x != nil {
+		return x.SortByDistance
+	}
+	return nil
+}
+
+// SuggestionsRequest asks for the terms indexed for a text index of a collection that complete a typed prefix
+type SuggestionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	// field is the alias of the text index
+	Field       string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Prefix      string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Size        uint32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	MaxDistance uint32 `protobuf:"varint,5,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+}
+
+func (x *SuggestionsRequest) Reset() {
+	*x = SuggestionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_objects_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestionsRequest) ProtoMessage() {}
+
+func (x *SuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_objects_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SuggestionsRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SuggestionsRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SuggestionsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestionsRequest) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SuggestionsRequest) GetMaxDistance() uint32 {
+	if x != nil {
+		return x.MaxDistance
+	}
+	return 0
+}
+
+type SaveSynonymSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Set *SynonymSet `protobuf:"bytes,1,opt,name=set,proto3" json:"set,omitempty"`
+}
+
+func (x *SaveSynonymSetRequest) Reset() {
+	*x = SaveSynonymSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_objects_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveSynonymSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSynonymSetRequest) ProtoMessage() {}
+
+func (x *SaveSynonymSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_objects_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSynonymSetRequest.ProtoReflect.Descriptor instead.
+func (*SaveSynonymSetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SaveSynonymSetRequest) GetSet() *SynonymSet {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+type SaveSynonymSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SaveSynonymSetResponse) Reset() {
+	*x = SaveSynonymSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_objects_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveSynonymSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSynonymSetResponse) ProtoMessage() {}
+
+func (x *SaveSynonymSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_objects_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSynonymSetResponse.ProtoReflect.Descriptor instead.
+func (*SaveSynonymSetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{36}
+}
+
+// ListSynonymSetsRequest lists the synonym sets that apply to a collection, or to an analyzer, or all of them when
+// both are empty
+type ListSynonymSetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Analyzer   string `protobuf:"bytes,2,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+}
+
+func (x *ListSynonymSetsRequest) Reset() {
+	*x = ListSynonymSetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_objects_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSynonymSetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSynonymSetsRequest) ProtoMessage() {}
+
+func (x *ListSynonymSetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_objects_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSynonymSetsRequest.ProtoReflect.Descriptor instead.
+func (*ListSynonymSetsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListSynonymSetsRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *ListSynonymSetsRequest) GetAnalyzer() string {
+	if x != nil {
+		return x.Analyzer
+	}
+	return ""
+}
+
+type ListSynonymSetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sets []*SynonymSet `protobuf:"bytes,1,rep,name=sets,proto3" json:"sets,omitempty"`
+}
+
+func (x *ListSynonymSetsResponse) Reset() {
+	*x = ListSynonymSetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_objects_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSynonymSetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSynonymSetsResponse) ProtoMessage() {}
+
+func (x *ListSynonymSetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_objects_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSynonymSetsResponse.ProtoReflect.Descriptor instead.
+func (*ListSynonymSetsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListSynonymSetsResponse) GetSets() []*SynonymSet {
+	if x != nil {
+		return x.Sets
+	}
+	return nil
+}
+
+type DeleteSynonymSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteSynonymSetRequest) Reset() {
+	*x = DeleteSynonymSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_objects_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSynonymSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSynonymSetRequest) ProtoMessage() {}
+
+func (x *DeleteSynonymSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_objects_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSynonymSetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSynonymSetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteSynonymSetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSynonymSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteSynonymSetResponse) Reset() {
+	*x = DeleteSynonymSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_objects_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSynonymSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSynonymSetResponse) ProtoMessage() {}
+
+func (x *DeleteSynonymSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_objects_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSynonymSetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSynonymSetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{40}
+}
+
+// CheckIndexRequest compares the search index of a collection to its objects, batch_size objects at a time. With
+// repair, the mappings of missing objects are deleted and the objects without mappings are indexed again
+type CheckIndexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Repair     bool   `protobuf:"varint,2,opt,name=repair,proto3" json:"repair,omitempty"`
+	BatchSize  uint32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+func (x *CheckIndexRequest) Reset() {
+	*x = CheckIndexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_objects_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckIndexRequest) ProtoMessage() {}
+
+func (x *CheckIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_objects_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckIndexRequest.ProtoReflect.Descriptor instead.
+func (*CheckIndexRequest) Descriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CheckIndexRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *CheckIndexRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+func (x *CheckIndexRequest) GetBatchSize() uint32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type CheckIndexResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report *IndexReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *CheckIndexResponse) Reset() {
+	*x = CheckIndexResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_objects_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckIndexResponse) ProtoMessage() {}
+
+func (x *CheckIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_objects_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckIndexResponse.ProtoReflect.Descriptor instead.
+func (*CheckIndexResponse) Descriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CheckIndexResponse) GetReport() *IndexReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// IndexReport lists the differences found between the search index of a collection and its objects. At most 100
+// ids of each kind are listed, the counts cover all of them
+type IndexReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	// objects is the number of checked objects
+	Objects int64 `protobuf:"varint,2,opt,name=objects,proto3" json:"objects,omitempty"`
+	// mapped is the number of checked ids that have mappings
+	Mapped int64 `protobuf:"varint,3,opt,name=mapped,proto3" json:"mapped,omitempty"`
+	// orphans are ids that have mappings but no object
+	Orphans      []string `protobuf:"bytes,4,rep,name=orphans,proto3" json:"orphans,omitempty"`
+	OrphansCount int64    `protobuf:"varint,5,opt,name=orphans_count,json=orphansCount,proto3" json:"orphans_count,omitempty"`
+	// unmapped are ids of objects that have indexed values but no mapping
+	Unmapped      []string `protobuf:"bytes,6,rep,name=unmapped,proto3" json:"unmapped,omitempty"`
+	UnmappedCount int64    `protobuf:"varint,7,opt,name=unmapped_count,json=unmappedCount,proto3" json:"unmapped_count,omitempty"`
+	// repaired is the number of orphans and unmapped objects whose fix was queued
+	Repaired int64 `protobuf:"varint,8,opt,name=repaired,proto3" json:"repaired,omitempty"`
+}
+
+func (x *IndexReport) Reset() {
+	*x = IndexReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_objects_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexReport) ProtoMessage() {}
+
+func (x *IndexReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_objects_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexReport.ProtoReflect.Descriptor instead.
+func (*IndexReport) Descriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *IndexReport) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *IndexReport) GetObjects() int64 {
+	if x != nil {
+		return x.Objects
+	}
+	return 0
+}
+
+func (x *IndexReport) GetMapped() int64 {
+	if x != nil {
+		return x.Mapped
+	}
+	return 0
+}
+
+func (x *IndexReport) GetOrphans() []string {
+	if x != nil {
+		return x.Orphans
+	}
+	return nil
+}
+
+func (x *IndexReport) GetOrphansCount() int64 {
+	if x != nil {
+		return x.OrphansCount
+	}
+	return 0
+}
+
+func (x *IndexReport) GetUnmapped() []string {
+	if x != nil {
+		return x.Unmapped
+	}
+	return nil
+}
+
+func (x *IndexReport) GetUnmappedCount() int64 {
+	if x != nil {
+		return x.UnmappedCount
+	}
+	return 0
+}
+
+func (x *IndexReport) GetRepaired() int64 {
+	if x != nil {
+		return x.Repaired
+	}
+	return 0
+}
+
+// ExplainSearchRequest asks how a search query is compiled for a collection and how the objects it finds are scored
+type ExplainSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string       `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Query      *SearchQuery `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (x *ExplainSearchRequest) Reset() {
+	*x = ExplainSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_objects_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainSearchRequest) ProtoMessage() {}
+
+func (x *ExplainSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_objects_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainSearchRequest.ProtoReflect.Descriptor instead.
+func (*ExplainSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExplainSearchRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *ExplainSearchRequest) GetQuery() *SearchQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+type ExplainSearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Explanation *SearchExplanation `protobuf:"bytes,1,opt,name=explanation,proto3" json:"explanation,omitempty"`
+}
+
+func (x *ExplainSearchResponse) Reset() {
+	*x = ExplainSearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_objects_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainSearchResponse) ProtoMessage() {}
+
+func (x *ExplainSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_objects_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainSearchResponse.ProtoReflect.Descriptor instead.
+func (*ExplainSearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_objects_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ExplainSearchResponse) GetExplanation() *SearchExplanation {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
+var File_proto_objects_proto protoreflect.FileDescriptor
+
+var file_proto_objects_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x63, 0x6c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe1, 0x03, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a,
+	0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2d,
+	0x0a, 0x0c, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x33, 0x0a,
+	0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x48, 0x0a, 0x17, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x15, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x0a,
+	0x61, 0x63, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x41, 0x43, 0x4c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x61, 0x63,
+	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a, 0x0b, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x0b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x67, 0x65, 0x6f, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x47, 0x65, 0x6f, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x52, 0x08, 0x67, 0x65, 0x6f, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xb5, 0x01, 0x0a, 0x09, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x5d, 0x0a, 0x09, 0x41, 0x43, 0x4c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x32, 0x0a,
+	0x15, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x22, 0x7b, 0x0a, 0x12, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x65, 0x74, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1f, 0x0a, 0x04, 0x65, 0x64, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x53, 0x65, 0x74, 0x52, 0x04, 0x65, 0x64, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x53, 0x65, 0x74, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x22, 0xac,
+	0x01, 0x0a, 0x0f, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x44, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x53, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb8, 0x01,
+	0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x64,
+	0x69, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x27, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x03, 0x0a, 0x06, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x74, 0x0a, 0x21, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x46,
+	0x6f, 0x72, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x1d, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x64, 0x69, 0x74, 0x73, 0x1a,
+	0x65, 0x0a, 0x22, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x74, 0x68, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe1, 0x01, 0x0a, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x1f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x07, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x0a,
+	0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x0a, 0x68, 0x69,
+	0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x65,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x48, 0x0a, 0x05, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x5d, 0x0a, 0x0a, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x21, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x07, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x22, 0x46, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x44, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x48, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xe6, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x17,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x15, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x77, 0x61,
+	0x69, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x22, 0x30, 0x0a, 0x11, 0x50, 0x75, 0x74,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x12, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x22,
+	0x15, 0x0a, 0x13, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x11, 0x4d, 0x6f, 0x76, 0x65, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x15, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x4d, 0x6f, 0x76,
+	0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x7c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x66, 0x6f, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x34, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0x75, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x77,
+	0x61, 0x69, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x50, 0x0a, 0x11, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x12, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x81, 0x03, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f,
+	0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x72,
+	0x74, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x69, 0x6e,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x3a, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xf2, 0x01, 0x0a, 0x14,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x69, 0x67, 0x68,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x66,
+	0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x66,
+	0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x46, 0x61,
+	0x63, 0x65, 0x74, 0x52, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x10, 0x73,
+	0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x0e, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x22, 0xd8, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x22, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x33, 0x0a, 0x10, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62,
+	0x79, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0e, 0x73, 0x6f, 0x72,
+	0x74, 0x42, 0x79, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x12,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x36, 0x0a, 0x15, 0x53, 0x61, 0x76, 0x65, 0x53,
+	0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x53, 0x65, 0x74, 0x52, 0x03, 0x73, 0x65, 0x74, 0x22,
+	0x18, 0x0a, 0x16, 0x53, 0x61, 0x76, 0x65, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x22,
+	0x3a, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x53, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x73, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x79, 0x6e, 0x6f, 0x6e,
+	0x79, 0x6d, 0x53, 0x65, 0x74, 0x52, 0x04, 0x73, 0x65, 0x74, 0x73, 0x22, 0x29, 0x0a, 0x17, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x6a, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xfd, 0x01, 0x0a, 0x0b, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x70, 0x68, 0x61, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e,
+	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6f,
+	0x72, 0x70, 0x68, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x6e, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x6e, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x6d, 0x61, 0x70,
+	0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x75, 0x6e, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x22, 0x5a, 0x0a, 0x14, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x4d, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x78, 0x70,
+	0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x1f, 0x0a, 0x0a, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x51, 0x4c, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x44, 0x69, 0x73, 0x6b, 0x10, 0x01, 0x2a, 0x4b, 0x0a, 0x0f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52,
+	0x75, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x10, 0x03, 0x32, 0xb4, 0x0a, 0x0a, 0x07, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0x47, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x50, 0x75, 0x74, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x11, 0x2e, 0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x13, 0x2e, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x12, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47,
+	0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x14, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x0a, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x2e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x07, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x07, 0x2e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x07, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x30,
+	0x01, 0x12, 0x30, 0x0a, 0x07, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x79, 0x6e, 0x6f, 0x6e,
+	0x79, 0x6d, 0x53, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x79, 0x6e, 0x6f,
+	0x6e, 0x79, 0x6d, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x53, 0x61, 0x76, 0x65, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79,
+	0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x53, 0x65, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d,
+	0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x10,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x53, 0x65, 0x74,
+	0x12, 0x18, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x12, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x14, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x12, 0x0f, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x15, 0x2e, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6d, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x67, 0x6f, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_objects_proto_rawDescOnce sync.Once
+	file_proto_objects_proto_rawDescData = file_proto_objects_proto_rawDesc
+)
+
+func file_proto_objects_proto_rawDescGZIP() []byte {
+	file_proto_objects_proto_rawDescOnce.Do(func() {
+		file_proto_objects_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_objects_proto_rawDescData)
+	})
+	return file_proto_objects_proto_rawDescData
+}
+
+var file_proto_objects_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_objects_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_proto_objects_proto_goTypes = []interface{}{
+	(IndexStore)(0),                  // 0: IndexStore
+	(WriteRuleAction)(0),             // 1: WriteRuleAction
+	(*Collection)(nil),               // 2: Collection
+	(*WriteRule)(nil),                // 3: WriteRule
+	(*ACLConfig)(nil),                // 4: ACLConfig
+	(*ObjectActionsUsers)(nil),       // 5: ObjectActionsUsers
+	(*PathAccessRules)(nil),          // 6: PathAccessRules
+	(*AccessRules)(nil),              // 7: AccessRules
+	(*Header)(nil),                   // 8: Header
+	(*Object)(nil),                   // 9: Object
+	(*Patch)(nil),                    // 10: Patch
+	(*ObjectList)(nil),               // 11: ObjectList
+	(*CreateCollectionRequest)(nil),  // 12: CreateCollectionRequest
+	(*CreateCollectionResponse)(nil), // 13: CreateCollectionResponse
+	(*GetCollectionRequest)(nil),     // 14: GetCollectionRequest
+	(*GetCollectionResponse)(nil),    // 15: GetCollectionResponse
+	(*ListCollectionsRequest)(nil),   // 16: ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),  // 17: ListCollectionsResponse
+	(*DeleteCollectionRequest)(nil),  // 18: DeleteCollectionRequest
+	(*DeleteCollectionResponse)(nil), // 19: DeleteCollectionResponse
+	(*PutObjectRequest)(nil),         // 20: PutObjectRequest
+	(*PutObjectResponse)(nil),        // 21: PutObjectResponse
+	(*PatchObjectRequest)(nil),       // 22: PatchObjectRequest
+	(*PatchObjectResponse)(nil),      // 23: PatchObjectResponse
+	(*MoveObjectRequest)(nil),        // 24: MoveObjectRequest
+	(*MoveObjectResponse)(nil),       // 25: MoveObjectResponse
+	(*GetObjectRequest)(nil),         // 26: GetObjectRequest
+	(*GetObjectResponse)(nil),        // 27: GetObjectResponse
+	(*DeleteObjectRequest)(nil),      // 28: DeleteObjectRequest
+	(*DeleteObjectResponse)(nil),     // 29: DeleteObjectResponse
+	(*ObjectInfoRequest)(nil),        // 30: ObjectInfoRequest
+	(*ObjectInfoResponse)(nil),       // 31: ObjectInfoResponse
+	(*ListObjectsRequest)(nil),       // 32: ListObjectsRequest
+	(*ListObjectsResponse)(nil),      // 33: ListObjectsResponse
+	(*SearchObjectsRequest)(nil),     // 34: SearchObjectsRequest
+	(*SearchCollectionsRequest)(nil), // 35: SearchCollectionsRequest
+	(*SuggestionsRequest)(nil),       // 36: SuggestionsRequest
+	(*SaveSynonymSetRequest)(nil),    // 37: SaveSynonymSetRequest
+	(*SaveSynonymSetResponse)(nil),   // 38: SaveSynonymSetResponse
+	(*ListSynonymSetsRequest)(nil),   // 39: ListSynonymSetsRequest
+	(*ListSynonymSetsResponse)(nil),  // 40: ListSynonymSetsResponse
+	(*DeleteSynonymSetRequest)(nil),  // 41: DeleteSynonymSetRequest
+	(*DeleteSynonymSetResponse)(nil), // 42: DeleteSynonymSetResponse
+	(*CheckIndexRequest)(nil),        // 43: CheckIndexRequest
+	(*CheckIndexResponse)(nil),       // 44: CheckIndexResponse
+	(*IndexReport)(nil),              // 45: IndexReport
+	(*ExplainSearchRequest)(nil),     // 46: ExplainSearchRequest
+	(*ExplainSearchResponse)(nil),    // 47: ExplainSearchResponse
+	nil,                              // 48: PathAccessRules.AccessRulesEntry
+	nil,                              // 49: Header.ActionAuthorizedUsersForPathsEntry
+	(*NumberIndex)(nil),              // 50: NumberIndex
+	(*TextIndex)(nil),                // 51: TextIndex
+	(*PropertiesIndex)(nil),          // 52: PropertiesIndex
+	(*GeoIndex)(nil),                 // 53: GeoIndex
+	(*SubjectSet)(nil),               // 54: SubjectSet
+	(*Highlight)(nil),                // 55: Highlight
+	(*FacetResult)(nil),              // 56: FacetResult
+	(*SearchQuery)(nil),              // 57: SearchQuery
+	(*Facet)(nil),                    // 58: Facet
+	(*GeoPoint)(nil),                 // 59: GeoPoint
+	(*SynonymSet)(nil),               // 60: SynonymSet
+	(*SearchExplanation)(nil),        // 61: SearchExplanation
+	(*ObjectTokensRequest)(nil),      // 62: ObjectTokensRequest
+	(*TokenDocumentsRequest)(nil),    // 63: TokenDocumentsRequest
+	(*AnalyzeRequest)(nil),           // 64: AnalyzeRequest
+	(*SuggestResponse)(nil),          // 65: SuggestResponse
+	(*ObjectTokensResponse)(nil),     // 66: ObjectTokensResponse
+	(*TokenDocumentsResponse)(nil),   // 67: TokenDocumentsResponse
+	(*AnalyzeResponse)(nil),          // 68: AnalyzeResponse
+}
+var file_proto_objects_proto_depIdxs = []int32{
+	50, // 0: Collection.number_index:type_name -> NumberIndex
+	51, // 1: Collection.text_indexes:type_name -> TextIndex
+	52, // 2: Collection.fields_index:type_name -> PropertiesIndex
+	6,  // 3: Collection.action_authorized_users:type_name -> PathAccessRules
+	4,  // 4: Collection.acl_config:type_name -> ACLConfig
+	3,  // 5: Collection.write_rules:type_name -> WriteRule
+	0,  // 6: Collection.index_store:type_name -> IndexStore
+	53, // 7: Collection.geo_index:type_name -> GeoIndex
+	1,  // 8: WriteRule.action:type_name -> WriteRuleAction
+	54, // 9: ObjectActionsUsers.view:type_name -> SubjectSet
+	54, // 10: ObjectActionsUsers.edit:type_name -> SubjectSet
+	54, // 11: ObjectActionsUsers.delete:type_name -> SubjectSet
+	48, // 12: PathAccessRules.access_rules:type_name -> PathAccessRules.AccessRulesEntry
+	49, // 13: Header.action_authorized_users_for_paths:type_name -> Header.ActionAuthorizedUsersForPathsEntry
+	8,  // 14: Object.header:type_name -> Header
+	55, // 15: Object.highlights:type_name -> Highlight
+	56, // 16: Object.facets:type_name -> FacetResult
+	9,  // 17: ObjectList.objects:type_name -> Object
+	2,  // 18: CreateCollectionRequest.collection:type_name -> Collection
+	2,  // 19: GetCollectionResponse.collection:type_name -> Collection
+	2,  // 20: ListCollectionsResponse.collections:type_name -> Collection
+	9,  // 21: PutObjectRequest.object:type_name -> Object
+	51, // 22: PutObjectRequest.indexes:type_name -> TextIndex
+	6,  // 23: PutObjectRequest.action_authorized_users:type_name -> PathAccessRules
+	10, // 24: PatchObjectRequest.patch:type_name -> Patch
+	6,  // 25: MoveObjectRequest.access_security_rules:type_name -> PathAccessRules
+	9,  // 26: GetObjectResponse.object:type_name -> Object
+	8,  // 27: ObjectInfoResponse.header:type_name -> Header
+	11, // 28: ListObjectsResponse.result:type_name -> ObjectList
+	57, // 29: SearchObjectsRequest.query:type_name -> SearchQuery
+	58, // 30: SearchObjectsRequest.facets:type_name -> Facet
+	59, // 31: SearchObjectsRequest.sort_by_distance:type_name -> GeoPoint
+	57, // 32: SearchCollectionsRequest.query:type_name -> SearchQuery
+	59, // 33: SearchCollectionsRequest.sort_by_distance:type_name -> GeoPoint
+	60, // 34: SaveSynonymSetRequest.set:type_name -> SynonymSet
+	60, // 35: ListSynonymSetsResponse.sets:type_name -> SynonymSet
+	45, // 36: CheckIndexResponse.report:type_name -> IndexReport
+	57, // 37: ExplainSearchRequest.query:type_name -> SearchQuery
+	61, // 38: ExplainSearchResponse.explanation:type_name -> SearchExplanation
+	5,  // 39: PathAccessRules.AccessRulesEntry.value:type_name -> ObjectActionsUsers
+	5,  // 40: Header.ActionAuthorizedUsersForPathsEntry.value:type_name -> ObjectActionsUsers
+	12, // 41: Objects.CreateCollection:input_type -> CreateCollectionRequest
+	14, // 42: Objects.GetCollection:input_type -> GetCollectionRequest
+	16, // 43: Objects.ListCollections:input_type -> ListCollectionsRequest
+	18, // 44: Objects.DeleteCollection:input_type -> DeleteCollectionRequest
+	20, // 45: Objects.PutObject:input_type -> PutObjectRequest
+	22, // 46: Objects.PatchObject:input_type -> PatchObjectRequest
+	24, // 47: Objects.MoveObject:input_type -> MoveObjectRequest
+	26, // 48: Objects.GetObject:input_type -> GetObjectRequest
+	28, // 49: Objects.DeleteObject:input_type -> DeleteObjectRequest
+	30, // 50: Objects.ObjectInfo:input_type -> ObjectInfoRequest
+	32, // 51: Objects.ListObjects:input_type -> ListObjectsRequest
+	34, // 52: Objects.SearchObjects:input_type -> SearchObjectsRequest
+	35, // 53: Objects.SearchCollections:input_type -> SearchCollectionsRequest
+	36, // 54: Objects.Suggest:input_type -> SuggestionsRequest
+	37, // 55: Objects.SaveSynonymSet:input_type -> SaveSynonymSetRequest
+	39, // 56: Objects.ListSynonymSets:input_type -> ListSynonymSetsRequest
+	41, // 57: Objects.DeleteSynonymSet:input_type -> DeleteSynonymSetRequest
+	43, // 58: Objects.CheckIndex:input_type -> CheckIndexRequest
+	62, // 59: Objects.ObjectTokens:input_type -> ObjectTokensRequest
+	63, // 60: Objects.TokenDocuments:input_type -> TokenDocumentsRequest
+	64, // 61: Objects.Analyze:input_type -> AnalyzeRequest
+	46, // 62: Objects.ExplainSearch:input_type -> ExplainSearchRequest
+	13, // 63: Objects.CreateCollection:output_type -> CreateCollectionResponse
+	15, // 64: Objects.GetCollection:output_type -> GetCollectionResponse
+	17, // 65: Objects.ListCollections:output_type -> ListCollectionsResponse
+	19, // 66: Objects.DeleteCollection:output_type -> DeleteCollectionResponse
+	21, // 67: Objects.PutObject:output_type -> PutObjectResponse
+	23, // 68: Objects.PatchObject:output_type -> PatchObjectResponse
+	25, // 69: Objects.MoveObject:output_type -> MoveObjectResponse
+	27, // 70: Objects.GetObject:output_type -> GetObjectResponse
+	29, // 71: Objects.DeleteObject:output_type -> DeleteObjectResponse
+	31, // 72: Objects.ObjectInfo:output_type -> ObjectInfoResponse
+	9,  // 73: Objects.ListObjects:output_type -> Object
+	9,  // 74: Objects.SearchObjects:output_type -> Object
+	9,  // 75: Objects.SearchCollections:output_type -> Object
+	65, // 76: Objects.Suggest:output_type -> SuggestResponse
+	38, // 77: Objects.SaveSynonymSet:output_type -> SaveSynonymSetResponse
+	40, // 78: Objects.ListSynonymSets:output_type -> ListSynonymSetsResponse
+	42, // 79: Objects.DeleteSynonymSet:output_type -> DeleteSynonymSetResponse
+	44, // 80: Objects.CheckIndex:output_type -> CheckIndexResponse
+	66, // 81: Objects.ObjectTokens:output_type -> ObjectTokensResponse
+	67, // 82: Objects.TokenDocuments:output_type -> TokenDocumentsResponse
+	68, // 83: Objects.Analyze:output_type -> AnalyzeResponse
+	47, // 84: Objects.ExplainSearch:output_type -> ExplainSearchResponse
+	63, // [63:85] is the sub-list for method output_type
+	41, // [41:63] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
+}
+
+func init() { file_proto_objects_proto_init() }
+func file_proto_objects_proto_init() {
+	if File_proto_objects_proto != nil {
+		return
+	}
+	file_proto_se_proto_init()
+	file_proto_acl_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_proto_objects_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Collection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
 				return nil
 			}
 		}
 		file_proto_objects_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ACLConfig); i {
+			switch v := v.(*WriteRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2177,7 +3598,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObjectActionsUsers); i {
+			switch v := v.(*ACLConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2189,7 +3610,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PathAccessRules); i {
+			switch v := v.(*ObjectActionsUsers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2201,7 +3622,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessRules); i {
+			switch v := v.(*PathAccessRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2213,7 +3634,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Header); i {
+			switch v := v.(*AccessRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2225,7 +3646,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Object); i {
+			switch v := v.(*Header); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2237,7 +3658,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Patch); i {
+			switch v := v.(*Object); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2249,7 +3670,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObjectList); i {
+			switch v := v.(*Patch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2261,7 +3682,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCollectionRequest); i {
+			switch v := v.(*ObjectList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2273,7 +3694,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCollectionResponse); i {
+			switch v := v.(*CreateCollectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2285,7 +3706,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCollectionRequest); i {
+			switch v := v.(*CreateCollectionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2297,7 +3718,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCollectionResponse); i {
+			switch v := v.(*GetCollectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2309,7 +3730,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCollectionsRequest); i {
+			switch v := v.(*GetCollectionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2321,7 +3742,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCollectionsResponse); i {
+			switch v := v.(*ListCollectionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2333,7 +3754,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCollectionRequest); i {
+			switch v := v.(*ListCollectionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2345,7 +3766,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCollectionResponse); i {
+			switch v := v.(*DeleteCollectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2357,7 +3778,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutObjectRequest); i {
+			switch v := v.(*DeleteCollectionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2369,7 +3790,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutObjectResponse); i {
+			switch v := v.(*PutObjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2381,7 +3802,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PatchObjectRequest); i {
+			switch v := v.(*PutObjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2393,7 +3814,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PatchObjectResponse); i {
+			switch v := v.(*PatchObjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2405,7 +3826,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveObjectRequest); i {
+			switch v := v.(*PatchObjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2417,7 +3838,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveObjectResponse); i {
+			switch v := v.(*MoveObjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2429,7 +3850,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetObjectRequest); i {
+			switch v := v.(*MoveObjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2441,7 +3862,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetObjectResponse); i {
+			switch v := v.(*GetObjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2453,7 +3874,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteObjectRequest); i {
+			switch v := v.(*GetObjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2465,7 +3886,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteObjectResponse); i {
+			switch v := v.(*DeleteObjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2477,7 +3898,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObjectInfoRequest); i {
+			switch v := v.(*DeleteObjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2489,7 +3910,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObjectInfoResponse); i {
+			switch v := v.(*ObjectInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2501,7 +3922,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListObjectsRequest); i {
+			switch v := v.(*ObjectInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2513,7 +3934,7 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListObjectsResponse); i {
+			switch v := v.(*ListObjectsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2525,6 +3946,18 @@ func file_proto_objects_proto_init() {
 			}
 		}
 		file_proto_objects_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListObjectsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_objects_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchObjectsRequest); i {
 			case 0:
 				return &v.state
@@ -2536,19 +3969,176 @@ func file_proto_objects_proto_init() {
 				return nil
 			}
 		}
+		file_proto_objects_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchCollectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_objects_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_objects_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveSynonymSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_objects_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveSynonymSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_objects_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSynonymSetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_objects_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSynonymSetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_objects_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSynonymSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_objects_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSynonymSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_objects_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckIndexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_objects_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckIndexResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_objects_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_objects_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_objects_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainSearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_objects_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   34,
+			NumEnums:      2,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_objects_proto_goTypes,
 		DependencyIndexes: file_proto_objects_proto_depIdxs,
+		EnumInfos:         file_proto_objects_proto_enumTypes,
 		MessageInfos:      file_proto_objects_proto_msgTypes,
 	}.Build()
 	File_proto_objects_proto = out.File
@@ -29,6 +29,16 @@ type ObjectsClient interface {
 	ObjectInfo(ctx context.Context, in *ObjectInfoRequest, opts ...grpc.CallOption) (*ObjectInfoResponse, error)
 	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (Objects_ListObjectsClient, error)
 	SearchObjects(ctx context.Context, in *SearchObjectsRequest, opts ...grpc.CallOption) (Objects_SearchObjectsClient, error)
+	SearchCollections(ctx context.Context, in *SearchCollectionsRequest, opts ...grpc.CallOption) (Objects_SearchCollectionsClient, error)
+	Suggest(ctx context.Context, in *SuggestionsRequest, opts ...grpc.CallOption) (*SuggestResponse, error)
+	SaveSynonymSet(ctx context.Context, in *SaveSynonymSetRequest, opts ...grpc.CallOption) (*SaveSynonymSetResponse, error)
+	ListSynonymSets(ctx context.Context, in *ListSynonymSetsRequest, opts ...grpc.CallOption) (*ListSynonymSetsResponse, error)
+	DeleteSynonymSet(ctx context.Context, in *DeleteSynonymSetRequest, opts ...grpc.CallOption) (*DeleteSynonymSetResponse, error)
+	CheckIndex(ctx context.Context, in *CheckIndexRequest, opts ...grpc.CallOption) (*CheckIndexResponse, error)
+	ObjectTokens(ctx context.Context, in *ObjectTokensRequest, opts ...grpc.CallOption) (*ObjectTokensResponse, error)
+	TokenDocuments(ctx context.Context, in *TokenDocumentsRequest, opts ...grpc.CallOption) (*TokenDocumentsResponse, error)
+	Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
+	ExplainSearch(ctx context.Context, in *ExplainSearchRequest, opts ...grpc.CallOption) (*ExplainSearchResponse, error)
 }
 
 type objectsClient struct {
@@ -193,6 +203,119 @@ func (x *objectsSearchObjectsClient) Recv() (*Object, error) {
 	return m, nil
 }
 
+func (c *objectsClient) SearchCollections(ctx context.Context, in *SearchCollectionsRequest, opts ...grpc.CallOption) (Objects_SearchCollectionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Objects_serviceDesc.Streams[2], "/Objects/SearchCollections", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &objectsSearchCollectionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Objects_SearchCollectionsClient interface {
+	Recv() (*Object, error)
+	grpc.ClientStream
+}
+
+type objectsSearchCollectionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectsSearchCollectionsClient) Recv() (*Object, error) {
+	m := new(Object)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *objectsClient) Suggest(ctx context.Context, in *SuggestionsRequest, opts ...grpc.CallOption) (*SuggestResponse, error) {
+	out := new(SuggestResponse)
+	err := c.cc.Invoke(ctx, "/Objects/Suggest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectsClient) SaveSynonymSet(ctx context.Context, in *SaveSynonymSetRequest, opts ...grpc.CallOption) (*SaveSynonymSetResponse, error) {
+	out := new(SaveSynonymSetResponse)
+	err := c.cc.Invoke(ctx, "/Objects/SaveSynonymSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectsClient) ListSynonymSets(ctx context.Context, in *ListSynonymSetsRequest, opts ...grpc.CallOption) (*ListSynonymSetsResponse, error) {
+	out := new(ListSynonymSetsResponse)
+	err := c.cc.Invoke(ctx, "/Objects/ListSynonymSets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectsClient) DeleteSynonymSet(ctx context.Context, in *DeleteSynonymSetRequest, opts ...grpc.CallOption) (*DeleteSynonymSetResponse, error) {
+	out := new(DeleteSynonymSetResponse)
+	err := c.cc.Invoke(ctx, "/Objects/DeleteSynonymSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectsClient) CheckIndex(ctx context.Context, in *CheckIndexRequest, opts ...grpc.CallOption) (*CheckIndexResponse, error) {
+	out := new(CheckIndexResponse)
+	err := c.cc.Invoke(ctx, "/Objects/CheckIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectsClient) ObjectTokens(ctx context.Context, in *ObjectTokensRequest, opts ...grpc.CallOption) (*ObjectTokensResponse, error) {
+	out := new(ObjectTokensResponse)
+	err := c.cc.Invoke(ctx, "/Objects/ObjectTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectsClient) TokenDocuments(ctx context.Context, in *TokenDocumentsRequest, opts ...grpc.CallOption) (*TokenDocumentsResponse, error) {
+	out := new(TokenDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/Objects/TokenDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectsClient) Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error) {
+	out := new(AnalyzeResponse)
+	err := c.cc.Invoke(ctx, "/Objects/Analyze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectsClient) ExplainSearch(ctx context.Context, in *ExplainSearchRequest, opts ...grpc.CallOption) (*ExplainSearchResponse, error) {
+	out := new(ExplainSearchResponse)
+	err := c.cc.Invoke(ctx, "/Objects/ExplainSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ObjectsServer is the server API for Objects service.
 // All implementations must embed UnimplementedObjectsServer
 // for forward compatibility
@@ -209,6 +332,16 @@ type ObjectsServer interface {
 	ObjectInfo(context.Context, *ObjectInfoRequest) (*ObjectInfoResponse, error)
 	ListObjects(*ListObjectsRequest, Objects_ListObjectsServer) error
 	SearchObjects(*SearchObjectsRequest, Objects_SearchObjectsServer) error
+	SearchCollections(*SearchCollectionsRequest, Objects_SearchCollectionsServer) error
+	Suggest(context.Context, *SuggestionsRequest) (*SuggestResponse, error)
+	SaveSynonymSet(context.Context, *SaveSynonymSetRequest) (*SaveSynonymSetResponse, error)
+	ListSynonymSets(context.Context, *ListSynonymSetsRequest) (*ListSynonymSetsResponse, error)
+	DeleteSynonymSet(context.Context, *DeleteSynonymSetRequest) (*DeleteSynonymSetResponse, error)
+	CheckIndex(context.Context, *CheckIndexRequest) (*CheckIndexResponse, error)
+	ObjectTokens(context.Context, *ObjectTokensRequest) (*ObjectTokensResponse, error)
+	TokenDocuments(context.Context, *TokenDocumentsRequest) (*TokenDocumentsResponse, error)
+	Analyze(context.Context, *AnalyzeRequest) (*AnalyzeResponse, error)
+	ExplainSearch(context.Context, *ExplainSearchRequest) (*ExplainSearchResponse, error)
 	mustEmbedUnimplementedObjectsServer()
 }
 
@@ -252,6 +385,36 @@ func (UnimplementedObjectsServer) ListObjects(*ListObjectsRequest, Objects_ListO
 func (UnimplementedObjectsServer) SearchObjects(*SearchObjectsRequest, Objects_SearchObjectsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SearchObjects not implemented")
 }
+func (UnimplementedObjectsServer) SearchCollections(*SearchCollectionsRequest, Objects_SearchCollectionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SearchCollections not implemented")
+}
+func (UnimplementedObjectsServer) Suggest(context.Context, *SuggestionsRequest) (*SuggestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
+func (UnimplementedObjectsServer) SaveSynonymSet(context.Context, *SaveSynonymSetRequest) (*SaveSynonymSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSynonymSet not implemented")
+}
+func (UnimplementedObjectsServer) ListSynonymSets(context.Context, *ListSynonymSetsRequest) (*ListSynonymSetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSynonymSets not implemented")
+}
+func (UnimplementedObjectsServer) DeleteSynonymSet(context.Context, *DeleteSynonymSetRequest) (*DeleteSynonymSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSynonymSet not implemented")
+}
+func (UnimplementedObjectsServer) CheckIndex(context.Context, *CheckIndexRequest) (*CheckIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIndex not implemented")
+}
+func (UnimplementedObjectsServer) ObjectTokens(context.Context, *ObjectTokensRequest) (*ObjectTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectTokens not implemented")
+}
+func (UnimplementedObjectsServer) TokenDocuments(context.Context, *TokenDocumentsRequest) (*TokenDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenDocuments not implemented")
+}
+func (UnimplementedObjectsServer) Analyze(context.Context, *AnalyzeRequest) (*AnalyzeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Analyze not implemented")
+}
+func (UnimplementedObjectsServer) ExplainSearch(context.Context, *ExplainSearchRequest) (*ExplainSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainSearch not implemented")
+}
 func (UnimplementedObjectsServer) mustEmbedUnimplementedObjectsServer() {}
 
 // UnsafeObjectsServer may be embedded to opt out of forward compatibility for this service.
@@ -487,6 +650,189 @@ func (x *objectsSearchObjectsServer) Send(m *Object) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Objects_SearchCollections_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchCollectionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ObjectsServer).SearchCollections(m, &objectsSearchCollectionsServer{stream})
+}
+
+type Objects_SearchCollectionsServer interface {
+	Send(*Object) error
+	grpc.ServerStream
+}
+
+type objectsSearchCollectionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *objectsSearchCollectionsServer) Send(m *Object) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Objects_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectsServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Objects/Suggest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectsServer).Suggest(ctx, req.(*SuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Objects_SaveSynonymSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSynonymSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectsServer).SaveSynonymSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Objects/SaveSynonymSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectsServer).SaveSynonymSet(ctx, req.(*SaveSynonymSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Objects_ListSynonymSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSynonymSetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectsServer).ListSynonymSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Objects/ListSynonymSets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectsServer).ListSynonymSets(ctx, req.(*ListSynonymSetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Objects_DeleteSynonymSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSynonymSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectsServer).DeleteSynonymSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Objects/DeleteSynonymSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectsServer).DeleteSynonymSet(ctx, req.(*DeleteSynonymSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Objects_CheckIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectsServer).CheckIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Objects/CheckIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectsServer).CheckIndex(ctx, req.(*CheckIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Objects_ObjectTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectsServer).ObjectTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Objects/ObjectTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectsServer).ObjectTokens(ctx, req.(*ObjectTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Objects_TokenDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectsServer).TokenDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Objects/TokenDocuments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectsServer).TokenDocuments(ctx, req.(*TokenDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Objects_Analyze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectsServer).Analyze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Objects/Analyze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectsServer).Analyze(ctx, req.(*AnalyzeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Objects_ExplainSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectsServer).ExplainSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Objects/ExplainSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectsServer).ExplainSearch(ctx, req.(*ExplainSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Objects_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "Objects",
 	HandlerType: (*ObjectsServer)(nil),
@@ -531,6 +877,42 @@ var _Objects_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ObjectInfo",
 			Handler:    _Objects_ObjectInfo_Handler,
 		},
+		{
+			MethodName: "Suggest",
+			Handler:    _Objects_Suggest_Handler,
+		},
+		{
+			MethodName: "SaveSynonymSet",
+			Handler:    _Objects_SaveSynonymSet_Handler,
+		},
+		{
+			MethodName: "ListSynonymSets",
+			Handler:    _Objects_ListSynonymSets_Handler,
+		},
+		{
+			MethodName: "DeleteSynonymSet",
+			Handler:    _Objects_DeleteSynonymSet_Handler,
+		},
+		{
+			MethodName: "CheckIndex",
+			Handler:    _Objects_CheckIndex_Handler,
+		},
+		{
+			MethodName: "ObjectTokens",
+			Handler:    _Objects_ObjectTokens_Handler,
+		},
+		{
+			MethodName: "TokenDocuments",
+			Handler:    _Objects_TokenDocuments_Handler,
+		},
+		{
+			MethodName: "Analyze",
+			Handler:    _Objects_Analyze_Handler,
+		},
+		{
+			MethodName: "ExplainSearch",
+			Handler:    _Objects_ExplainSearch_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -543,6 +925,11 @@ var _Objects_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Objects_SearchObjects_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SearchCollections",
+			Handler:       _Objects_SearchCollections_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "proto/objects.proto",
 }
@@ -25,6 +25,64 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// ValueType is the type of the values indexed for a field. Timestamps are ISO-8601 strings indexed as numbers of
+// milliseconds since the unix epoch, so that they are compared and counted like numbers. A field accepts null values
+// whatever its type. Untyped fields get the type of the first value indexed for them
+type ValueType int32
+
+const (
+	ValueType_UntypedValue   ValueType = 0
+	ValueType_StringValue    ValueType = 1
+	ValueType_NumberValue    ValueType = 2
+	ValueType_BooleanValue   ValueType = 3
+	ValueType_TimestampValue ValueType = 4
+)
+
+// Enum value maps for ValueType.
+var (
+	ValueType_name = map[int32]string{
+		0: "UntypedValue",
+		1: "StringValue",
+		2: "NumberValue",
+		3: "BooleanValue",
+		4: "TimestampValue",
+	}
+	ValueType_value = map[string]int32{
+		"UntypedValue":   0,
+		"StringValue":    1,
+		"NumberValue":    2,
+		"BooleanValue":   3,
+		"TimestampValue": 4,
+	}
+)
+
+func (x ValueType) Enum() *ValueType {
+	p := new(ValueType)
+	*p = x
+	return p
+}
+
+func (x ValueType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValueType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_se_proto_enumTypes[0].Descriptor()
+}
+
+func (ValueType) Type() protoreflect.EnumType {
+	return &file_proto_se_proto_enumTypes[0]
+}
+
+func (x ValueType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValueType.Descriptor instead.
+func (ValueType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{0}
+}
+
 // SEARCH ENGINE
 type Index struct {
 	state         protoimpl.MessageState
@@ -35,6 +93,7 @@ type Index struct {
 	//	*Index_Text
 	//	*Index_Number
 	//	*Index_Properties
+	//	*Index_Geo
 	Info isIndex_Info `protobuf_oneof:"info"`
 }
 
@@ -98,6 +157,13 @@ func (x *Index) GetProperties() *PropertiesIndex {
 	return nil
 }
 
+func (x *Index) GetGeo() *GeoIndex {
+	if x, ok := x.GetInfo().(*Index_Geo); ok {
+		return x.Geo
+	}
+	return nil
+}
+
 type isIndex_Info interface {
 	isIndex_Info()
 }
@@ -114,19 +180,27 @@ type Index_Properties struct {
 	Properties *PropertiesIndex `protobuf:"bytes,3,opt,name=properties,proto3,oneof"`
 }
 
+type Index_Geo struct {
+	Geo *GeoIndex `protobuf:"bytes,4,opt,name=geo,proto3,oneof"`
+}
+
 func (*Index_Text) isIndex_Info() {}
 
 func (*Index_Number) isIndex_Info() {}
 
 func (*Index_Properties) isIndex_Info() {}
 
+func (*Index_Geo) isIndex_Info() {}
+
 type TextIndex struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Alias string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Path     string  `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Alias    string  `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Boost    float64 `protobuf:"fixed64,3,opt,name=boost,proto3" json:"boost,omitempty"`
+	Analyzer string  `protobuf:"bytes,4,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
 }
 
 func (x *TextIndex) Reset() {
@@ -175,13 +249,29 @@ func (x *TextIndex) GetAlias() string {
 	return ""
 }
 
+func (x *TextIndex) GetBoost() float64 {
+	if x != nil {
+		return x.Boost
+	}
+	return 0
+}
+
+func (x *TextIndex) GetAnalyzer() string {
+	if x != nil {
+		return x.Analyzer
+	}
+	return ""
+}
+
+// NumberIndex indexes a number or a timestamp. Type records the type of the indexed values
 type NumberIndex struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Alias string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Path  string    `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Alias string    `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Type  ValueType `protobuf:"varint,3,opt,name=type,proto3,enum=ValueType" json:"type,omitempty"`
 }
 
 func (x *NumberIndex) Reset() {
@@ -230,12 +320,21 @@ func (x *NumberIndex) GetAlias() string {
 	return ""
 }
 
+func (x *NumberIndex) GetType() ValueType {
+	if x != nil {
+		return x.Type
+	}
+	return ValueType_UntypedValue
+}
+
+// PropertiesIndex indexes the values of paths under an alias. Types records the type of the values of each alias
 type PropertiesIndex struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Aliases map[string]string `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Aliases map[string]string    `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Types   map[string]ValueType `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=ValueType"`
 }
 
 func (x *PropertiesIndex) Reset() {
@@ -277,6 +376,133 @@ func (x *PropertiesIndex) GetAliases() map[string]string {
 	return nil
 }
 
+func (x *PropertiesIndex) GetTypes() map[string]ValueType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// GeoIndex indexes a point, read from a GeoJSON point at path or from the numbers at latitude_path and longitude_path
+type GeoIndex struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path          string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	LatitudePath  string `protobuf:"bytes,2,opt,name=latitude_path,json=latitudePath,proto3" json:"latitude_path,omitempty"`
+	LongitudePath string `protobuf:"bytes,3,opt,name=longitude_path,json=longitudePath,proto3" json:"longitude_path,omitempty"`
+}
+
+func (x *GeoIndex) Reset() {
+	*x = GeoIndex{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoIndex) ProtoMessage() {}
+
+func (x *GeoIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoIndex.ProtoReflect.Descriptor instead.
+func (*GeoIndex) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GeoIndex) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GeoIndex) GetLatitudePath() string {
+	if x != nil {
+		return x.LatitudePath
+	}
+	return ""
+}
+
+func (x *GeoIndex) GetLongitudePath() string {
+	if x != nil {
+		return x.LongitudePath
+	}
+	return ""
+}
+
+// GeoPoint is a position in degrees. Latitudes go from -90 to 90, longitudes from -180 to 180
+type GeoPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lat float64 `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon float64 `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GeoPoint) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
 type SearchQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -286,13 +512,14 @@ type SearchQuery struct {
 	//	*SearchQuery_Text
 	//	*SearchQuery_Number
 	//	*SearchQuery_Fields
+	//	*SearchQuery_Geo
 	Query isSearchQuery_Query `protobuf_oneof:"query"`
 }
 
 func (x *SearchQuery) Reset() {
 	*x = SearchQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[4]
+		mi := &file_proto_se_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -305,7 +532,7 @@ func (x *SearchQuery) String() string {
 func (*SearchQuery) ProtoMessage() {}
 
 func (x *SearchQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[4]
+	mi := &file_proto_se_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -318,7 +545,7 @@ func (x *SearchQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchQuery.ProtoReflect.Descriptor instead.
 func (*SearchQuery) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{4}
+	return file_proto_se_proto_rawDescGZIP(), []int{6}
 }
 
 func (m *SearchQuery) GetQuery() isSearchQuery_Query {
@@ -349,6 +576,13 @@ func (x *SearchQuery) GetFields() *FieldQuery {
 	return nil
 }
 
+func (x *SearchQuery) GetGeo() *GeoQuery {
+	if x, ok := x.GetQuery().(*SearchQuery_Geo); ok {
+		return x.Geo
+	}
+	return nil
+}
+
 type isSearchQuery_Query interface {
 	isSearchQuery_Query()
 }
@@ -365,12 +599,18 @@ type SearchQuery_Fields struct {
 	Fields *FieldQuery `protobuf:"bytes,3,opt,name=fields,proto3,oneof"`
 }
 
+type SearchQuery_Geo struct {
+	Geo *GeoQuery `protobuf:"bytes,4,opt,name=geo,proto3,oneof"`
+}
+
 func (*SearchQuery_Text) isSearchQuery_Query() {}
 
 func (*SearchQuery_Number) isSearchQuery_Query() {}
 
 func (*SearchQuery_Fields) isSearchQuery_Query() {}
 
+func (*SearchQuery_Geo) isSearchQuery_Query() {}
+
 type StrQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -382,13 +622,17 @@ type StrQuery struct {
 	//	*StrQuery_Contains
 	//	*StrQuery_StartsWith
 	//	*StrQuery_EndsWith
+	//	*StrQuery_Not
+	//	*StrQuery_Fuzzy
+	//	*StrQuery_Phrase
+	//	*StrQuery_Near
 	Bool isStrQuery_Bool `protobuf_oneof:"bool"`
 }
 
 func (x *StrQuery) Reset() {
 	*x = StrQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[5]
+		mi := &file_proto_se_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -401,7 +645,7 @@ func (x *StrQuery) String() string {
 func (*StrQuery) ProtoMessage() {}
 
 func (x *StrQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[5]
+	mi := &file_proto_se_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,7 +658,7 @@ func (x *StrQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StrQuery.ProtoReflect.Descriptor instead.
 func (*StrQuery) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{5}
+	return file_proto_se_proto_rawDescGZIP(), []int{7}
 }
 
 func (m *StrQuery) GetBool() isStrQuery_Bool {
@@ -459,6 +703,34 @@ func (x *StrQuery) GetEndsWith() *EndsWith {
 	return nil
 }
 
+func (x *StrQuery) GetNot() *StrNot {
+	if x, ok := x.GetBool().(*StrQuery_Not); ok {
+		return x.Not
+	}
+	return nil
+}
+
+func (x *StrQuery) GetFuzzy() *Fuzzy {
+	if x, ok := x.GetBool().(*StrQuery_Fuzzy); ok {
+		return x.Fuzzy
+	}
+	return nil
+}
+
+func (x *StrQuery) GetPhrase() *Phrase {
+	if x, ok := x.GetBool().(*StrQuery_Phrase); ok {
+		return x.Phrase
+	}
+	return nil
+}
+
+func (x *StrQuery) GetNear() *Near {
+	if x, ok := x.GetBool().(*StrQuery_Near); ok {
+		return x.Near
+	}
+	return nil
+}
+
 type isStrQuery_Bool interface {
 	isStrQuery_Bool()
 }
@@ -483,6 +755,22 @@ type StrQuery_EndsWith struct {
 	EndsWith *EndsWith `protobuf:"bytes,5,opt,name=ends_with,json=endsWith,proto3,oneof"`
 }
 
+type StrQuery_Not struct {
+	Not *StrNot `protobuf:"bytes,6,opt,name=not,proto3,oneof"`
+}
+
+type StrQuery_Fuzzy struct {
+	Fuzzy *Fuzzy `protobuf:"bytes,7,opt,name=fuzzy,proto3,oneof"`
+}
+
+type StrQuery_Phrase struct {
+	Phrase *Phrase `protobuf:"bytes,8,opt,name=phrase,proto3,oneof"`
+}
+
+type StrQuery_Near struct {
+	Near *Near `protobuf:"bytes,9,opt,name=near,proto3,oneof"`
+}
+
 func (*StrQuery_Or) isStrQuery_Bool() {}
 
 func (*StrQuery_Eq) isStrQuery_Bool() {}
@@ -493,6 +781,14 @@ func (*StrQuery_StartsWith) isStrQuery_Bool() {}
 
 func (*StrQuery_EndsWith) isStrQuery_Bool() {}
 
+func (*StrQuery_Not) isStrQuery_Bool() {}
+
+func (*StrQuery_Fuzzy) isStrQuery_Bool() {}
+
+func (*StrQuery_Phrase) isStrQuery_Bool() {}
+
+func (*StrQuery_Near) isStrQuery_Bool() {}
+
 type NumQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -506,13 +802,14 @@ type NumQuery struct {
 	//	*NumQuery_Lt
 	//	*NumQuery_Lte
 	//	*NumQuery_Eq
+	//	*NumQuery_Not
 	Bool isNumQuery_Bool `protobuf_oneof:"bool"`
 }
 
 func (x *NumQuery) Reset() {
 	*x = NumQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[6]
+		mi := &file_proto_se_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -525,7 +822,7 @@ func (x *NumQuery) String() string {
 func (*NumQuery) ProtoMessage() {}
 
 func (x *NumQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[6]
+	mi := &file_proto_se_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,7 +835,7 @@ func (x *NumQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NumQuery.ProtoReflect.Descriptor instead.
 func (*NumQuery) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{6}
+	return file_proto_se_proto_rawDescGZIP(), []int{8}
 }
 
 func (m *NumQuery) GetBool() isNumQuery_Bool {
@@ -597,6 +894,13 @@ func (x *NumQuery) GetEq() *NumbEq {
 	return nil
 }
 
+func (x *NumQuery) GetNot() *NumNot {
+	if x, ok := x.GetBool().(*NumQuery_Not); ok {
+		return x.Not
+	}
+	return nil
+}
+
 type isNumQuery_Bool interface {
 	isNumQuery_Bool()
 }
@@ -629,6 +933,10 @@ type NumQuery_Eq struct {
 	Eq *NumbEq `protobuf:"bytes,7,opt,name=eq,proto3,oneof"`
 }
 
+type NumQuery_Not struct {
+	Not *NumNot `protobuf:"bytes,8,opt,name=not,proto3,oneof"`
+}
+
 func (*NumQuery_And) isNumQuery_Bool() {}
 
 func (*NumQuery_Or) isNumQuery_Bool() {}
@@ -643,43 +951,40 @@ func (*NumQuery_Lte) isNumQuery_Bool() {}
 
 func (*NumQuery_Eq) isNumQuery_Bool() {}
 
-type FieldQuery struct {
+func (*NumQuery_Not) isNumQuery_Bool() {}
+
+// GeoQuery matches the objects that have an indexed point satisfying its conditions
+type GeoQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Bool:
-	//	*FieldQuery_And
-	//	*FieldQuery_Or
-	//	*FieldQuery_StartsWith
-	//	*FieldQuery_EndsWith
-	//	*FieldQuery_Contains
-	//	*FieldQuery_StrEqual
-	//	*FieldQuery_Lt
-	//	*FieldQuery_Lte
-	//	*FieldQuery_Gt
-	//	*FieldQuery_Gte
-	//	*FieldQuery_NumbEq
-	Bool isFieldQuery_Bool `protobuf_oneof:"bool"`
+	//	*GeoQuery_And
+	//	*GeoQuery_Or
+	//	*GeoQuery_Not
+	//	*GeoQuery_WithinRadius
+	//	*GeoQuery_WithinBox
+	Bool isGeoQuery_Bool `protobuf_oneof:"bool"`
 }
 
-func (x *FieldQuery) Reset() {
-	*x = FieldQuery{}
+func (x *GeoQuery) Reset() {
+	*x = GeoQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[7]
+		mi := &file_proto_se_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FieldQuery) String() string {
+func (x *GeoQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FieldQuery) ProtoMessage() {}
+func (*GeoQuery) ProtoMessage() {}
 
-func (x *FieldQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[7]
+func (x *GeoQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,195 +995,112 @@ func (x *FieldQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FieldQuery.ProtoReflect.Descriptor instead.
-func (*FieldQuery) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GeoQuery.ProtoReflect.Descriptor instead.
+func (*GeoQuery) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{9}
 }
 
-func (m *FieldQuery) GetBool() isFieldQuery_Bool {
+func (m *GeoQuery) GetBool() isGeoQuery_Bool {
 	if m != nil {
 		return m.Bool
 	}
 	return nil
 }
 
-func (x *FieldQuery) GetAnd() *And {
-	if x, ok := x.GetBool().(*FieldQuery_And); ok {
+func (x *GeoQuery) GetAnd() *GeoAnd {
+	if x, ok := x.GetBool().(*GeoQuery_And); ok {
 		return x.And
 	}
 	return nil
 }
 
-func (x *FieldQuery) GetOr() *Or {
-	if x, ok := x.GetBool().(*FieldQuery_Or); ok {
+func (x *GeoQuery) GetOr() *GeoOr {
+	if x, ok := x.GetBool().(*GeoQuery_Or); ok {
 		return x.Or
 	}
 	return nil
 }
 
-func (x *FieldQuery) GetStartsWith() *StartsWith {
-	if x, ok := x.GetBool().(*FieldQuery_StartsWith); ok {
-		return x.StartsWith
+func (x *GeoQuery) GetNot() *GeoNot {
+	if x, ok := x.GetBool().(*GeoQuery_Not); ok {
+		return x.Not
 	}
 	return nil
 }
 
-func (x *FieldQuery) GetEndsWith() *EndsWith {
-	if x, ok := x.GetBool().(*FieldQuery_EndsWith); ok {
-		return x.EndsWith
+func (x *GeoQuery) GetWithinRadius() *WithinRadius {
+	if x, ok := x.GetBool().(*GeoQuery_WithinRadius); ok {
+		return x.WithinRadius
 	}
 	return nil
 }
 
-func (x *FieldQuery) GetContains() *Contains {
-	if x, ok := x.GetBool().(*FieldQuery_Contains); ok {
-		return x.Contains
+func (x *GeoQuery) GetWithinBox() *WithinBox {
+	if x, ok := x.GetBool().(*GeoQuery_WithinBox); ok {
+		return x.WithinBox
 	}
 	return nil
 }
 
-func (x *FieldQuery) GetStrEqual() *StrEqual {
-	if x, ok := x.GetBool().(*FieldQuery_StrEqual); ok {
-		return x.StrEqual
-	}
-	return nil
+type isGeoQuery_Bool interface {
+	isGeoQuery_Bool()
 }
 
-func (x *FieldQuery) GetLt() *Lt {
-	if x, ok := x.GetBool().(*FieldQuery_Lt); ok {
-		return x.Lt
-	}
-	return nil
+type GeoQuery_And struct {
+	And *GeoAnd `protobuf:"bytes,1,opt,name=and,proto3,oneof"`
 }
 
-func (x *FieldQuery) GetLte() *Lte {
-	if x, ok := x.GetBool().(*FieldQuery_Lte); ok {
-		return x.Lte
-	}
-	return nil
+type GeoQuery_Or struct {
+	Or *GeoOr `protobuf:"bytes,2,opt,name=or,proto3,oneof"`
 }
 
-func (x *FieldQuery) GetGt() *Gt {
-	if x, ok := x.GetBool().(*FieldQuery_Gt); ok {
-		return x.Gt
-	}
-	return nil
+type GeoQuery_Not struct {
+	Not *GeoNot `protobuf:"bytes,3,opt,name=not,proto3,oneof"`
 }
 
-func (x *FieldQuery) GetGte() *Gte {
-	if x, ok := x.GetBool().(*FieldQuery_Gte); ok {
-		return x.Gte
-	}
-	return nil
-}
-
-func (x *FieldQuery) GetNumbEq() *NumbEq {
-	if x, ok := x.GetBool().(*FieldQuery_NumbEq); ok {
-		return x.NumbEq
-	}
-	return nil
-}
-
-type isFieldQuery_Bool interface {
-	isFieldQuery_Bool()
-}
-
-type FieldQuery_And struct {
-	And *And `protobuf:"bytes,1,opt,name=and,proto3,oneof"`
-}
-
-type FieldQuery_Or struct {
-	Or *Or `protobuf:"bytes,2,opt,name=or,proto3,oneof"`
-}
-
-type FieldQuery_StartsWith struct {
-	StartsWith *StartsWith `protobuf:"bytes,3,opt,name=starts_with,json=startsWith,proto3,oneof"`
-}
-
-type FieldQuery_EndsWith struct {
-	EndsWith *EndsWith `protobuf:"bytes,4,opt,name=ends_with,json=endsWith,proto3,oneof"`
-}
-
-type FieldQuery_Contains struct {
-	Contains *Contains `protobuf:"bytes,5,opt,name=contains,proto3,oneof"`
-}
-
-type FieldQuery_StrEqual struct {
-	StrEqual *StrEqual `protobuf:"bytes,6,opt,name=str_equal,json=strEqual,proto3,oneof"`
-}
-
-type FieldQuery_Lt struct {
-	Lt *Lt `protobuf:"bytes,7,opt,name=lt,proto3,oneof"`
-}
-
-type FieldQuery_Lte struct {
-	Lte *Lte `protobuf:"bytes,8,opt,name=lte,proto3,oneof"`
-}
-
-type FieldQuery_Gt struct {
-	Gt *Gt `protobuf:"bytes,9,opt,name=gt,proto3,oneof"`
-}
-
-type FieldQuery_Gte struct {
-	Gte *Gte `protobuf:"bytes,10,opt,name=gte,proto3,oneof"`
+type GeoQuery_WithinRadius struct {
+	WithinRadius *WithinRadius `protobuf:"bytes,4,opt,name=within_radius,json=withinRadius,proto3,oneof"`
 }
 
-type FieldQuery_NumbEq struct {
-	NumbEq *NumbEq `protobuf:"bytes,11,opt,name=numb_eq,json=numbEq,proto3,oneof"`
+type GeoQuery_WithinBox struct {
+	WithinBox *WithinBox `protobuf:"bytes,5,opt,name=within_box,json=withinBox,proto3,oneof"`
 }
 
-func (*FieldQuery_And) isFieldQuery_Bool() {}
-
-func (*FieldQuery_Or) isFieldQuery_Bool() {}
-
-func (*FieldQuery_StartsWith) isFieldQuery_Bool() {}
-
-func (*FieldQuery_EndsWith) isFieldQuery_Bool() {}
-
-func (*FieldQuery_Contains) isFieldQuery_Bool() {}
-
-func (*FieldQuery_StrEqual) isFieldQuery_Bool() {}
-
-func (*FieldQuery_Lt) isFieldQuery_Bool() {}
+func (*GeoQuery_And) isGeoQuery_Bool() {}
 
-func (*FieldQuery_Lte) isFieldQuery_Bool() {}
+func (*GeoQuery_Or) isGeoQuery_Bool() {}
 
-func (*FieldQuery_Gt) isFieldQuery_Bool() {}
+func (*GeoQuery_Not) isGeoQuery_Bool() {}
 
-func (*FieldQuery_Gte) isFieldQuery_Bool() {}
+func (*GeoQuery_WithinRadius) isGeoQuery_Bool() {}
 
-func (*FieldQuery_NumbEq) isFieldQuery_Bool() {}
+func (*GeoQuery_WithinBox) isGeoQuery_Bool() {}
 
-type MessageFeed struct {
+type GeoAnd struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Message:
-	//	*MessageFeed_NumMapping
-	//	*MessageFeed_TextMapping
-	//	*MessageFeed_PropertiesMapping
-	//	*MessageFeed_Delete
-	Message isMessageFeed_Message `protobuf_oneof:"message"`
+	Queries []*GeoQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
 }
 
-func (x *MessageFeed) Reset() {
-	*x = MessageFeed{}
+func (x *GeoAnd) Reset() {
+	*x = GeoAnd{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[8]
+		mi := &file_proto_se_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MessageFeed) String() string {
+func (x *GeoAnd) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageFeed) ProtoMessage() {}
+func (*GeoAnd) ProtoMessage() {}
 
-func (x *MessageFeed) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[8]
+func (x *GeoAnd) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -889,101 +1111,90 @@ func (x *MessageFeed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageFeed.ProtoReflect.Descriptor instead.
-func (*MessageFeed) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{8}
-}
-
-func (m *MessageFeed) GetMessage() isMessageFeed_Message {
-	if m != nil {
-		return m.Message
-	}
-	return nil
+// Deprecated: Use GeoAnd.ProtoReflect.Descriptor instead.
+func (*GeoAnd) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MessageFeed) GetNumMapping() *NumberMapping {
-	if x, ok := x.GetMessage().(*MessageFeed_NumMapping); ok {
-		return x.NumMapping
+func (x *GeoAnd) GetQueries() []*GeoQuery {
+	if x != nil {
+		return x.Queries
 	}
 	return nil
 }
 
-func (x *MessageFeed) GetTextMapping() *TextMapping {
-	if x, ok := x.GetMessage().(*MessageFeed_TextMapping); ok {
-		return x.TextMapping
-	}
-	return nil
-}
+type GeoOr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *MessageFeed) GetPropertiesMapping() *PropertiesMapping {
-	if x, ok := x.GetMessage().(*MessageFeed_PropertiesMapping); ok {
-		return x.PropertiesMapping
-	}
-	return nil
+	Queries []*GeoQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
 }
 
-func (x *MessageFeed) GetDelete() *ObjectDeletedNotification {
-	if x, ok := x.GetMessage().(*MessageFeed_Delete); ok {
-		return x.Delete
+func (x *GeoOr) Reset() {
+	*x = GeoOr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isMessageFeed_Message interface {
-	isMessageFeed_Message()
+func (x *GeoOr) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type MessageFeed_NumMapping struct {
-	NumMapping *NumberMapping `protobuf:"bytes,1,opt,name=num_mapping,json=numMapping,proto3,oneof"`
-}
+func (*GeoOr) ProtoMessage() {}
 
-type MessageFeed_TextMapping struct {
-	TextMapping *TextMapping `protobuf:"bytes,2,opt,name=text_mapping,json=textMapping,proto3,oneof"`
+func (x *GeoOr) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type MessageFeed_PropertiesMapping struct {
-	PropertiesMapping *PropertiesMapping `protobuf:"bytes,3,opt,name=properties_mapping,json=propertiesMapping,proto3,oneof"`
+// Deprecated: Use GeoOr.ProtoReflect.Descriptor instead.
+func (*GeoOr) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{11}
 }
 
-type MessageFeed_Delete struct {
-	Delete *ObjectDeletedNotification `protobuf:"bytes,4,opt,name=delete,proto3,oneof"`
+func (x *GeoOr) GetQueries() []*GeoQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
 }
 
-func (*MessageFeed_NumMapping) isMessageFeed_Message() {}
-
-func (*MessageFeed_TextMapping) isMessageFeed_Message() {}
-
-func (*MessageFeed_PropertiesMapping) isMessageFeed_Message() {}
-
-func (*MessageFeed_Delete) isMessageFeed_Message() {}
-
-type NumberMapping struct {
+type GeoNot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Number   int64  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ObjectId string `protobuf:"bytes,4,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Expressions *GeoQuery `protobuf:"bytes,1,opt,name=expressions,proto3" json:"expressions,omitempty"`
 }
 
-func (x *NumberMapping) Reset() {
-	*x = NumberMapping{}
+func (x *GeoNot) Reset() {
+	*x = GeoNot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[9]
+		mi := &file_proto_se_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NumberMapping) String() string {
+func (x *GeoNot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumberMapping) ProtoMessage() {}
+func (*GeoNot) ProtoMessage() {}
 
-func (x *NumberMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[9]
+func (x *GeoNot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,60 +1205,45 @@ func (x *NumberMapping) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumberMapping.ProtoReflect.Descriptor instead.
-func (*NumberMapping) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *NumberMapping) GetNumber() int64 {
-	if x != nil {
-		return x.Number
-	}
-	return 0
-}
-
-func (x *NumberMapping) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use GeoNot.ProtoReflect.Descriptor instead.
+func (*GeoNot) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *NumberMapping) GetObjectId() string {
+func (x *GeoNot) GetExpressions() *GeoQuery {
 	if x != nil {
-		return x.ObjectId
+		return x.Expressions
 	}
-	return ""
+	return nil
 }
 
-type TextMapping struct {
+// WithinRadius matches the points at most meters away from center
+type WithinRadius struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Text              string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Name              string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ObjectId          string `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
-	PrefixMappingSize uint32 `protobuf:"varint,4,opt,name=prefix_mapping_size,json=prefixMappingSize,proto3" json:"prefix_mapping_size,omitempty"`
+	Center *GeoPoint `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
+	Meters float64   `protobuf:"fixed64,2,opt,name=meters,proto3" json:"meters,omitempty"`
 }
 
-func (x *TextMapping) Reset() {
-	*x = TextMapping{}
+func (x *WithinRadius) Reset() {
+	*x = WithinRadius{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[10]
+		mi := &file_proto_se_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TextMapping) String() string {
+func (x *WithinRadius) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextMapping) ProtoMessage() {}
+func (*WithinRadius) ProtoMessage() {}
 
-func (x *TextMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[10]
+func (x *WithinRadius) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,65 +1254,53 @@ func (x *TextMapping) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextMapping.ProtoReflect.Descriptor instead.
-func (*TextMapping) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *TextMapping) GetText() string {
-	if x != nil {
-		return x.Text
-	}
-	return ""
-}
-
-func (x *TextMapping) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use WithinRadius.ProtoReflect.Descriptor instead.
+func (*WithinRadius) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *TextMapping) GetObjectId() string {
+func (x *WithinRadius) GetCenter() *GeoPoint {
 	if x != nil {
-		return x.ObjectId
+		return x.Center
 	}
-	return ""
+	return nil
 }
 
-func (x *TextMapping) GetPrefixMappingSize() uint32 {
+func (x *WithinRadius) GetMeters() float64 {
 	if x != nil {
-		return x.PrefixMappingSize
+		return x.Meters
 	}
 	return 0
 }
 
-type PropertiesMapping struct {
+// WithinBox matches the points between the latitudes and the longitudes of the south west and north east corners.
+// Boxes whose west longitude is greater than their east longitude cross the antimeridian
+type WithinBox struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ObjectId string `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
-	Json     string `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+	SouthWest *GeoPoint `protobuf:"bytes,1,opt,name=south_west,json=southWest,proto3" json:"south_west,omitempty"`
+	NorthEast *GeoPoint `protobuf:"bytes,2,opt,name=north_east,json=northEast,proto3" json:"north_east,omitempty"`
 }
 
-func (x *PropertiesMapping) Reset() {
-	*x = PropertiesMapping{}
+func (x *WithinBox) Reset() {
+	*x = WithinBox{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[11]
+		mi := &file_proto_se_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PropertiesMapping) String() string {
+func (x *WithinBox) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PropertiesMapping) ProtoMessage() {}
+func (*WithinBox) ProtoMessage() {}
 
-func (x *PropertiesMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[11]
+func (x *WithinBox) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,50 +1311,69 @@ func (x *PropertiesMapping) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PropertiesMapping.ProtoReflect.Descriptor instead.
-func (*PropertiesMapping) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use WithinBox.ProtoReflect.Descriptor instead.
+func (*WithinBox) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PropertiesMapping) GetObjectId() string {
+func (x *WithinBox) GetSouthWest() *GeoPoint {
 	if x != nil {
-		return x.ObjectId
+		return x.SouthWest
 	}
-	return ""
+	return nil
 }
 
-func (x *PropertiesMapping) GetJson() string {
+func (x *WithinBox) GetNorthEast() *GeoPoint {
 	if x != nil {
-		return x.Json
+		return x.NorthEast
 	}
-	return ""
+	return nil
 }
 
-type ObjectDeletedNotification struct {
+type FieldQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are assignable to Bool:
+	//	*FieldQuery_And
+	//	*FieldQuery_Or
+	//	*FieldQuery_StartsWith
+	//	*FieldQuery_EndsWith
+	//	*FieldQuery_Contains
+	//	*FieldQuery_StrEqual
+	//	*FieldQuery_Lt
+	//	*FieldQuery_Lte
+	//	*FieldQuery_Gt
+	//	*FieldQuery_Gte
+	//	*FieldQuery_NumbEq
+	//	*FieldQuery_Not
+	//	*FieldQuery_BoolEq
+	//	*FieldQuery_IsNull
+	//	*FieldQuery_Exists
+	//	*FieldQuery_In
+	//	*FieldQuery_AnyOf
+	//	*FieldQuery_AllOf
+	Bool isFieldQuery_Bool `protobuf_oneof:"bool"`
 }
 
-func (x *ObjectDeletedNotification) Reset() {
-	*x = ObjectDeletedNotification{}
+func (x *FieldQuery) Reset() {
+	*x = FieldQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[12]
+		mi := &file_proto_se_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ObjectDeletedNotification) String() string {
+func (x *FieldQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ObjectDeletedNotification) ProtoMessage() {}
+func (*FieldQuery) ProtoMessage() {}
 
-func (x *ObjectDeletedNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[12]
+func (x *FieldQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,279 +1384,289 @@ func (x *ObjectDeletedNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ObjectDeletedNotification.ProtoReflect.Descriptor instead.
-func (*ObjectDeletedNotification) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use FieldQuery.ProtoReflect.Descriptor instead.
+func (*FieldQuery) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ObjectDeletedNotification) GetId() string {
-	if x != nil {
-		return x.Id
+func (m *FieldQuery) GetBool() isFieldQuery_Bool {
+	if m != nil {
+		return m.Bool
 	}
-	return ""
+	return nil
 }
 
-type ResearchRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Query *SearchQuery `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+func (x *FieldQuery) GetAnd() *And {
+	if x, ok := x.GetBool().(*FieldQuery_And); ok {
+		return x.And
+	}
+	return nil
 }
 
-func (x *ResearchRequest) Reset() {
-	*x = ResearchRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *FieldQuery) GetOr() *Or {
+	if x, ok := x.GetBool().(*FieldQuery_Or); ok {
+		return x.Or
 	}
+	return nil
 }
 
-func (x *ResearchRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FieldQuery) GetStartsWith() *StartsWith {
+	if x, ok := x.GetBool().(*FieldQuery_StartsWith); ok {
+		return x.StartsWith
+	}
+	return nil
 }
 
-func (*ResearchRequest) ProtoMessage() {}
-
-func (x *ResearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *FieldQuery) GetEndsWith() *EndsWith {
+	if x, ok := x.GetBool().(*FieldQuery_EndsWith); ok {
+		return x.EndsWith
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ResearchRequest.ProtoReflect.Descriptor instead.
-func (*ResearchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{13}
+func (x *FieldQuery) GetContains() *Contains {
+	if x, ok := x.GetBool().(*FieldQuery_Contains); ok {
+		return x.Contains
+	}
+	return nil
 }
 
-func (x *ResearchRequest) GetQuery() *SearchQuery {
-	if x != nil {
-		return x.Query
+func (x *FieldQuery) GetStrEqual() *StrEqual {
+	if x, ok := x.GetBool().(*FieldQuery_StrEqual); ok {
+		return x.StrEqual
 	}
 	return nil
 }
 
-type SearchResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+func (x *FieldQuery) GetLt() *Lt {
+	if x, ok := x.GetBool().(*FieldQuery_Lt); ok {
+		return x.Lt
+	}
+	return nil
 }
 
-func (x *SearchResult) Reset() {
-	*x = SearchResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *FieldQuery) GetLte() *Lte {
+	if x, ok := x.GetBool().(*FieldQuery_Lte); ok {
+		return x.Lte
 	}
+	return nil
 }
 
-func (x *SearchResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FieldQuery) GetGt() *Gt {
+	if x, ok := x.GetBool().(*FieldQuery_Gt); ok {
+		return x.Gt
+	}
+	return nil
 }
 
-func (*SearchResult) ProtoMessage() {}
+func (x *FieldQuery) GetGte() *Gte {
+	if x, ok := x.GetBool().(*FieldQuery_Gte); ok {
+		return x.Gte
+	}
+	return nil
+}
 
-func (x *SearchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *FieldQuery) GetNumbEq() *NumbEq {
+	if x, ok := x.GetBool().(*FieldQuery_NumbEq); ok {
+		return x.NumbEq
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
-func (*SearchResult) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{14}
+func (x *FieldQuery) GetNot() *Not {
+	if x, ok := x.GetBool().(*FieldQuery_Not); ok {
+		return x.Not
+	}
+	return nil
 }
 
-func (x *SearchResult) GetIds() []string {
-	if x != nil {
-		return x.Ids
+func (x *FieldQuery) GetBoolEq() *BoolEq {
+	if x, ok := x.GetBool().(*FieldQuery_BoolEq); ok {
+		return x.BoolEq
 	}
 	return nil
 }
 
-type FeedResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *FieldQuery) GetIsNull() *IsNull {
+	if x, ok := x.GetBool().(*FieldQuery_IsNull); ok {
+		return x.IsNull
+	}
+	return nil
 }
 
-func (x *FeedResponse) Reset() {
-	*x = FeedResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *FieldQuery) GetExists() *Exists {
+	if x, ok := x.GetBool().(*FieldQuery_Exists); ok {
+		return x.Exists
 	}
+	return nil
 }
 
-func (x *FeedResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FieldQuery) GetIn() *In {
+	if x, ok := x.GetBool().(*FieldQuery_In); ok {
+		return x.In
+	}
+	return nil
 }
 
-func (*FeedResponse) ProtoMessage() {}
+func (x *FieldQuery) GetAnyOf() *AnyOf {
+	if x, ok := x.GetBool().(*FieldQuery_AnyOf); ok {
+		return x.AnyOf
+	}
+	return nil
+}
 
-func (x *FeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *FieldQuery) GetAllOf() *AllOf {
+	if x, ok := x.GetBool().(*FieldQuery_AllOf); ok {
+		return x.AllOf
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use FeedResponse.ProtoReflect.Descriptor instead.
-func (*FeedResponse) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{15}
+type isFieldQuery_Bool interface {
+	isFieldQuery_Bool()
 }
 
-type ResearchResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type FieldQuery_And struct {
+	And *And `protobuf:"bytes,1,opt,name=and,proto3,oneof"`
+}
 
-	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+type FieldQuery_Or struct {
+	Or *Or `protobuf:"bytes,2,opt,name=or,proto3,oneof"`
 }
 
-func (x *ResearchResponse) Reset() {
-	*x = ResearchResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type FieldQuery_StartsWith struct {
+	StartsWith *StartsWith `protobuf:"bytes,3,opt,name=starts_with,json=startsWith,proto3,oneof"`
 }
 
-func (x *ResearchResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type FieldQuery_EndsWith struct {
+	EndsWith *EndsWith `protobuf:"bytes,4,opt,name=ends_with,json=endsWith,proto3,oneof"`
 }
 
-func (*ResearchResponse) ProtoMessage() {}
+type FieldQuery_Contains struct {
+	Contains *Contains `protobuf:"bytes,5,opt,name=contains,proto3,oneof"`
+}
 
-func (x *ResearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type FieldQuery_StrEqual struct {
+	StrEqual *StrEqual `protobuf:"bytes,6,opt,name=str_equal,json=strEqual,proto3,oneof"`
 }
 
-// Deprecated: Use ResearchResponse.ProtoReflect.Descriptor instead.
-func (*ResearchResponse) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{16}
+type FieldQuery_Lt struct {
+	Lt *Lt `protobuf:"bytes,7,opt,name=lt,proto3,oneof"`
 }
 
-func (x *ResearchResponse) GetIds() []string {
-	if x != nil {
-		return x.Ids
-	}
-	return nil
+type FieldQuery_Lte struct {
+	Lte *Lte `protobuf:"bytes,8,opt,name=lte,proto3,oneof"`
 }
 
-// CONDITION
-type StartsWith struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type FieldQuery_Gt struct {
+	Gt *Gt `protobuf:"bytes,9,opt,name=gt,proto3,oneof"`
+}
 
-	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+type FieldQuery_Gte struct {
+	Gte *Gte `protobuf:"bytes,10,opt,name=gte,proto3,oneof"`
 }
 
-func (x *StartsWith) Reset() {
-	*x = StartsWith{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type FieldQuery_NumbEq struct {
+	NumbEq *NumbEq `protobuf:"bytes,11,opt,name=numb_eq,json=numbEq,proto3,oneof"`
 }
 
-func (x *StartsWith) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type FieldQuery_Not struct {
+	Not *Not `protobuf:"bytes,12,opt,name=not,proto3,oneof"`
 }
 
-func (*StartsWith) ProtoMessage() {}
+type FieldQuery_BoolEq struct {
+	BoolEq *BoolEq `protobuf:"bytes,13,opt,name=bool_eq,json=boolEq,proto3,oneof"`
+}
 
-func (x *StartsWith) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type FieldQuery_IsNull struct {
+	IsNull *IsNull `protobuf:"bytes,14,opt,name=is_null,json=isNull,proto3,oneof"`
 }
 
-// Deprecated: Use StartsWith.ProtoReflect.Descriptor instead.
-func (*StartsWith) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{17}
+type FieldQuery_Exists struct {
+	Exists *Exists `protobuf:"bytes,15,opt,name=exists,proto3,oneof"`
 }
 
-func (x *StartsWith) GetField() string {
-	if x != nil {
-		return x.Field
-	}
-	return ""
+type FieldQuery_In struct {
+	In *In `protobuf:"bytes,16,opt,name=in,proto3,oneof"`
 }
 
-func (x *StartsWith) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
+type FieldQuery_AnyOf struct {
+	AnyOf *AnyOf `protobuf:"bytes,17,opt,name=any_of,json=anyOf,proto3,oneof"`
 }
 
-type EndsWith struct {
+type FieldQuery_AllOf struct {
+	AllOf *AllOf `protobuf:"bytes,18,opt,name=all_of,json=allOf,proto3,oneof"`
+}
+
+func (*FieldQuery_And) isFieldQuery_Bool() {}
+
+func (*FieldQuery_Or) isFieldQuery_Bool() {}
+
+func (*FieldQuery_StartsWith) isFieldQuery_Bool() {}
+
+func (*FieldQuery_EndsWith) isFieldQuery_Bool() {}
+
+func (*FieldQuery_Contains) isFieldQuery_Bool() {}
+
+func (*FieldQuery_StrEqual) isFieldQuery_Bool() {}
+
+func (*FieldQuery_Lt) isFieldQuery_Bool() {}
+
+func (*FieldQuery_Lte) isFieldQuery_Bool() {}
+
+func (*FieldQuery_Gt) isFieldQuery_Bool() {}
+
+func (*FieldQuery_Gte) isFieldQuery_Bool() {}
+
+func (*FieldQuery_NumbEq) isFieldQuery_Bool() {}
+
+func (*FieldQuery_Not) isFieldQuery_Bool() {}
+
+func (*FieldQuery_BoolEq) isFieldQuery_Bool() {}
+
+func (*FieldQuery_IsNull) isFieldQuery_Bool() {}
+
+func (*FieldQuery_Exists) isFieldQuery_Bool() {}
+
+func (*FieldQuery_In) isFieldQuery_Bool() {}
+
+func (*FieldQuery_AnyOf) isFieldQuery_Bool() {}
+
+func (*FieldQuery_AllOf) isFieldQuery_Bool() {}
+
+// MessageFeed is a change of the index of the objects of a collection
+type MessageFeed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Types that are assignable to Message:
+	//	*MessageFeed_NumMapping
+	//	*MessageFeed_TextMapping
+	//	*MessageFeed_PropertiesMapping
+	//	*MessageFeed_Delete
+	//	*MessageFeed_GeoMapping
+	Message    isMessageFeed_Message `protobuf_oneof:"message"`
+	Collection string                `protobuf:"bytes,5,opt,name=collection,proto3" json:"collection,omitempty"`
 }
 
-func (x *EndsWith) Reset() {
-	*x = EndsWith{}
+func (x *MessageFeed) Reset() {
+	*x = MessageFeed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[18]
+		mi := &file_proto_se_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EndsWith) String() string {
+func (x *MessageFeed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EndsWith) ProtoMessage() {}
+func (*MessageFeed) ProtoMessage() {}
 
-func (x *EndsWith) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[18]
+func (x *MessageFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,51 +1677,120 @@ func (x *EndsWith) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EndsWith.ProtoReflect.Descriptor instead.
-func (*EndsWith) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use MessageFeed.ProtoReflect.Descriptor instead.
+func (*MessageFeed) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *EndsWith) GetField() string {
-	if x != nil {
-		return x.Field
+func (m *MessageFeed) GetMessage() isMessageFeed_Message {
+	if m != nil {
+		return m.Message
 	}
-	return ""
+	return nil
 }
 
-func (x *EndsWith) GetValue() string {
+func (x *MessageFeed) GetNumMapping() *NumberMapping {
+	if x, ok := x.GetMessage().(*MessageFeed_NumMapping); ok {
+		return x.NumMapping
+	}
+	return nil
+}
+
+func (x *MessageFeed) GetTextMapping() *TextMapping {
+	if x, ok := x.GetMessage().(*MessageFeed_TextMapping); ok {
+		return x.TextMapping
+	}
+	return nil
+}
+
+func (x *MessageFeed) GetPropertiesMapping() *PropertiesMapping {
+	if x, ok := x.GetMessage().(*MessageFeed_PropertiesMapping); ok {
+		return x.PropertiesMapping
+	}
+	return nil
+}
+
+func (x *MessageFeed) GetDelete() *ObjectDeletedNotification {
+	if x, ok := x.GetMessage().(*MessageFeed_Delete); ok {
+		return x.Delete
+	}
+	return nil
+}
+
+func (x *MessageFeed) GetGeoMapping() *GeoMapping {
+	if x, ok := x.GetMessage().(*MessageFeed_GeoMapping); ok {
+		return x.GeoMapping
+	}
+	return nil
+}
+
+func (x *MessageFeed) GetCollection() string {
 	if x != nil {
-		return x.Value
+		return x.Collection
 	}
 	return ""
 }
 
-type Contains struct {
+type isMessageFeed_Message interface {
+	isMessageFeed_Message()
+}
+
+type MessageFeed_NumMapping struct {
+	NumMapping *NumberMapping `protobuf:"bytes,1,opt,name=num_mapping,json=numMapping,proto3,oneof"`
+}
+
+type MessageFeed_TextMapping struct {
+	TextMapping *TextMapping `protobuf:"bytes,2,opt,name=text_mapping,json=textMapping,proto3,oneof"`
+}
+
+type MessageFeed_PropertiesMapping struct {
+	PropertiesMapping *PropertiesMapping `protobuf:"bytes,3,opt,name=properties_mapping,json=propertiesMapping,proto3,oneof"`
+}
+
+type MessageFeed_Delete struct {
+	Delete *ObjectDeletedNotification `protobuf:"bytes,4,opt,name=delete,proto3,oneof"`
+}
+
+type MessageFeed_GeoMapping struct {
+	GeoMapping *GeoMapping `protobuf:"bytes,6,opt,name=geo_mapping,json=geoMapping,proto3,oneof"`
+}
+
+func (*MessageFeed_NumMapping) isMessageFeed_Message() {}
+
+func (*MessageFeed_TextMapping) isMessageFeed_Message() {}
+
+func (*MessageFeed_PropertiesMapping) isMessageFeed_Message() {}
+
+func (*MessageFeed_Delete) isMessageFeed_Message() {}
+
+func (*MessageFeed_GeoMapping) isMessageFeed_Message() {}
+
+type GeoMapping struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ObjectId string    `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Point    *GeoPoint `protobuf:"bytes,2,opt,name=point,proto3" json:"point,omitempty"`
 }
 
-func (x *Contains) Reset() {
-	*x = Contains{}
+func (x *GeoMapping) Reset() {
+	*x = GeoMapping{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[19]
+		mi := &file_proto_se_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Contains) String() string {
+func (x *GeoMapping) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Contains) ProtoMessage() {}
+func (*GeoMapping) ProtoMessage() {}
 
-func (x *Contains) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[19]
+func (x *GeoMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1519,51 +1801,52 @@ func (x *Contains) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Contains.ProtoReflect.Descriptor instead.
-func (*Contains) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GeoMapping.ProtoReflect.Descriptor instead.
+func (*GeoMapping) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Contains) GetField() string {
+func (x *GeoMapping) GetObjectId() string {
 	if x != nil {
-		return x.Field
+		return x.ObjectId
 	}
 	return ""
 }
 
-func (x *Contains) GetValue() string {
+func (x *GeoMapping) GetPoint() *GeoPoint {
 	if x != nil {
-		return x.Value
+		return x.Point
 	}
-	return ""
+	return nil
 }
 
-type StrEqual struct {
+type NumberMapping struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Number   float64 `protobuf:"fixed64,1,opt,name=number,proto3" json:"number,omitempty"`
+	Name     string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ObjectId string  `protobuf:"bytes,4,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
 }
 
-func (x *StrEqual) Reset() {
-	*x = StrEqual{}
+func (x *NumberMapping) Reset() {
+	*x = NumberMapping{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[20]
+		mi := &file_proto_se_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StrEqual) String() string {
+func (x *NumberMapping) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StrEqual) ProtoMessage() {}
+func (*NumberMapping) ProtoMessage() {}
 
-func (x *StrEqual) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[20]
+func (x *NumberMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1574,51 +1857,61 @@ func (x *StrEqual) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StrEqual.ProtoReflect.Descriptor instead.
-func (*StrEqual) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use NumberMapping.ProtoReflect.Descriptor instead.
+func (*NumberMapping) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *StrEqual) GetField() string {
+func (x *NumberMapping) GetNumber() float64 {
 	if x != nil {
-		return x.Field
+		return x.Number
+	}
+	return 0
+}
+
+func (x *NumberMapping) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *StrEqual) GetValue() string {
+func (x *NumberMapping) GetObjectId() string {
 	if x != nil {
-		return x.Value
+		return x.ObjectId
 	}
 	return ""
 }
 
-type Like struct {
+type TextMapping struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Text              string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Name              string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ObjectId          string `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	PrefixMappingSize uint32 `protobuf:"varint,4,opt,name=prefix_mapping_size,json=prefixMappingSize,proto3" json:"prefix_mapping_size,omitempty"`
+	Analyzer          string `protobuf:"bytes,5,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
 }
 
-func (x *Like) Reset() {
-	*x = Like{}
+func (x *TextMapping) Reset() {
+	*x = TextMapping{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[21]
+		mi := &file_proto_se_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Like) String() string {
+func (x *TextMapping) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Like) ProtoMessage() {}
+func (*TextMapping) ProtoMessage() {}
 
-func (x *Like) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[21]
+func (x *TextMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1629,50 +1922,72 @@ func (x *Like) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Like.ProtoReflect.Descriptor instead.
-func (*Like) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use TextMapping.ProtoReflect.Descriptor instead.
+func (*TextMapping) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Like) GetField() string {
+func (x *TextMapping) GetText() string {
 	if x != nil {
-		return x.Field
+		return x.Text
 	}
 	return ""
 }
 
-func (x *Like) GetValue() string {
+func (x *TextMapping) GetName() string {
 	if x != nil {
-		return x.Value
+		return x.Name
 	}
 	return ""
 }
 
-type Not struct {
+func (x *TextMapping) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *TextMapping) GetPrefixMappingSize() uint32 {
+	if x != nil {
+		return x.PrefixMappingSize
+	}
+	return 0
+}
+
+func (x *TextMapping) GetAnalyzer() string {
+	if x != nil {
+		return x.Analyzer
+	}
+	return ""
+}
+
+type PropertiesMapping struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Expressions *FieldQuery `protobuf:"bytes,1,opt,name=expressions,proto3" json:"expressions,omitempty"`
+	ObjectId string `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Json     string `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
 }
 
-func (x *Not) Reset() {
-	*x = Not{}
+func (x *PropertiesMapping) Reset() {
+	*x = PropertiesMapping{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[22]
+		mi := &file_proto_se_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Not) String() string {
+func (x *PropertiesMapping) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Not) ProtoMessage() {}
+func (*PropertiesMapping) ProtoMessage() {}
 
-func (x *Not) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[22]
+func (x *PropertiesMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1683,44 +1998,50 @@ func (x *Not) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Not.ProtoReflect.Descriptor instead.
-func (*Not) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use PropertiesMapping.ProtoReflect.Descriptor instead.
+func (*PropertiesMapping) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Not) GetExpressions() *FieldQuery {
+func (x *PropertiesMapping) GetObjectId() string {
 	if x != nil {
-		return x.Expressions
+		return x.ObjectId
 	}
-	return nil
+	return ""
 }
 
-type Gt struct {
+func (x *PropertiesMapping) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+type ObjectDeletedNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *Gt) Reset() {
-	*x = Gt{}
+func (x *ObjectDeletedNotification) Reset() {
+	*x = ObjectDeletedNotification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[23]
+		mi := &file_proto_se_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Gt) String() string {
+func (x *ObjectDeletedNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Gt) ProtoMessage() {}
+func (*ObjectDeletedNotification) ProtoMessage() {}
 
-func (x *Gt) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[23]
+func (x *ObjectDeletedNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1731,51 +2052,50 @@ func (x *Gt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Gt.ProtoReflect.Descriptor instead.
-func (*Gt) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ObjectDeletedNotification.ProtoReflect.Descriptor instead.
+func (*ObjectDeletedNotification) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Gt) GetField() string {
+func (x *ObjectDeletedNotification) GetId() string {
 	if x != nil {
-		return x.Field
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Gt) GetValue() int64 {
-	if x != nil {
-		return x.Value
-	}
-	return 0
-}
-
-type Gte struct {
+// SuggestRequest asks for the terms indexed for a text field of the objects of a collection that complete a typed
+// prefix. The prefix is analyzed with the analyzer the field texts were indexed with
+type SuggestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Collection  string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Field       string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Prefix      string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Size        uint32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	MaxDistance uint32 `protobuf:"varint,5,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	Analyzer    string `protobuf:"bytes,6,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
 }
 
-func (x *Gte) Reset() {
-	*x = Gte{}
+func (x *SuggestRequest) Reset() {
+	*x = SuggestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[24]
+		mi := &file_proto_se_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Gte) String() string {
+func (x *SuggestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Gte) ProtoMessage() {}
+func (*SuggestRequest) ProtoMessage() {}
 
-func (x *Gte) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[24]
+func (x *SuggestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1786,51 +2106,78 @@ func (x *Gte) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Gte.ProtoReflect.Descriptor instead.
-func (*Gte) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use SuggestRequest.ProtoReflect.Descriptor instead.
+func (*SuggestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Gte) GetField() string {
+func (x *SuggestRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetField() string {
 	if x != nil {
 		return x.Field
 	}
 	return ""
 }
 
-func (x *Gte) GetValue() int64 {
+func (x *SuggestRequest) GetPrefix() string {
 	if x != nil {
-		return x.Value
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetSize() uint32 {
+	if x != nil {
+		return x.Size
 	}
 	return 0
 }
 
-type Lt struct {
+func (x *SuggestRequest) GetMaxDistance() uint32 {
+	if x != nil {
+		return x.MaxDistance
+	}
+	return 0
+}
+
+func (x *SuggestRequest) GetAnalyzer() string {
+	if x != nil {
+		return x.Analyzer
+	}
+	return ""
+}
+
+type SuggestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Suggestions []*Suggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
 }
 
-func (x *Lt) Reset() {
-	*x = Lt{}
+func (x *SuggestResponse) Reset() {
+	*x = SuggestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[25]
+		mi := &file_proto_se_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Lt) String() string {
+func (x *SuggestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Lt) ProtoMessage() {}
+func (*SuggestResponse) ProtoMessage() {}
 
-func (x *Lt) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[25]
+func (x *SuggestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1841,51 +2188,47 @@ func (x *Lt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Lt.ProtoReflect.Descriptor instead.
-func (*Lt) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *Lt) GetField() string {
-	if x != nil {
-		return x.Field
-	}
-	return ""
+// Deprecated: Use SuggestResponse.ProtoReflect.Descriptor instead.
+func (*SuggestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Lt) GetValue() int64 {
+func (x *SuggestResponse) GetSuggestions() []*Suggestion {
 	if x != nil {
-		return x.Value
+		return x.Suggestions
 	}
-	return 0
+	return nil
 }
 
-type Lte struct {
+// MappedIdsRequest lists, in increasing order, at most count ids greater than after of the objects of a collection
+// that have mappings
+type MappedIdsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	After      string `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	Count      uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *Lte) Reset() {
-	*x = Lte{}
+func (x *MappedIdsRequest) Reset() {
+	*x = MappedIdsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[26]
+		mi := &file_proto_se_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Lte) String() string {
+func (x *MappedIdsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Lte) ProtoMessage() {}
+func (*MappedIdsRequest) ProtoMessage() {}
 
-func (x *Lte) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[26]
+func (x *MappedIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1896,51 +2239,57 @@ func (x *Lte) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Lte.ProtoReflect.Descriptor instead.
-func (*Lte) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use MappedIdsRequest.ProtoReflect.Descriptor instead.
+func (*MappedIdsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Lte) GetField() string {
+func (x *MappedIdsRequest) GetCollection() string {
 	if x != nil {
-		return x.Field
+		return x.Collection
 	}
 	return ""
 }
 
-func (x *Lte) GetValue() int64 {
+func (x *MappedIdsRequest) GetAfter() string {
 	if x != nil {
-		return x.Value
+		return x.After
+	}
+	return ""
+}
+
+func (x *MappedIdsRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
 	}
 	return 0
 }
 
-type NumbEq struct {
+type MappedIdsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *NumbEq) Reset() {
-	*x = NumbEq{}
+func (x *MappedIdsResponse) Reset() {
+	*x = MappedIdsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[27]
+		mi := &file_proto_se_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NumbEq) String() string {
+func (x *MappedIdsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumbEq) ProtoMessage() {}
+func (*MappedIdsResponse) ProtoMessage() {}
 
-func (x *NumbEq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[27]
+func (x *MappedIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1951,50 +2300,45 @@ func (x *NumbEq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumbEq.ProtoReflect.Descriptor instead.
-func (*NumbEq) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *NumbEq) GetField() string {
-	if x != nil {
-		return x.Field
-	}
-	return ""
+// Deprecated: Use MappedIdsResponse.ProtoReflect.Descriptor instead.
+func (*MappedIdsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *NumbEq) GetValue() int64 {
+func (x *MappedIdsResponse) GetIds() []string {
 	if x != nil {
-		return x.Value
+		return x.Ids
 	}
-	return 0
+	return nil
 }
 
-type And struct {
+// UnmappedIdsRequest asks which of ids have no mapping in the index of a collection
+type UnmappedIdsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Queries []*FieldQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	Collection string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Ids        []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *And) Reset() {
-	*x = And{}
+func (x *UnmappedIdsRequest) Reset() {
+	*x = UnmappedIdsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[28]
+		mi := &file_proto_se_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *And) String() string {
+func (x *UnmappedIdsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*And) ProtoMessage() {}
+func (*UnmappedIdsRequest) ProtoMessage() {}
 
-func (x *And) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[28]
+func (x *UnmappedIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2005,43 +2349,50 @@ func (x *And) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use And.ProtoReflect.Descriptor instead.
-func (*And) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UnmappedIdsRequest.ProtoReflect.Descriptor instead.
+func (*UnmappedIdsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *And) GetQueries() []*FieldQuery {
+func (x *UnmappedIdsRequest) GetCollection() string {
 	if x != nil {
-		return x.Queries
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *UnmappedIdsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
 	}
 	return nil
 }
 
-type Or struct {
+type UnmappedIdsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Queries []*FieldQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *Or) Reset() {
-	*x = Or{}
+func (x *UnmappedIdsResponse) Reset() {
+	*x = UnmappedIdsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_se_proto_msgTypes[29]
+		mi := &file_proto_se_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Or) String() string {
+func (x *UnmappedIdsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Or) ProtoMessage() {}
+func (*UnmappedIdsResponse) ProtoMessage() {}
 
-func (x *Or) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_se_proto_msgTypes[29]
+func (x *UnmappedIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2052,28 +2403,135 @@ func (x *Or) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Or.ProtoReflect.Descriptor instead.
-func (*Or) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use UnmappedIdsResponse.ProtoReflect.Descriptor instead.
+func (*UnmappedIdsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Or) GetQueries() []*FieldQuery {
+func (x *UnmappedIdsResponse) GetIds() []string {
 	if x != nil {
-		return x.Queries
+		return x.Ids
 	}
 	return nil
 }
 
-type NumAnd struct {
+// ObjectTokensRequest asks for the tokens indexed for the text fields of an object of a collection
+type ObjectTokensRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Queries []*NumQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Id         string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *NumAnd) Reset() {
-	*x = NumAnd{}
+func (x *ObjectTokensRequest) Reset() {
+	*x = ObjectTokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectTokensRequest) ProtoMessage() {}
+
+func (x *ObjectTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectTokensRequest.ProtoReflect.Descriptor instead.
+func (*ObjectTokensRequest) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ObjectTokensRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *ObjectTokensRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ObjectTokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []*FieldTokens `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *ObjectTokensResponse) Reset() {
+	*x = ObjectTokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectTokensResponse) ProtoMessage() {}
+
+func (x *ObjectTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectTokensResponse.ProtoReflect.Descriptor instead.
+func (*ObjectTokensResponse) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ObjectTokensResponse) GetFields() []*FieldTokens {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// FieldTokens lists the tokens indexed for a text field of an object, in alphabetical order. Length is the number
+// of tokens the field counts for when scores are normalized
+type FieldTokens struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field  string          `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Length int64           `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	Tokens []*IndexedToken `protobuf:"bytes,3,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *FieldTokens) Reset() {
+	*x = FieldTokens{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_se_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2081,13 +2539,13 @@ func (x *NumAnd) Reset() {
 	}
 }
 
-func (x *NumAnd) String() string {
+func (x *FieldTokens) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumAnd) ProtoMessage() {}
+func (*FieldTokens) ProtoMessage() {}
 
-func (x *NumAnd) ProtoReflect() protoreflect.Message {
+func (x *FieldTokens) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_se_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2099,28 +2557,46 @@ func (x *NumAnd) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumAnd.ProtoReflect.Descriptor instead.
-func (*NumAnd) Descriptor() ([]byte, []int) {
+// Deprecated: Use FieldTokens.ProtoReflect.Descriptor instead.
+func (*FieldTokens) Descriptor() ([]byte, []int) {
 	return file_proto_se_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *NumAnd) GetQueries() []*NumQuery {
+func (x *FieldTokens) GetField() string {
 	if x != nil {
-		return x.Queries
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldTokens) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *FieldTokens) GetTokens() []*IndexedToken {
+	if x != nil {
+		return x.Tokens
 	}
 	return nil
 }
 
-type NumOr struct {
+// IndexedToken is a token indexed for a text field with its frequency and its positions in the text. Terms indexed
+// without position, like the prefixes of texts, count in the frequency only
+type IndexedToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Queries []*NumQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	Token     string  `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Tf        int64   `protobuf:"varint,2,opt,name=tf,proto3" json:"tf,omitempty"`
+	Positions []int64 `protobuf:"varint,3,rep,packed,name=positions,proto3" json:"positions,omitempty"`
 }
 
-func (x *NumOr) Reset() {
-	*x = NumOr{}
+func (x *IndexedToken) Reset() {
+	*x = IndexedToken{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_se_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2128,13 +2604,13 @@ func (x *NumOr) Reset() {
 	}
 }
 
-func (x *NumOr) String() string {
+func (x *IndexedToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumOr) ProtoMessage() {}
+func (*IndexedToken) ProtoMessage() {}
 
-func (x *NumOr) ProtoReflect() protoreflect.Message {
+func (x *IndexedToken) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_se_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2146,28 +2622,48 @@ func (x *NumOr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumOr.ProtoReflect.Descriptor instead.
-func (*NumOr) Descriptor() ([]byte, []int) {
+// Deprecated: Use IndexedToken.ProtoReflect.Descriptor instead.
+func (*IndexedToken) Descriptor() ([]byte, []int) {
 	return file_proto_se_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *NumOr) GetQueries() []*NumQuery {
+func (x *IndexedToken) GetToken() string {
 	if x != nil {
-		return x.Queries
+		return x.Token
+	}
+	return ""
+}
+
+func (x *IndexedToken) GetTf() int64 {
+	if x != nil {
+		return x.Tf
+	}
+	return 0
+}
+
+func (x *IndexedToken) GetPositions() []int64 {
+	if x != nil {
+		return x.Positions
 	}
 	return nil
 }
 
-type StrOr struct {
+// TokenDocumentsRequest lists the postings of a token for at most count objects whose id is greater than after, in
+// increasing id order. An empty field looks the token up in all the text fields
+type TokenDocumentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Queries []*StrQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Token      string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Field      string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	After      string `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	Count      uint32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *StrOr) Reset() {
-	*x = StrOr{}
+func (x *TokenDocumentsRequest) Reset() {
+	*x = TokenDocumentsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_se_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2175,341 +2671,4429 @@ func (x *StrOr) Reset() {
 	}
 }
 
-func (x *StrOr) String() string {
+func (x *TokenDocumentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StrOr) ProtoMessage() {}
+func (*TokenDocumentsRequest) ProtoMessage() {}
 
-func (x *StrOr) ProtoReflect() protoreflect.Message {
+func (x *TokenDocumentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_se_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StrOr.ProtoReflect.Descriptor instead.
-func (*StrOr) Descriptor() ([]byte, []int) {
-	return file_proto_se_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *StrOr) GetQueries() []*StrQuery {
-	if x != nil {
-		return x.Queries
-	}
-	return nil
-}
-
-var File_proto_se_proto protoreflect.FileDescriptor
-
-var file_proto_se_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x8d, 0x01, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x04, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x26, 0x0a, 0x06,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f,
-	0x22, 0x35, 0x0a, 0x09, 0x54, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x37, 0x0a, 0x0b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c,
-	0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
-	0x22, 0x86, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x1a, 0x3a, 0x0a,
-	0x0c, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x74, 0x72, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x4e, 0x75, 0x6d,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x25, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x06,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22,
-	0xcc, 0x01, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x02,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x53, 0x74, 0x72, 0x4f, 0x72,
-	0x48, 0x00, 0x52, 0x02, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x74, 0x72, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x48, 0x00, 0x52,
-	0x02, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
-	0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x0b,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x48, 0x00,
-	0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x09,
-	0x65, 0x6e, 0x64, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x09, 0x2e, 0x45, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x48, 0x00, 0x52, 0x08, 0x65, 0x6e,
-	0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x22, 0xc6,
-	0x01, 0x0a, 0x08, 0x4e, 0x75, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x03, 0x61,
-	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4e, 0x75, 0x6d, 0x41, 0x6e,
-	0x64, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x02, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4e, 0x75, 0x6d, 0x4f, 0x72, 0x48, 0x00, 0x52, 0x02,
-	0x6f, 0x72, 0x12, 0x15, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x03,
-	0x2e, 0x47, 0x74, 0x48, 0x00, 0x52, 0x02, 0x67, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x67, 0x74, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x47, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03,
-	0x67, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x03, 0x2e, 0x4c, 0x74, 0x48, 0x00, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x6c, 0x74,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x4c, 0x74, 0x65, 0x48, 0x00, 0x52,
-	0x03, 0x6c, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x07, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x45, 0x71, 0x48, 0x00, 0x52, 0x02, 0x65, 0x71, 0x42,
-	0x06, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x22, 0xf8, 0x02, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x03, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x41, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x64,
-	0x12, 0x15, 0x0a, 0x02, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x03, 0x2e, 0x4f,
-	0x72, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x73, 0x5f,
-	0x77, 0x69, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x45, 0x6e, 0x64,
-	0x73, 0x57, 0x69, 0x74, 0x68, 0x48, 0x00, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74,
-	0x68, 0x12, 0x27, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x48, 0x00,
-	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x09, 0x73, 0x74,
-	0x72, 0x5f, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x53, 0x74, 0x72, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x72, 0x45,
-	0x71, 0x75, 0x61, 0x6c, 0x12, 0x15, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x03, 0x2e, 0x4c, 0x74, 0x48, 0x00, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x6c,
-	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x4c, 0x74, 0x65, 0x48, 0x00,
-	0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x03, 0x2e, 0x47, 0x74, 0x48, 0x00, 0x52, 0x02, 0x67, 0x74, 0x12, 0x18, 0x0a, 0x03,
-	0x67, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x47, 0x74, 0x65, 0x48,
-	0x00, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x5f, 0x65,
-	0x71, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x45, 0x71,
-	0x48, 0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x45, 0x71, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f,
-	0x6f, 0x6c, 0x22, 0xf9, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x65,
-	0x65, 0x64, 0x12, 0x31, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x0c, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x65,
-	0x78, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x65, 0x78,
-	0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x43, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a,
-	0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x58,
-	0x0a, 0x0d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12,
-	0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x0b, 0x54, 0x65, 0x78,
-	0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a,
-	0x13, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x65, 0x66,
-	0x69, 0x78, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x44, 0x0a,
-	0x11, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6a,
-	0x73, 0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x19, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x35, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x20, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x0e, 0x0a, 0x0c, 0x46, 0x65, 0x65,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x0a, 0x10, 0x52, 0x65, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22,
-	0x38, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x12, 0x14, 0x0a,
-	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x64,
-	0x73, 0x57, 0x69, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x36, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x36, 0x0a, 0x08, 0x53, 0x74, 0x72,
-	0x45, 0x71, 0x75, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x32, 0x0a, 0x04, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x34, 0x0a, 0x03, 0x4e, 0x6f, 0x74, 0x12, 0x2d, 0x0a, 0x0b,
-	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0b, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x0b,
-	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x30, 0x0a, 0x02, 0x47,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x31, 0x0a,
-	0x03, 0x47, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x30, 0x0a, 0x02, 0x4c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x31, 0x0a, 0x03, 0x4c, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x34, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x45, 0x71, 0x12,
-	0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2c, 0x0a, 0x03, 0x41,
-	0x6e, 0x64, 0x12, 0x25, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x02, 0x4f, 0x72, 0x12,
-	0x25, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71,
-	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x41, 0x6e, 0x64,
-	0x12, 0x23, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x09, 0x2e, 0x4e, 0x75, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71, 0x75,
-	0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x05, 0x4e, 0x75, 0x6d, 0x4f, 0x72, 0x12, 0x23,
-	0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x09, 0x2e, 0x4e, 0x75, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72,
-	0x69, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x05, 0x53, 0x74, 0x72, 0x4f, 0x72, 0x12, 0x23, 0x0a, 0x07,
-	0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x53, 0x74, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65,
-	0x73, 0x32, 0x62, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x12, 0x25, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x64, 0x12, 0x0c, 0x2e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x1a, 0x0d, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x2b, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x12, 0x10, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x30, 0x01, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6d, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x2f, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x3b,
-	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_proto_se_proto_rawDescOnce sync.Once
-	file_proto_se_proto_rawDescData = file_proto_se_proto_rawDesc
-)
-
-func file_proto_se_proto_rawDescGZIP() []byte {
-	file_proto_se_proto_rawDescOnce.Do(func() {
-		file_proto_se_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_se_proto_rawDescData)
-	})
-	return file_proto_se_proto_rawDescData
-}
-
-var file_proto_se_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
-var file_proto_se_proto_goTypes = []interface{}{
-	(*Index)(nil),                     // 0: Index
-	(*TextIndex)(nil),                 // 1: TextIndex
-	(*NumberIndex)(nil),               // 2: NumberIndex
-	(*PropertiesIndex)(nil),           // 3: PropertiesIndex
-	(*SearchQuery)(nil),               // 4: SearchQuery
-	(*StrQuery)(nil),                  // 5: StrQuery
-	(*NumQuery)(nil),                  // 6: NumQuery
-	(*FieldQuery)(nil),                // 7: FieldQuery
-	(*MessageFeed)(nil),               // 8: MessageFeed
-	(*NumberMapping)(nil),             // 9: NumberMapping
-	(*TextMapping)(nil),               // 10: TextMapping
-	(*PropertiesMapping)(nil),         // 11: PropertiesMapping
-	(*ObjectDeletedNotification)(nil), // 12: ObjectDeletedNotification
-	(*ResearchRequest)(nil),           // 13: ResearchRequest
-	(*SearchResult)(nil),              // 14: SearchResult
-	(*FeedResponse)(nil),              // 15: FeedResponse
-	(*ResearchResponse)(nil),          // 16: ResearchResponse
-	(*StartsWith)(nil),                // 17: StartsWith
-	(*EndsWith)(nil),                  // 18: EndsWith
-	(*Contains)(nil),                  // 19: Contains
-	(*StrEqual)(nil),                  // 20: StrEqual
-	(*Like)(nil),                      // 21: Like
-	(*Not)(nil),                       // 22: Not
-	(*Gt)(nil),                        // 23: Gt
-	(*Gte)(nil),                       // 24: Gte
-	(*Lt)(nil),                        // 25: Lt
-	(*Lte)(nil),                       // 26: Lte
-	(*NumbEq)(nil),                    // 27: NumbEq
-	(*And)(nil),                       // 28: And
-	(*Or)(nil),                        // 29: Or
-	(*NumAnd)(nil),                    // 30: NumAnd
-	(*NumOr)(nil),                     // 31: NumOr
-	(*StrOr)(nil),                     // 32: StrOr
-	nil,                               // 33: PropertiesIndex.AliasesEntry
-}
-var file_proto_se_proto_depIdxs = []int32{
-	1,  // 0: Index.text:type_name -> TextIndex
-	2,  // 1: Index.number:type_name -> NumberIndex
-	3,  // 2: Index.properties:type_name -> PropertiesIndex
-	33, // 3: PropertiesIndex.aliases:type_name -> PropertiesIndex.AliasesEntry
-	5,  // 4: SearchQuery.text:type_name -> StrQuery
-	6,  // 5: SearchQuery.number:type_name -> NumQuery
-	7,  // 6: SearchQuery.fields:type_name -> FieldQuery
-	32, // 7: StrQuery.or:type_name -> StrOr
-	20, // 8: StrQuery.eq:type_name -> StrEqual
-	19, // 9: StrQuery.contains:type_name -> Contains
-	17, // 10: StrQuery.starts_with:type_name -> StartsWith
-	18, // 11: StrQuery.ends_with:type_name -> EndsWith
-	30, // 12: NumQuery.and:type_name -> NumAnd
-	31, // 13: NumQuery.or:type_name -> NumOr
-	23, // 14: NumQuery.gt:type_name -> Gt
-	24, // 15: NumQuery.gte:type_name -> Gte
-	25, // 16: NumQuery.lt:type_name -> Lt
-	26, // 17: NumQuery.lte:type_name -> Lte
-	27, // 18: NumQuery.eq:type_name -> NumbEq
-	28, // 19: FieldQuery.and:type_name -> And
-	29, // 20: FieldQuery.or:type_name -> Or
-	17, // 21: FieldQuery.starts_with:type_name -> StartsWith
-	18, // 22: FieldQuery.ends_with:type_name -> EndsWith
-	19, // 23: FieldQuery.contains:type_name -> Contains
-	20, // 24: FieldQuery.str_equal:type_name -> StrEqual
-	25, // 25: FieldQuery.lt:type_name -> Lt
-	26, // 26: FieldQuery.lte:type_name -> Lte
-	23, // 27: FieldQuery.gt:type_name -> Gt
-	24, // 28: FieldQuery.gte:type_name -> Gte
-	27, // 29: FieldQuery.numb_eq:type_name -> NumbEq
-	9,  // 30: MessageFeed.num_mapping:type_name -> NumberMapping
-	10, // 31: MessageFeed.text_mapping:type_name -> TextMapping
-	11, // 32: MessageFeed.properties_mapping:type_name -> PropertiesMapping
-	12, // 33: MessageFeed.delete:type_name -> ObjectDeletedNotification
-	4,  // 34: ResearchRequest.query:type_name -> SearchQuery
-	7,  // 35: Not.expressions:type_name -> FieldQuery
-	7,  // 36: And.queries:type_name -> FieldQuery
-	7,  // 37: Or.queries:type_name -> FieldQuery
-	6,  // 38: NumAnd.queries:type_name -> NumQuery
-	6,  // 39: NumOr.queries:type_name -> NumQuery
-	5,  // 40: StrOr.queries:type_name -> StrQuery
-	8,  // 41: SearchEngine.Feed:input_type -> MessageFeed
-	13, // 42: SearchEngine.Search:input_type -> ResearchRequest
-	15, // 43: SearchEngine.Feed:output_type -> FeedResponse
-	14, // 44: SearchEngine.Search:output_type -> SearchResult
-	43, // [43:45] is the sub-list for method output_type
-	41, // [41:43] is the sub-list for method input_type
-	41, // [41:41] is the sub-list for extension type_name
-	41, // [41:41] is the sub-list for extension extendee
-	0,  // [0:41] is the sub-list for field type_name
-}
-
-func init() { file_proto_se_proto_init() }
-func file_proto_se_proto_init() {
-	if File_proto_se_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_se_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Index); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*TokenDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TokenDocumentsRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *TokenDocumentsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TokenDocumentsRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *TokenDocumentsRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *TokenDocumentsRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type TokenDocumentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Postings []*TokenPosting `protobuf:"bytes,1,rep,name=postings,proto3" json:"postings,omitempty"`
+}
+
+func (x *TokenDocumentsResponse) Reset() {
+	*x = TokenDocumentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenDocumentsResponse) ProtoMessage() {}
+
+func (x *TokenDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*TokenDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TokenDocumentsResponse) GetPostings() []*TokenPosting {
+	if x != nil {
+		return x.Postings
+	}
+	return nil
+}
+
+// TokenPosting is the occurrences of a token in a text field of an object
+type TokenPosting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Tf    int64  `protobuf:"varint,3,opt,name=tf,proto3" json:"tf,omitempty"`
+}
+
+func (x *TokenPosting) Reset() {
+	*x = TokenPosting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenPosting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenPosting) ProtoMessage() {}
+
+func (x *TokenPosting) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenPosting.ProtoReflect.Descriptor instead.
+func (*TokenPosting) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TokenPosting) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TokenPosting) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *TokenPosting) GetTf() int64 {
+	if x != nil {
+		return x.Tf
+	}
+	return 0
+}
+
+// AnalyzeRequest asks for the tokens an analyzer extracts from a text, in text order. An empty analyzer refers to
+// the default analyzer. The collection selects the search engine the text is analyzed by
+type AnalyzeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Analyzer   string `protobuf:"bytes,2,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+	Text       string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *AnalyzeRequest) Reset() {
+	*x = AnalyzeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeRequest) ProtoMessage() {}
+
+func (x *AnalyzeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AnalyzeRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *AnalyzeRequest) GetAnalyzer() string {
+	if x != nil {
+		return x.Analyzer
+	}
+	return ""
+}
+
+func (x *AnalyzeRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type AnalyzeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokens []string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *AnalyzeResponse) Reset() {
+	*x = AnalyzeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeResponse) ProtoMessage() {}
+
+func (x *AnalyzeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AnalyzeResponse) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+// SearchExplanation describes how a search query was compiled and how the objects it found were scored
+type SearchExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// conditions are the text conditions of the query with the terms they look for
+	Conditions []*ConditionExplanation `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	// sql is the query compiled by SQL index stores, params the values bound to its placeholders. Other stores
+	// evaluate queries without compiling them
+	Sql    string   `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
+	Params []string `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	// hits are the best scored found objects, at most 100 of them
+	Hits []*HitExplanation `protobuf:"bytes,4,rep,name=hits,proto3" json:"hits,omitempty"`
+	// hits_count is the number of found objects
+	HitsCount int64 `protobuf:"varint,5,opt,name=hits_count,json=hitsCount,proto3" json:"hits_count,omitempty"`
+}
+
+func (x *SearchExplanation) Reset() {
+	*x = SearchExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchExplanation) ProtoMessage() {}
+
+func (x *SearchExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchExplanation.ProtoReflect.Descriptor instead.
+func (*SearchExplanation) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SearchExplanation) GetConditions() []*ConditionExplanation {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *SearchExplanation) GetSql() string {
+	if x != nil {
+		return x.Sql
+	}
+	return ""
+}
+
+func (x *SearchExplanation) GetParams() []string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *SearchExplanation) GetHits() []*HitExplanation {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchExplanation) GetHitsCount() int64 {
+	if x != nil {
+		return x.HitsCount
+	}
+	return 0
+}
+
+// ConditionExplanation is a text condition as evaluated for the fields of an analyzer. Fields lists the text index
+// aliases of the analyzer, or the aliases it does not apply to when exclude_fields is set. Terms are the distinct
+// analyzed words of the value and their synonyms, phrases the synonyms of several words. The terms of phrase and
+// proximity conditions are the analyzed words in text order, they have no synonyms. The terms of fuzzy conditions
+// are then expanded to the indexed tokens close to them. Negated conditions exclude objects and do not score
+type ConditionExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Condition     string   `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Value         string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Analyzer      string   `protobuf:"bytes,3,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+	Fields        []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	ExcludeFields bool     `protobuf:"varint,5,opt,name=exclude_fields,json=excludeFields,proto3" json:"exclude_fields,omitempty"`
+	Terms         []string `protobuf:"bytes,6,rep,name=terms,proto3" json:"terms,omitempty"`
+	Phrases       []string `protobuf:"bytes,7,rep,name=phrases,proto3" json:"phrases,omitempty"`
+	Negated       bool     `protobuf:"varint,8,opt,name=negated,proto3" json:"negated,omitempty"`
+}
+
+func (x *ConditionExplanation) Reset() {
+	*x = ConditionExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConditionExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConditionExplanation) ProtoMessage() {}
+
+func (x *ConditionExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConditionExplanation.ProtoReflect.Descriptor instead.
+func (*ConditionExplanation) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ConditionExplanation) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *ConditionExplanation) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ConditionExplanation) GetAnalyzer() string {
+	if x != nil {
+		return x.Analyzer
+	}
+	return ""
+}
+
+func (x *ConditionExplanation) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ConditionExplanation) GetExcludeFields() bool {
+	if x != nil {
+		return x.ExcludeFields
+	}
+	return false
+}
+
+func (x *ConditionExplanation) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *ConditionExplanation) GetPhrases() []string {
+	if x != nil {
+		return x.Phrases
+	}
+	return nil
+}
+
+func (x *ConditionExplanation) GetNegated() bool {
+	if x != nil {
+		return x.Negated
+	}
+	return false
+}
+
+// HitExplanation breaks the score of a found object down to the contributions of its matching tokens
+type HitExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Score float64      `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Terms []*TermScore `protobuf:"bytes,3,rep,name=terms,proto3" json:"terms,omitempty"`
+}
+
+func (x *HitExplanation) Reset() {
+	*x = HitExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HitExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HitExplanation) ProtoMessage() {}
+
+func (x *HitExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HitExplanation.ProtoReflect.Descriptor instead.
+func (*HitExplanation) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *HitExplanation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HitExplanation) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *HitExplanation) GetTerms() []*TermScore {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+// TermScore is the BM25 contribution of a token indexed for a field of an object. The token matched the query term
+// with match_weight. Docs is the number of objects the field is indexed for and df the number of them in which a
+// token matched term. Weight is the contribution: idf * tf * (k1 + 1) / (tf + k1 * norm) * match_weight * boost
+type TermScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token       string  `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Field       string  `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Term        string  `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	Tf          int64   `protobuf:"varint,4,opt,name=tf,proto3" json:"tf,omitempty"`
+	Df          int64   `protobuf:"varint,5,opt,name=df,proto3" json:"df,omitempty"`
+	Docs        int64   `protobuf:"varint,6,opt,name=docs,proto3" json:"docs,omitempty"`
+	Length      int64   `protobuf:"varint,7,opt,name=length,proto3" json:"length,omitempty"`
+	AvgLength   float64 `protobuf:"fixed64,8,opt,name=avg_length,json=avgLength,proto3" json:"avg_length,omitempty"`
+	Idf         float64 `protobuf:"fixed64,9,opt,name=idf,proto3" json:"idf,omitempty"`
+	Norm        float64 `protobuf:"fixed64,10,opt,name=norm,proto3" json:"norm,omitempty"`
+	MatchWeight float64 `protobuf:"fixed64,11,opt,name=match_weight,json=matchWeight,proto3" json:"match_weight,omitempty"`
+	Boost       float64 `protobuf:"fixed64,12,opt,name=boost,proto3" json:"boost,omitempty"`
+	Weight      float64 `protobuf:"fixed64,13,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *TermScore) Reset() {
+	*x = TermScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TermScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermScore) ProtoMessage() {}
+
+func (x *TermScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermScore.ProtoReflect.Descriptor instead.
+func (*TermScore) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TermScore) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TermScore) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *TermScore) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *TermScore) GetTf() int64 {
+	if x != nil {
+		return x.Tf
+	}
+	return 0
+}
+
+func (x *TermScore) GetDf() int64 {
+	if x != nil {
+		return x.Df
+	}
+	return 0
+}
+
+func (x *TermScore) GetDocs() int64 {
+	if x != nil {
+		return x.Docs
+	}
+	return 0
+}
+
+func (x *TermScore) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *TermScore) GetAvgLength() float64 {
+	if x != nil {
+		return x.AvgLength
+	}
+	return 0
+}
+
+func (x *TermScore) GetIdf() float64 {
+	if x != nil {
+		return x.Idf
+	}
+	return 0
+}
+
+func (x *TermScore) GetNorm() float64 {
+	if x != nil {
+		return x.Norm
+	}
+	return 0
+}
+
+func (x *TermScore) GetMatchWeight() float64 {
+	if x != nil {
+		return x.MatchWeight
+	}
+	return 0
+}
+
+func (x *TermScore) GetBoost() float64 {
+	if x != nil {
+		return x.Boost
+	}
+	return 0
+}
+
+func (x *TermScore) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// Suggestion is an indexed term completing a prefix. Docs is the number of objects the term is indexed for
+type Suggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Docs int64  `protobuf:"varint,2,opt,name=docs,proto3" json:"docs,omitempty"`
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Suggestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Suggestion) GetDocs() int64 {
+	if x != nil {
+		return x.Docs
+	}
+	return 0
+}
+
+// ResearchRequest searches the objects of a collection. The other fields are the options the query is compiled with.
+// When facets are set, the facets are counted instead of the hits being returned
+type ResearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query      *SearchQuery       `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Collection string             `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	Fields     []string           `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	Boosts     map[string]float64 `protobuf:"bytes,4,rep,name=boosts,proto3" json:"boosts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Analyzers  map[string]string  `protobuf:"bytes,5,rep,name=analyzers,proto3" json:"analyzers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MaxDepth   uint32             `protobuf:"varint,6,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	MaxTerms   uint32             `protobuf:"varint,7,opt,name=max_terms,json=maxTerms,proto3" json:"max_terms,omitempty"`
+	Facets     []*Facet           `protobuf:"bytes,8,rep,name=facets,proto3" json:"facets,omitempty"`
+	Synonyms   []*SynonymSet      `protobuf:"bytes,9,rep,name=synonyms,proto3" json:"synonyms,omitempty"`
+	// origin sorts the hits by distance to it
+	Origin *GeoPoint `protobuf:"bytes,10,opt,name=origin,proto3" json:"origin,omitempty"`
+}
+
+func (x *ResearchRequest) Reset() {
+	*x = ResearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResearchRequest) ProtoMessage() {}
+
+func (x *ResearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResearchRequest.ProtoReflect.Descriptor instead.
+func (*ResearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ResearchRequest) GetQuery() *SearchQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ResearchRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *ResearchRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ResearchRequest) GetBoosts() map[string]float64 {
+	if x != nil {
+		return x.Boosts
+	}
+	return nil
+}
+
+func (x *ResearchRequest) GetAnalyzers() map[string]string {
+	if x != nil {
+		return x.Analyzers
+	}
+	return nil
+}
+
+func (x *ResearchRequest) GetMaxDepth() uint32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *ResearchRequest) GetMaxTerms() uint32 {
+	if x != nil {
+		return x.MaxTerms
+	}
+	return 0
+}
+
+func (x *ResearchRequest) GetFacets() []*Facet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *ResearchRequest) GetSynonyms() []*SynonymSet {
+	if x != nil {
+		return x.Synonyms
+	}
+	return nil
+}
+
+func (x *ResearchRequest) GetOrigin() *GeoPoint {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+// SynonymSet declares words that text conditions treat alike. The synonyms of an equivalent set all find each other.
+// When inputs are set, the set is one-way: the inputs find the synonyms, the synonyms do not find the inputs. A set
+// applies to the text indexes of a collection, to the text indexes using an analyzer, or to the text indexes of a
+// collection using an analyzer
+type SynonymSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Collection string   `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	Analyzer   string   `protobuf:"bytes,3,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+	Synonyms   []string `protobuf:"bytes,4,rep,name=synonyms,proto3" json:"synonyms,omitempty"`
+	Inputs     []string `protobuf:"bytes,5,rep,name=inputs,proto3" json:"inputs,omitempty"`
+}
+
+func (x *SynonymSet) Reset() {
+	*x = SynonymSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SynonymSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SynonymSet) ProtoMessage() {}
+
+func (x *SynonymSet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SynonymSet.ProtoReflect.Descriptor instead.
+func (*SynonymSet) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SynonymSet) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SynonymSet) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SynonymSet) GetAnalyzer() string {
+	if x != nil {
+		return x.Analyzer
+	}
+	return ""
+}
+
+func (x *SynonymSet) GetSynonyms() []string {
+	if x != nil {
+		return x.Synonyms
+	}
+	return nil
+}
+
+func (x *SynonymSet) GetInputs() []string {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids    []string       `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Hits   []*SearchHit   `protobuf:"bytes,2,rep,name=hits,proto3" json:"hits,omitempty"`
+	Facets []*FacetResult `protobuf:"bytes,3,rep,name=facets,proto3" json:"facets,omitempty"`
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SearchResult) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *SearchResult) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchResult) GetFacets() []*FacetResult {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+// SearchHit is an object found by a search. Distance is set when hits are sorted by distance: it is the number of
+// meters between the origin and the nearest point indexed for the object, -1 when none was
+type SearchHit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Score    float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Distance float64 `protobuf:"fixed64,3,opt,name=distance,proto3" json:"distance,omitempty"`
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SearchHit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchHit) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+// Facet requests the counts of the values of a field over all the objects matching a search query. The field is
+// a properties index alias or $number. Values are counted in ranges when ranges are set, otherwise the size most
+// frequent values are counted. Strings are counted in their normalized form
+type Facet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Field  string      `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Size   uint32      `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Ranges []*NumRange `protobuf:"bytes,4,rep,name=ranges,proto3" json:"ranges,omitempty"`
+}
+
+func (x *Facet) Reset() {
+	*x = Facet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Facet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Facet) ProtoMessage() {}
+
+func (x *Facet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Facet.ProtoReflect.Descriptor instead.
+func (*Facet) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Facet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Facet) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Facet) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Facet) GetRanges() []*NumRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+// NumRange selects the numbers from "from", included, to "to", excluded. A missing bound leaves the range open
+type NumRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	From *NumBound `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   *NumBound `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *NumRange) Reset() {
+	*x = NumRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumRange) ProtoMessage() {}
+
+func (x *NumRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumRange.ProtoReflect.Descriptor instead.
+func (*NumRange) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *NumRange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *NumRange) GetFrom() *NumBound {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *NumRange) GetTo() *NumBound {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type NumBound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *NumBound) Reset() {
+	*x = NumBound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumBound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumBound) ProtoMessage() {}
+
+func (x *NumBound) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumBound.ProtoReflect.Descriptor instead.
+func (*NumBound) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *NumBound) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type FacetResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Buckets []*FacetBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (x *FacetResult) Reset() {
+	*x = FacetResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FacetResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetResult) ProtoMessage() {}
+
+func (x *FacetResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetResult.ProtoReflect.Descriptor instead.
+func (*FacetResult) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FacetResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FacetResult) GetBuckets() []*FacetBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type FacetBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *FacetBucket) Reset() {
+	*x = FacetBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FacetBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetBucket) ProtoMessage() {}
+
+func (x *FacetBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetBucket.ProtoReflect.Descriptor instead.
+func (*FacetBucket) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *FacetBucket) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FacetBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Highlight holds the fragments of an indexed text field that contain words matching a search query
+type Highlight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field     string      `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Fragments []*Fragment `protobuf:"bytes,2,rep,name=fragments,proto3" json:"fragments,omitempty"`
+}
+
+func (x *Highlight) Reset() {
+	*x = Highlight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highlight) ProtoMessage() {}
+
+func (x *Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highlight.ProtoReflect.Descriptor instead.
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Highlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Highlight) GetFragments() []*Fragment {
+	if x != nil {
+		return x.Fragments
+	}
+	return nil
+}
+
+// Fragment is an excerpt of a text. Matches locate the matching words in the fragment text
+type Fragment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text    string  `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Matches []*Span `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"`
+}
+
+func (x *Fragment) Reset() {
+	*x = Fragment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fragment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fragment) ProtoMessage() {}
+
+func (x *Fragment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fragment.ProtoReflect.Descriptor instead.
+func (*Fragment) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Fragment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Fragment) GetMatches() []*Span {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+// Span locates a part of a text, in characters from the beginning of the text. End is exclusive
+type Span struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start uint32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   uint32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *Span) Reset() {
+	*x = Span{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Span) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span) ProtoMessage() {}
+
+func (x *Span) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Span.ProtoReflect.Descriptor instead.
+func (*Span) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Span) GetStart() uint32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Span) GetEnd() uint32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type FeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FeedResponse) Reset() {
+	*x = FeedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedResponse) ProtoMessage() {}
+
+func (x *FeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedResponse.ProtoReflect.Descriptor instead.
+func (*FeedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{54}
+}
+
+type ResearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids  []string     `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Hits []*SearchHit `protobuf:"bytes,2,rep,name=hits,proto3" json:"hits,omitempty"`
+}
+
+func (x *ResearchResponse) Reset() {
+	*x = ResearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResearchResponse) ProtoMessage() {}
+
+func (x *ResearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResearchResponse.ProtoReflect.Descriptor instead.
+func (*ResearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ResearchResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ResearchResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// CONDITION
+type StartsWith struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StartsWith) Reset() {
+	*x = StartsWith{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartsWith) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartsWith) ProtoMessage() {}
+
+func (x *StartsWith) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartsWith.ProtoReflect.Descriptor instead.
+func (*StartsWith) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *StartsWith) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *StartsWith) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type EndsWith struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *EndsWith) Reset() {
+	*x = EndsWith{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndsWith) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndsWith) ProtoMessage() {}
+
+func (x *EndsWith) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndsWith.ProtoReflect.Descriptor instead.
+func (*EndsWith) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *EndsWith) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *EndsWith) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Contains struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Contains) Reset() {
+	*x = Contains{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Contains) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contains) ProtoMessage() {}
+
+func (x *Contains) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contains.ProtoReflect.Descriptor instead.
+func (*Contains) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Contains) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Contains) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type StrEqual struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StrEqual) Reset() {
+	*x = StrEqual{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StrEqual) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrEqual) ProtoMessage() {}
+
+func (x *StrEqual) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrEqual.ProtoReflect.Descriptor instead.
+func (*StrEqual) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *StrEqual) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *StrEqual) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Fuzzy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field       string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value       string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	MaxDistance uint32 `protobuf:"varint,3,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+}
+
+func (x *Fuzzy) Reset() {
+	*x = Fuzzy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fuzzy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fuzzy) ProtoMessage() {}
+
+func (x *Fuzzy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fuzzy.ProtoReflect.Descriptor instead.
+func (*Fuzzy) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Fuzzy) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Fuzzy) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Fuzzy) GetMaxDistance() uint32 {
+	if x != nil {
+		return x.MaxDistance
+	}
+	return 0
+}
+
+type Phrase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Phrase) Reset() {
+	*x = Phrase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Phrase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Phrase) ProtoMessage() {}
+
+func (x *Phrase) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Phrase.ProtoReflect.Descriptor instead.
+func (*Phrase) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Phrase) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Phrase) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Near struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field    string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value    string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Distance uint32 `protobuf:"varint,3,opt,name=distance,proto3" json:"distance,omitempty"`
+}
+
+func (x *Near) Reset() {
+	*x = Near{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Near) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Near) ProtoMessage() {}
+
+func (x *Near) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Near.ProtoReflect.Descriptor instead.
+func (*Near) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Near) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Near) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Near) GetDistance() uint32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type Like struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Like) Reset() {
+	*x = Like{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Like) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Like) ProtoMessage() {}
+
+func (x *Like) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Like.ProtoReflect.Descriptor instead.
+func (*Like) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Like) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Like) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Not struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expressions *FieldQuery `protobuf:"bytes,1,opt,name=expressions,proto3" json:"expressions,omitempty"`
+}
+
+func (x *Not) Reset() {
+	*x = Not{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Not) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Not) ProtoMessage() {}
+
+func (x *Not) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Not.ProtoReflect.Descriptor instead.
+func (*Not) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Not) GetExpressions() *FieldQuery {
+	if x != nil {
+		return x.Expressions
+	}
+	return nil
+}
+
+type NumNot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expressions *NumQuery `protobuf:"bytes,1,opt,name=expressions,proto3" json:"expressions,omitempty"`
+}
+
+func (x *NumNot) Reset() {
+	*x = NumNot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumNot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumNot) ProtoMessage() {}
+
+func (x *NumNot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumNot.ProtoReflect.Descriptor instead.
+func (*NumNot) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *NumNot) GetExpressions() *NumQuery {
+	if x != nil {
+		return x.Expressions
+	}
+	return nil
+}
+
+type StrNot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expressions *StrQuery `protobuf:"bytes,1,opt,name=expressions,proto3" json:"expressions,omitempty"`
+}
+
+func (x *StrNot) Reset() {
+	*x = StrNot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StrNot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrNot) ProtoMessage() {}
+
+func (x *StrNot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrNot.ProtoReflect.Descriptor instead.
+func (*StrNot) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *StrNot) GetExpressions() *StrQuery {
+	if x != nil {
+		return x.Expressions
+	}
+	return nil
+}
+
+type Gt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string  `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Gt) Reset() {
+	*x = Gt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gt) ProtoMessage() {}
+
+func (x *Gt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gt.ProtoReflect.Descriptor instead.
+func (*Gt) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Gt) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Gt) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type Gte struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string  `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Gte) Reset() {
+	*x = Gte{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gte) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gte) ProtoMessage() {}
+
+func (x *Gte) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gte.ProtoReflect.Descriptor instead.
+func (*Gte) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Gte) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Gte) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type Lt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string  `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Lt) Reset() {
+	*x = Lt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lt) ProtoMessage() {}
+
+func (x *Lt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lt.ProtoReflect.Descriptor instead.
+func (*Lt) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Lt) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Lt) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type Lte struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string  `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Lte) Reset() {
+	*x = Lte{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lte) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lte) ProtoMessage() {}
+
+func (x *Lte) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lte.ProtoReflect.Descriptor instead.
+func (*Lte) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Lte) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Lte) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type NumbEq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string  `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *NumbEq) Reset() {
+	*x = NumbEq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumbEq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumbEq) ProtoMessage() {}
+
+func (x *NumbEq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumbEq.ProtoReflect.Descriptor instead.
+func (*NumbEq) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *NumbEq) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *NumbEq) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type BoolEq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value bool   `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *BoolEq) Reset() {
+	*x = BoolEq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoolEq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoolEq) ProtoMessage() {}
+
+func (x *BoolEq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoolEq.ProtoReflect.Descriptor instead.
+func (*BoolEq) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BoolEq) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *BoolEq) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+// IsNull matches the objects whose field is indexed with a null value
+type IsNull struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (x *IsNull) Reset() {
+	*x = IsNull{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsNull) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsNull) ProtoMessage() {}
+
+func (x *IsNull) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsNull.ProtoReflect.Descriptor instead.
+func (*IsNull) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *IsNull) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+// Exists matches the objects whose field is indexed, with any value including null
+type Exists struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (x *Exists) Reset() {
+	*x = Exists{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Exists) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Exists) ProtoMessage() {}
+
+func (x *Exists) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Exists.ProtoReflect.Descriptor instead.
+func (*Exists) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Exists) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+// Value is a value properties are compared to. Timestamps are compared as numbers of milliseconds since the unix epoch
+type Value struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*Value_Text
+	//	*Value_Number
+	//	*Value_Boolean
+	Value isValue_Value `protobuf_oneof:"value"`
+}
+
+func (x *Value) Reset() {
+	*x = Value{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value) ProtoMessage() {}
+
+func (x *Value) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value.ProtoReflect.Descriptor instead.
+func (*Value) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{75}
+}
+
+func (m *Value) GetValue() isValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *Value) GetText() string {
+	if x, ok := x.GetValue().(*Value_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Value) GetNumber() float64 {
+	if x, ok := x.GetValue().(*Value_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Value) GetBoolean() bool {
+	if x, ok := x.GetValue().(*Value_Boolean); ok {
+		return x.Boolean
+	}
+	return false
+}
+
+type isValue_Value interface {
+	isValue_Value()
+}
+
+type Value_Text struct {
+	Text string `protobuf:"bytes,1,opt,name=text,proto3,oneof"`
+}
+
+type Value_Number struct {
+	Number float64 `protobuf:"fixed64,2,opt,name=number,proto3,oneof"`
+}
+
+type Value_Boolean struct {
+	Boolean bool `protobuf:"varint,3,opt,name=boolean,proto3,oneof"`
+}
+
+func (*Value_Text) isValue_Value() {}
+
+func (*Value_Number) isValue_Value() {}
+
+func (*Value_Boolean) isValue_Value() {}
+
+// In matches the objects whose field values, the elements of arrays or the value itself, are all one of values
+type In struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field  string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Values []*Value `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *In) Reset() {
+	*x = In{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *In) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*In) ProtoMessage() {}
+
+func (x *In) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use In.ProtoReflect.Descriptor instead.
+func (*In) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *In) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *In) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// AnyOf matches the objects whose field values, the elements of arrays or the value itself, include one of values
+type AnyOf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field  string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Values []*Value `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *AnyOf) Reset() {
+	*x = AnyOf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnyOf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnyOf) ProtoMessage() {}
+
+func (x *AnyOf) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnyOf.ProtoReflect.Descriptor instead.
+func (*AnyOf) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AnyOf) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AnyOf) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// AllOf matches the objects whose field values, the elements of arrays or the value itself, include all the values
+type AllOf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field  string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Values []*Value `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *AllOf) Reset() {
+	*x = AllOf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllOf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllOf) ProtoMessage() {}
+
+func (x *AllOf) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllOf.ProtoReflect.Descriptor instead.
+func (*AllOf) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AllOf) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AllOf) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type And struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queries []*FieldQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+}
+
+func (x *And) Reset() {
+	*x = And{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *And) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*And) ProtoMessage() {}
+
+func (x *And) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use And.ProtoReflect.Descriptor instead.
+func (*And) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *And) GetQueries() []*FieldQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type Or struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queries []*FieldQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+}
+
+func (x *Or) Reset() {
+	*x = Or{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Or) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Or) ProtoMessage() {}
+
+func (x *Or) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Or.ProtoReflect.Descriptor instead.
+func (*Or) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Or) GetQueries() []*FieldQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type NumAnd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queries []*NumQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+}
+
+func (x *NumAnd) Reset() {
+	*x = NumAnd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumAnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumAnd) ProtoMessage() {}
+
+func (x *NumAnd) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumAnd.ProtoReflect.Descriptor instead.
+func (*NumAnd) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *NumAnd) GetQueries() []*NumQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type NumOr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queries []*NumQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+}
+
+func (x *NumOr) Reset() {
+	*x = NumOr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumOr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumOr) ProtoMessage() {}
+
+func (x *NumOr) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumOr.ProtoReflect.Descriptor instead.
+func (*NumOr) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *NumOr) GetQueries() []*NumQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type StrOr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queries []*StrQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+}
+
+func (x *StrOr) Reset() {
+	*x = StrOr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_se_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StrOr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrOr) ProtoMessage() {}
+
+func (x *StrOr) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_se_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrOr.ProtoReflect.Descriptor instead.
+func (*StrOr) Descriptor() ([]byte, []int) {
+	return file_proto_se_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *StrOr) GetQueries() []*StrQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+var File_proto_se_proto protoreflect.FileDescriptor
+
+var file_proto_se_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xac, 0x01, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x26, 0x0a, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x03, 0x67, 0x65, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x47, 0x65, 0x6f, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x48, 0x00, 0x52, 0x03, 0x67, 0x65, 0x6f, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22,
+	0x67, 0x0a, 0x09, 0x54, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x22, 0x57, 0x0a, 0x0b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x12, 0x1e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0a, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0xff, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x31,
+	0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a,
+	0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x6a, 0x0a, 0x08, 0x47, 0x65, 0x6f, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22,
+	0x2e, 0x0a, 0x08, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6c, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x6f, 0x6e, 0x22,
+	0xa2, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x1f, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x53, 0x74, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x23, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x4e, 0x75, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x03,
+	0x67, 0x65, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x47, 0x65, 0x6f, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x03, 0x67, 0x65, 0x6f, 0x42, 0x07, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x22, 0xc9, 0x02, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x18, 0x0a, 0x02, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x53, 0x74, 0x72, 0x4f, 0x72, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x02, 0x65,
+	0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x74, 0x72, 0x45, 0x71, 0x75,
+	0x61, 0x6c, 0x48, 0x00, 0x52, 0x02, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x73, 0x12, 0x2e, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x57,
+	0x69, 0x74, 0x68, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x57, 0x69, 0x74,
+	0x68, 0x12, 0x28, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x45, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x48,
+	0x00, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x03, 0x6e,
+	0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x72, 0x4e, 0x6f,
+	0x74, 0x48, 0x00, 0x52, 0x03, 0x6e, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x66, 0x75, 0x7a, 0x7a,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x79, 0x48,
+	0x00, 0x52, 0x05, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x12, 0x21, 0x0a, 0x06, 0x70, 0x68, 0x72, 0x61,
+	0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x50, 0x68, 0x72, 0x61, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e,
+	0x65, 0x61, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4e, 0x65, 0x61, 0x72,
+	0x48, 0x00, 0x52, 0x04, 0x6e, 0x65, 0x61, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c,
+	0x22, 0xe3, 0x01, 0x0a, 0x08, 0x4e, 0x75, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1b, 0x0a,
+	0x03, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4e, 0x75, 0x6d,
+	0x41, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x02, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4e, 0x75, 0x6d, 0x4f, 0x72, 0x48, 0x00,
+	0x52, 0x02, 0x6f, 0x72, 0x12, 0x15, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x03, 0x2e, 0x47, 0x74, 0x48, 0x00, 0x52, 0x02, 0x67, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x67,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x47, 0x74, 0x65, 0x48, 0x00,
+	0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x03, 0x2e, 0x4c, 0x74, 0x48, 0x00, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x03,
+	0x6c, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x4c, 0x74, 0x65, 0x48,
+	0x00, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x45, 0x71, 0x48, 0x00, 0x52, 0x02, 0x65,
+	0x71, 0x12, 0x1b, 0x0a, 0x03, 0x6e, 0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07,
+	0x2e, 0x4e, 0x75, 0x6d, 0x4e, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x03, 0x6e, 0x6f, 0x74, 0x42, 0x06,
+	0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x22, 0xc9, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x6f, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x03, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x07, 0x2e, 0x47, 0x65, 0x6f, 0x41, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x64,
+	0x12, 0x18, 0x0a, 0x02, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x47,
+	0x65, 0x6f, 0x4f, 0x72, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x03, 0x6e, 0x6f,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x47, 0x65, 0x6f, 0x4e, 0x6f, 0x74,
+	0x48, 0x00, 0x52, 0x03, 0x6e, 0x6f, 0x74, 0x12, 0x34, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x69,
+	0x6e, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x48, 0x00, 0x52,
+	0x0c, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x2b, 0x0a,
+	0x0a, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x62, 0x6f, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x42, 0x6f, 0x78, 0x48, 0x00, 0x52,
+	0x09, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x42, 0x6f, 0x78, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f,
+	0x6f, 0x6c, 0x22, 0x2d, 0x0a, 0x06, 0x47, 0x65, 0x6f, 0x41, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x07,
+	0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x47, 0x65, 0x6f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0x2c, 0x0a, 0x05, 0x47, 0x65, 0x6f, 0x4f, 0x72, 0x12, 0x23, 0x0a, 0x07, 0x71, 0x75,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x47, 0x65,
+	0x6f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x35, 0x0a, 0x06, 0x47, 0x65, 0x6f, 0x4e, 0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x0b, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x47, 0x65, 0x6f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x49, 0x0a, 0x0c, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e,
+	0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0x5f, 0x0a, 0x09, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x42, 0x6f, 0x78, 0x12, 0x28,
+	0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x5f, 0x77, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x73,
+	0x6f, 0x75, 0x74, 0x68, 0x57, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0a, 0x6e, 0x6f, 0x72, 0x74,
+	0x68, 0x5f, 0x65, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x47,
+	0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x45, 0x61,
+	0x73, 0x74, 0x22, 0xd6, 0x04, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x18, 0x0a, 0x03, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04,
+	0x2e, 0x41, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x64, 0x12, 0x15, 0x0a, 0x02, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x03, 0x2e, 0x4f, 0x72, 0x48, 0x00, 0x52, 0x02,
+	0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x77, 0x69, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x12, 0x28, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x45, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x48, 0x00, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x5f, 0x65, 0x71, 0x75,
+	0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x74, 0x72, 0x45, 0x71,
+	0x75, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x72, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x12,
+	0x15, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x03, 0x2e, 0x4c, 0x74,
+	0x48, 0x00, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x4c, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x6c, 0x74, 0x65,
+	0x12, 0x15, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x03, 0x2e, 0x47,
+	0x74, 0x48, 0x00, 0x52, 0x02, 0x67, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x47, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x67, 0x74,
+	0x65, 0x12, 0x22, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x5f, 0x65, 0x71, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x45, 0x71, 0x48, 0x00, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x45, 0x71, 0x12, 0x18, 0x0a, 0x03, 0x6e, 0x6f, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x04, 0x2e, 0x4e, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x03, 0x6e, 0x6f, 0x74, 0x12,
+	0x22, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x65, 0x71, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x07, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x45, 0x71, 0x48, 0x00, 0x52, 0x06, 0x62, 0x6f, 0x6f,
+	0x6c, 0x45, 0x71, 0x12, 0x22, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x49, 0x73, 0x4e, 0x75, 0x6c, 0x6c, 0x48, 0x00, 0x52,
+	0x06, 0x69, 0x73, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x21, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x48, 0x00, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x15, 0x0a, 0x02, 0x69, 0x6e,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x03, 0x2e, 0x49, 0x6e, 0x48, 0x00, 0x52, 0x02, 0x69,
+	0x6e, 0x12, 0x1f, 0x0a, 0x06, 0x61, 0x6e, 0x79, 0x5f, 0x6f, 0x66, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x41, 0x6e, 0x79, 0x4f, 0x66, 0x48, 0x00, 0x52, 0x05, 0x61, 0x6e, 0x79,
+	0x4f, 0x66, 0x12, 0x1f, 0x0a, 0x06, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x66, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x41, 0x6c, 0x6c, 0x4f, 0x66, 0x48, 0x00, 0x52, 0x05, 0x61, 0x6c,
+	0x6c, 0x4f, 0x66, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x22, 0xc9, 0x02, 0x0a, 0x0b,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x0b, 0x6e,
+	0x75, 0x6d, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x48, 0x00, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x31,
+	0x0a, 0x0c, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x12, 0x43, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x5f,
+	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x48, 0x00, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x0b,
+	0x67, 0x65, 0x6f, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x47, 0x65, 0x6f, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x48, 0x00,
+	0x52, 0x0a, 0x67, 0x65, 0x6f, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4a, 0x0a, 0x0a, 0x47, 0x65, 0x6f, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x22, 0x58, 0x0a, 0x0d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x9e, 0x01,
+	0x0a, 0x0b, 0x54, 0x65, 0x78, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x11, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x22, 0x44,
+	0x0a, 0x11, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x19, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xb1, 0x01, 0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61,
+	0x78, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x0f, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5e, 0x0a, 0x10, 0x4d, 0x61, 0x70, 0x70, 0x65,
+	0x64, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x25, 0x0a, 0x11, 0x4d, 0x61, 0x70, 0x70, 0x65,
+	0x64, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x46,
+	0x0a, 0x12, 0x55, 0x6e, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x27, 0x0a, 0x13, 0x55, 0x6e, 0x6d, 0x61, 0x70, 0x70,
+	0x65, 0x64, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22,
+	0x45, 0x0a, 0x13, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3c, 0x0a, 0x14, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x52, 0x0a, 0x0c, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x66, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8f, 0x01, 0x0a,
+	0x15, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x43,
+	0x0a, 0x16, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x44, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x66, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x66, 0x22, 0x60, 0x0a, 0x0e, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x29, 0x0a, 0x0f, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c,
+	0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x0a,
+	0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x48, 0x69,
+	0x74, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x68, 0x69,
+	0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x69, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x68, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xef, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x72,
+	0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x22, 0x58, 0x0a, 0x0e, 0x48, 0x69, 0x74, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x74,
+	0x65, 0x72, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x65, 0x72,
+	0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x22, 0xad, 0x02,
+	0x0a, 0x09, 0x54, 0x65, 0x72, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74,
+	0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x64,
+	0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x64, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x6f, 0x63, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x67, 0x5f, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x76, 0x67,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x66, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x69, 0x64, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x72, 0x6d,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6e, 0x6f, 0x72, 0x6d, 0x12, 0x21, 0x0a, 0x0c,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x62, 0x6f, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x34, 0x0a,
+	0x0a, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64,
+	0x6f, 0x63, 0x73, 0x22, 0x81, 0x04, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x52,
+	0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78,
+	0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x65, 0x72,
+	0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x54, 0x65, 0x72,
+	0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x52, 0x06, 0x66, 0x61, 0x63, 0x65,
+	0x74, 0x73, 0x12, 0x27, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x53, 0x65,
+	0x74, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x47, 0x65,
+	0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x1a, 0x39,
+	0x0a, 0x0b, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8c, 0x01, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x6f,
+	0x6e, 0x79, 0x6d, 0x53, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x22, 0x66, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48,
+	0x69, 0x74, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x65,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x22, 0x4d,
+	0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x68, 0x0a,
+	0x05, 0x46, 0x61, 0x63, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x4e, 0x75, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x56, 0x0a, 0x08, 0x4e, 0x75, 0x6d, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x4e, 0x75, 0x6d, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x19, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x4e, 0x75, 0x6d, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x02, 0x74, 0x6f, 0x22,
+	0x20, 0x0a, 0x08, 0x4e, 0x75, 0x6d, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x49, 0x0a, 0x0b, 0x46, 0x61, 0x63, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x35, 0x0a, 0x0b,
+	0x46, 0x61, 0x63, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x09, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x27, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x46, 0x72, 0x61, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x3f, 0x0a, 0x08, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x1f, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x22, 0x2e, 0x0a, 0x04, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x22, 0x0e, 0x0a, 0x0c, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x44, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74,
+	0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x22, 0x38, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x64, 0x73, 0x57, 0x69, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x36, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x36, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x56, 0x0a, 0x05, 0x46, 0x75, 0x7a, 0x7a,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x22, 0x34, 0x0a, 0x06, 0x50, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4e, 0x0a, 0x04, 0x4e, 0x65, 0x61, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x32, 0x0a, 0x04, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x34, 0x0a, 0x03, 0x4e, 0x6f,
+	0x74, 0x12, 0x2d, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x35, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x4e, 0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x0b, 0x65, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x4e, 0x75, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x35, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x4e, 0x6f,
+	0x74, 0x12, 0x2b, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x74, 0x72, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x30,
+	0x0a, 0x02, 0x47, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x31, 0x0a, 0x03, 0x47, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x30, 0x0a, 0x02, 0x4c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x31, 0x0a, 0x03, 0x4c, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x34, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62,
+	0x45, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x34,
+	0x0a, 0x06, 0x42, 0x6f, 0x6f, 0x6c, 0x45, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x1e, 0x0a, 0x06, 0x49, 0x73, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x22, 0x1e, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x22, 0x5c, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x3a, 0x0a, 0x02, 0x49, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1e,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x3d,
+	0x0a, 0x05, 0x41, 0x6e, 0x79, 0x4f, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1e, 0x0a,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x3d, 0x0a,
+	0x05, 0x41, 0x6c, 0x6c, 0x4f, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x03,
+	0x41, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x02, 0x4f, 0x72,
+	0x12, 0x25, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07,
+	0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x41, 0x6e,
+	0x64, 0x12, 0x23, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x4e, 0x75, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71,
+	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x05, 0x4e, 0x75, 0x6d, 0x4f, 0x72, 0x12,
+	0x23, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x4e, 0x75, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71, 0x75, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x05, 0x53, 0x74, 0x72, 0x4f, 0x72, 0x12, 0x23, 0x0a,
+	0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x53, 0x74, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x2a, 0x65, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x74, 0x79, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x10,
+	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x10, 0x04, 0x32, 0xdd, 0x03, 0x0a, 0x0c, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x46, 0x65,
+	0x65, 0x64, 0x12, 0x0c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64,
+	0x1a, 0x0d, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
+	0x01, 0x12, 0x2b, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x10, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x2c,
+	0x0a, 0x07, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09,
+	0x4d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x11, 0x2e, 0x4d, 0x61, 0x70, 0x70,
+	0x65, 0x64, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x4d,
+	0x61, 0x70, 0x70, 0x65, 0x64, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x38, 0x0a, 0x0b, 0x55, 0x6e, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12,
+	0x13, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x49,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x14, 0x2e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x12, 0x0f, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x12, 0x10, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6d, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x73,
+	0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x67, 0x6f, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_se_proto_rawDescOnce sync.Once
+	file_proto_se_proto_rawDescData = file_proto_se_proto_rawDesc
+)
+
+func file_proto_se_proto_rawDescGZIP() []byte {
+	file_proto_se_proto_rawDescOnce.Do(func() {
+		file_proto_se_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_se_proto_rawDescData)
+	})
+	return file_proto_se_proto_rawDescData
+}
+
+var file_proto_se_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_se_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
+var file_proto_se_proto_goTypes = []interface{}{
+	(ValueType)(0),                    // 0: ValueType
+	(*Index)(nil),                     // 1: Index
+	(*TextIndex)(nil),                 // 2: TextIndex
+	(*NumberIndex)(nil),               // 3: NumberIndex
+	(*PropertiesIndex)(nil),           // 4: PropertiesIndex
+	(*GeoIndex)(nil),                  // 5: GeoIndex
+	(*GeoPoint)(nil),                  // 6: GeoPoint
+	(*SearchQuery)(nil),               // 7: SearchQuery
+	(*StrQuery)(nil),                  // 8: StrQuery
+	(*NumQuery)(nil),                  // 9: NumQuery
+	(*GeoQuery)(nil),                  // 10: GeoQuery
+	(*GeoAnd)(nil),                    // 11: GeoAnd
+	(*GeoOr)(nil),                     // 12: GeoOr
+	(*GeoNot)(nil),                    // 13: GeoNot
+	(*WithinRadius)(nil),              // 14: WithinRadius
+	(*WithinBox)(nil),                 // 15: WithinBox
+	(*FieldQuery)(nil),                // 16: FieldQuery
+	(*MessageFeed)(nil),               // 17: MessageFeed
+	(*GeoMapping)(nil),                // 18: GeoMapping
+	(*NumberMapping)(nil),             // 19: NumberMapping
+	(*TextMapping)(nil),               // 20: TextMapping
+	(*PropertiesMapping)(nil),         // 21: PropertiesMapping
+	(*ObjectDeletedNotification)(nil), // 22: ObjectDeletedNotification
+	(*SuggestRequest)(nil),            // 23: SuggestRequest
+	(*SuggestResponse)(nil),           // 24: SuggestResponse
+	(*MappedIdsRequest)(nil),          // 25: MappedIdsRequest
+	(*MappedIdsResponse)(nil),         // 26: MappedIdsResponse
+	(*UnmappedIdsRequest)(nil),        // 27: UnmappedIdsRequest
+	(*UnmappedIdsResponse)(nil),       // 28: UnmappedIdsResponse
+	(*ObjectTokensRequest)(nil),       // 29: ObjectTokensRequest
+	(*ObjectTokensResponse)(nil),      // 30: ObjectTokensResponse
+	(*FieldTokens)(nil),               // 31: FieldTokens
+	(*IndexedToken)(nil),              // 32: IndexedToken
+	(*TokenDocumentsRequest)(nil),     // 33: TokenDocumentsRequest
+	(*TokenDocumentsResponse)(nil),    // 34: TokenDocumentsResponse
+	(*TokenPosting)(nil),              // 35: TokenPosting
+	(*AnalyzeRequest)(nil),            // 36: AnalyzeRequest
+	(*AnalyzeResponse)(nil),           // 37: AnalyzeResponse
+	(*SearchExplanation)(nil),         // 38: SearchExplanation
+	(*ConditionExplanation)(nil),      // 39: ConditionExplanation
+	(*HitExplanation)(nil),            // 40: HitExplanation
+	(*TermScore)(nil),                 // 41: TermScore
+	(*Suggestion)(nil),                // 42: Suggestion
+	(*ResearchRequest)(nil),           // 43: ResearchRequest
+	(*SynonymSet)(nil),                // 44: SynonymSet
+	(*SearchResult)(nil),              // 45: SearchResult
+	(*SearchHit)(nil),                 // 46: SearchHit
+	(*Facet)(nil),                     // 47: Facet
+	(*NumRange)(nil),                  // 48: NumRange
+	(*NumBound)(nil),                  // 49: NumBound
+	(*FacetResult)(nil),               // 50: FacetResult
+	(*FacetBucket)(nil),               // 51: FacetBucket
+	(*Highlight)(nil),                 // 52: Highlight
+	(*Fragment)(nil),                  // 53: Fragment
+	(*Span)(nil),                      // 54: Span
+	(*FeedResponse)(nil),              // 55: FeedResponse
+	(*ResearchResponse)(nil),          // 56: ResearchResponse
+	(*StartsWith)(nil),                // 57: StartsWith
+	(*EndsWith)(nil),                  // 58: EndsWith
+	(*Contains)(nil),                  // 59: Contains
+	(*StrEqual)(nil),                  // 60: StrEqual
+	(*Fuzzy)(nil),                     // 61: Fuzzy
+	(*Phrase)(nil),                    // 62: Phrase
+	(*Near)(nil),                      // 63: Near
+	(*Like)(nil),                      // 64: Like
+	(*Not)(nil),                       // 65: Not
+	(*NumNot)(nil),                    // 66: NumNot
+	(*StrNot)(nil),                    // 67: StrNot
+	(*Gt)(nil),                        // 68: Gt
+	(*Gte)(nil),                       // 69: Gte
+	(*Lt)(nil),                        // 70: Lt
+	(*Lte)(nil),                       // 71: Lte
+	(*NumbEq)(nil),                    // 72: NumbEq
+	(*BoolEq)(nil),                    // 73: BoolEq
+	(*IsNull)(nil),                    // 74: IsNull
+	(*Exists)(nil),                    // 75: Exists
+	(*Value)(nil),                     // 76: Value
+	(*In)(nil),                        // 77: In
+	(*AnyOf)(nil),                     // 78: AnyOf
+	(*AllOf)(nil),                     // 79: AllOf
+	(*And)(nil),                       // 80: And
+	(*Or)(nil),                        // 81: Or
+	(*NumAnd)(nil),                    // 82: NumAnd
+	(*NumOr)(nil),                     // 83: NumOr
+	(*StrOr)(nil),                     // 84: StrOr
+	nil,                               // 85: PropertiesIndex.AliasesEntry
+	nil,                               // 86: PropertiesIndex.TypesEntry
+	nil,                               // 87: ResearchRequest.BoostsEntry
+	nil,                               // 88: ResearchRequest.AnalyzersEntry
+}
+var file_proto_se_proto_depIdxs = []int32{
+	2,   // 0: Index.text:type_name -> TextIndex
+	3,   // 1: Index.number:type_name -> NumberIndex
+	4,   // 2: Index.properties:type_name -> PropertiesIndex
+	5,   // 3: Index.geo:type_name -> GeoIndex
+	0,   // 4: NumberIndex.type:type_name -> ValueType
+	85,  // 5: PropertiesIndex.aliases:type_name -> PropertiesIndex.AliasesEntry
+	86,  // 6: PropertiesIndex.types:type_name -> PropertiesIndex.TypesEntry
+	8,   // 7: SearchQuery.text:type_name -> StrQuery
+	9,   // 8: SearchQuery.number:type_name -> NumQuery
+	16,  // 9: SearchQuery.fields:type_name -> FieldQuery
+	10,  // 10: SearchQuery.geo:type_name -> GeoQuery
+	84,  // 11: StrQuery.or:type_name -> StrOr
+	60,  // 12: StrQuery.eq:type_name -> StrEqual
+	59,  // 13: StrQuery.contains:type_name -> Contains
+	57,  // 14: StrQuery.starts_with:type_name -> StartsWith
+	58,  // 15: StrQuery.ends_with:type_name -> EndsWith
+	67,  // 16: StrQuery.not:type_name -> StrNot
+	61,  // 17: StrQuery.fuzzy:type_name -> Fuzzy
+	62,  // 18: StrQuery.phrase:type_name -> Phrase
+	63,  // 19: StrQuery.near:type_name -> Near
+	82,  // 20: NumQuery.and:type_name -> NumAnd
+	83,  // 21: NumQuery.or:type_name -> NumOr
+	68,  // 22: NumQuery.gt:type_name -> Gt
+	69,  // 23: NumQuery.gte:type_name -> Gte
+	70,  // 24: NumQuery.lt:type_name -> Lt
+	71,  // 25: NumQuery.lte:type_name -> Lte
+	72,  // 26: NumQuery.eq:type_name -> NumbEq
+	66,  // 27: NumQuery.not:type_name -> NumNot
+	11,  // 28: GeoQuery.and:type_name -> GeoAnd
+	12,  // 29: GeoQuery.or:type_name -> GeoOr
+	13,  // 30: GeoQuery.not:type_name -> GeoNot
+	14,  // 31: GeoQuery.within_radius:type_name -> WithinRadius
+	15,  // 32: GeoQuery.within_box:type_name -> WithinBox
+	10,  // 33: GeoAnd.queries:type_name -> GeoQuery
+	10,  // 34: GeoOr.queries:type_name -> GeoQuery
+	10,  // 35: GeoNot.expressions:type_name -> GeoQuery
+	6,   // 36: WithinRadius.center:type_name -> GeoPoint
+	6,   // 37: WithinBox.south_west:type_name -> GeoPoint
+	6,   // 38: WithinBox.north_east:type_name -> GeoPoint
+	80,  // 39: FieldQuery.and:type_name -> And
+	81,  // 40: FieldQuery.or:type_name -> Or
+	57,  // 41: FieldQuery.starts_with:type_name -> StartsWith
+	58,  // 42: FieldQuery.ends_with:type_name -> EndsWith
+	59,  // 43: FieldQuery.contains:type_name -> Contains
+	60,  // 44: FieldQuery.str_equal:type_name -> StrEqual
+	70,  // 45: FieldQuery.lt:type_name -> Lt
+	71,  // 46: FieldQuery.lte:type_name -> Lte
+	68,  // 47: FieldQuery.gt:type_name -> Gt
+	69,  // 48: FieldQuery.gte:type_name -> Gte
+	72,  // 49: FieldQuery.numb_eq:type_name -> NumbEq
+	65,  // 50: FieldQuery.not:type_name -> Not
+	73,  // 51: FieldQuery.bool_eq:type_name -> BoolEq
+	74,  // 52: FieldQuery.is_null:type_name -> IsNull
+	75,  // 53: FieldQuery.exists:type_name -> Exists
+	77,  // 54: FieldQuery.in:type_name -> In
+	78,  // 55: FieldQuery.any_of:type_name -> AnyOf
+	79,  // 56: FieldQuery.all_of:type_name -> AllOf
+	19,  // 57: MessageFeed.num_mapping:type_name -> NumberMapping
+	20,  // 58: MessageFeed.text_mapping:type_name -> TextMapping
+	21,  // 59: MessageFeed.properties_mapping:type_name -> PropertiesMapping
+	22,  // 60: MessageFeed.delete:type_name -> ObjectDeletedNotification
+	18,  // 61: MessageFeed.geo_mapping:type_name -> GeoMapping
+	6,   // 62: GeoMapping.point:type_name -> GeoPoint
+	42,  // 63: SuggestResponse.suggestions:type_name -> Suggestion
+	31,  // 64: ObjectTokensResponse.fields:type_name -> FieldTokens
+	32,  // 65: FieldTokens.tokens:type_name -> IndexedToken
+	35,  // 66: TokenDocumentsResponse.postings:type_name -> TokenPosting
+	39,  // 67: SearchExplanation.conditions:type_name -> ConditionExplanation
+	40,  // 68: SearchExplanation.hits:type_name -> HitExplanation
+	41,  // 69: HitExplanation.terms:type_name -> TermScore
+	7,   // 70: ResearchRequest.query:type_name -> SearchQuery
+	87,  // 71: ResearchRequest.boosts:type_name -> ResearchRequest.BoostsEntry
+	88,  // 72: ResearchRequest.analyzers:type_name -> ResearchRequest.AnalyzersEntry
+	47,  // 73: ResearchRequest.facets:type_name -> Facet
+	44,  // 74: ResearchRequest.synonyms:type_name -> SynonymSet
+	6,   // 75: ResearchRequest.origin:type_name -> GeoPoint
+	46,  // 76: SearchResult.hits:type_name -> SearchHit
+	50,  // 77: SearchResult.facets:type_name -> FacetResult
+	48,  // 78: Facet.ranges:type_name -> NumRange
+	49,  // 79: NumRange.from:type_name -> NumBound
+	49,  // 80: NumRange.to:type_name -> NumBound
+	51,  // 81: FacetResult.buckets:type_name -> FacetBucket
+	53,  // 82: Highlight.fragments:type_name -> Fragment
+	54,  // 83: Fragment.matches:type_name -> Span
+	46,  // 84: ResearchResponse.hits:type_name -> SearchHit
+	16,  // 85: Not.expressions:type_name -> FieldQuery
+	9,   // 86: NumNot.expressions:type_name -> NumQuery
+	8,   // 87: StrNot.expressions:type_name -> StrQuery
+	76,  // 88: In.values:type_name -> Value
+	76,  // 89: AnyOf.values:type_name -> Value
+	76,  // 90: AllOf.values:type_name -> Value
+	16,  // 91: And.queries:type_name -> FieldQuery
+	16,  // 92: Or.queries:type_name -> FieldQuery
+	9,   // 93: NumAnd.queries:type_name -> NumQuery
+	9,   // 94: NumOr.queries:type_name -> NumQuery
+	8,   // 95: StrOr.queries:type_name -> StrQuery
+	0,   // 96: PropertiesIndex.TypesEntry.value:type_name -> ValueType
+	17,  // 97: SearchEngine.Feed:input_type -> MessageFeed
+	43,  // 98: SearchEngine.Search:input_type -> ResearchRequest
+	23,  // 99: SearchEngine.Suggest:input_type -> SuggestRequest
+	25,  // 100: SearchEngine.MappedIds:input_type -> MappedIdsRequest
+	27,  // 101: SearchEngine.UnmappedIds:input_type -> UnmappedIdsRequest
+	29,  // 102: SearchEngine.ObjectTokens:input_type -> ObjectTokensRequest
+	33,  // 103: SearchEngine.TokenDocuments:input_type -> TokenDocumentsRequest
+	36,  // 104: SearchEngine.Analyze:input_type -> AnalyzeRequest
+	43,  // 105: SearchEngine.Explain:input_type -> ResearchRequest
+	55,  // 106: SearchEngine.Feed:output_type -> FeedResponse
+	45,  // 107: SearchEngine.Search:output_type -> SearchResult
+	24,  // 108: SearchEngine.Suggest:output_type -> SuggestResponse
+	26,  // 109: SearchEngine.MappedIds:output_type -> MappedIdsResponse
+	28,  // 110: SearchEngine.UnmappedIds:output_type -> UnmappedIdsResponse
+	30,  // 111: SearchEngine.ObjectTokens:output_type -> ObjectTokensResponse
+	34,  // 112: SearchEngine.TokenDocuments:output_type -> TokenDocumentsResponse
+	37,  // 113: SearchEngine.Analyze:output_type -> AnalyzeResponse
+	38,  // 114: SearchEngine.Explain:output_type -> SearchExplanation
+	106, // [106:115] is the sub-list for method output_type
+	97,  // [97:106] is the sub-list for method input_type
+	97,  // [97:97] is the sub-list for extension type_name
+	97,  // [97:97] is the sub-list for extension extendee
+	0,   // [0:97] is the sub-list for field type_name
+}
+
+func init() { file_proto_se_proto_init() }
+func file_proto_se_proto_init() {
+	if File_proto_se_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_se_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Index); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumberIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PropertiesIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StrQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoAnd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoOr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoNot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithinRadius); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithinBox); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageFeed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumberMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PropertiesMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectDeletedNotification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MappedIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MappedIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnmappedIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnmappedIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectTokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectTokensResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldTokens); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexedToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenDocumentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenDocumentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenPosting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyzeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyzeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConditionExplanation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2520,8 +7104,8 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TextIndex); i {
+		file_proto_se_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HitExplanation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2532,8 +7116,8 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NumberIndex); i {
+		file_proto_se_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TermScore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2544,8 +7128,8 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PropertiesIndex); i {
+		file_proto_se_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Suggestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2556,8 +7140,8 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchQuery); i {
+		file_proto_se_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2568,8 +7152,8 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StrQuery); i {
+		file_proto_se_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SynonymSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2580,8 +7164,8 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NumQuery); i {
+		file_proto_se_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2592,8 +7176,8 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldQuery); i {
+		file_proto_se_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchHit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2604,8 +7188,8 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageFeed); i {
+		file_proto_se_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Facet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2616,8 +7200,8 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NumberMapping); i {
+		file_proto_se_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2628,8 +7212,8 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TextMapping); i {
+		file_proto_se_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumBound); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2640,8 +7224,8 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PropertiesMapping); i {
+		file_proto_se_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FacetResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2652,8 +7236,8 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObjectDeletedNotification); i {
+		file_proto_se_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FacetBucket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2664,8 +7248,8 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResearchRequest); i {
+		file_proto_se_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Highlight); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2676,8 +7260,8 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchResult); i {
+		file_proto_se_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fragment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2688,7 +7272,19 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Span); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FeedResponse); i {
 			case 0:
 				return &v.state
@@ -2700,7 +7296,7 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResearchResponse); i {
 			case 0:
 				return &v.state
@@ -2712,7 +7308,7 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StartsWith); i {
 			case 0:
 				return &v.state
@@ -2724,7 +7320,7 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EndsWith); i {
 			case 0:
 				return &v.state
@@ -2736,7 +7332,7 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Contains); i {
 			case 0:
 				return &v.state
@@ -2748,7 +7344,7 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StrEqual); i {
 			case 0:
 				return &v.state
@@ -2760,7 +7356,43 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fuzzy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Phrase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Near); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Like); i {
 			case 0:
 				return &v.state
@@ -2772,7 +7404,7 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Not); i {
 			case 0:
 				return &v.state
@@ -2784,7 +7416,31 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumNot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StrNot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Gt); i {
 			case 0:
 				return &v.state
@@ -2796,7 +7452,7 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Gte); i {
 			case 0:
 				return &v.state
@@ -2808,7 +7464,7 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Lt); i {
 			case 0:
 				return &v.state
@@ -2820,7 +7476,7 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Lte); i {
 			case 0:
 				return &v.state
@@ -2832,7 +7488,7 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NumbEq); i {
 			case 0:
 				return &v.state
@@ -2844,7 +7500,91 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoolEq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsNull); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Exists); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*In); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnyOf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllOf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_se_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*And); i {
 			case 0:
 				return &v.state
@@ -2856,7 +7596,7 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Or); i {
 			case 0:
 				return &v.state
@@ -2868,7 +7608,7 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NumAnd); i {
 			case 0:
 				return &v.state
@@ -2880,7 +7620,7 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NumOr); i {
 			case 0:
 				return &v.state
@@ -2892,7 +7632,7 @@ func file_proto_se_proto_init() {
 				return nil
 			}
 		}
-		file_proto_se_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_se_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StrOr); i {
 			case 0:
 				return &v.state
@@ -2909,20 +7649,26 @@ func file_proto_se_proto_init() {
 		(*Index_Text)(nil),
 		(*Index_Number)(nil),
 		(*Index_Properties)(nil),
+		(*Index_Geo)(nil),
 	}
-	file_proto_se_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_proto_se_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*SearchQuery_Text)(nil),
 		(*SearchQuery_Number)(nil),
 		(*SearchQuery_Fields)(nil),
+		(*SearchQuery_Geo)(nil),
 	}
-	file_proto_se_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_proto_se_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*StrQuery_Or)(nil),
 		(*StrQuery_Eq)(nil),
 		(*StrQuery_Contains)(nil),
 		(*StrQuery_StartsWith)(nil),
 		(*StrQuery_EndsWith)(nil),
+		(*StrQuery_Not)(nil),
+		(*StrQuery_Fuzzy)(nil),
+		(*StrQuery_Phrase)(nil),
+		(*StrQuery_Near)(nil),
 	}
-	file_proto_se_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_proto_se_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*NumQuery_And)(nil),
 		(*NumQuery_Or)(nil),
 		(*NumQuery_Gt)(nil),
@@ -2930,8 +7676,16 @@ func file_proto_se_proto_init() {
 		(*NumQuery_Lt)(nil),
 		(*NumQuery_Lte)(nil),
 		(*NumQuery_Eq)(nil),
+		(*NumQuery_Not)(nil),
 	}
-	file_proto_se_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_proto_se_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*GeoQuery_And)(nil),
+		(*GeoQuery_Or)(nil),
+		(*GeoQuery_Not)(nil),
+		(*GeoQuery_WithinRadius)(nil),
+		(*GeoQuery_WithinBox)(nil),
+	}
+	file_proto_se_proto_msgTypes[15].OneofWrappers = []interface{}{
 		(*FieldQuery_And)(nil),
 		(*FieldQuery_Or)(nil),
 		(*FieldQuery_StartsWith)(nil),
@@ -2943,25 +7697,39 @@ func file_proto_se_proto_init() {
 		(*FieldQuery_Gt)(nil),
 		(*FieldQuery_Gte)(nil),
 		(*FieldQuery_NumbEq)(nil),
-	}
-	file_proto_se_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*FieldQuery_Not)(nil),
+		(*FieldQuery_BoolEq)(nil),
+		(*FieldQuery_IsNull)(nil),
+		(*FieldQuery_Exists)(nil),
+		(*FieldQuery_In)(nil),
+		(*FieldQuery_AnyOf)(nil),
+		(*FieldQuery_AllOf)(nil),
+	}
+	file_proto_se_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*MessageFeed_NumMapping)(nil),
 		(*MessageFeed_TextMapping)(nil),
 		(*MessageFeed_PropertiesMapping)(nil),
 		(*MessageFeed_Delete)(nil),
+		(*MessageFeed_GeoMapping)(nil),
+	}
+	file_proto_se_proto_msgTypes[75].OneofWrappers = []interface{}{
+		(*Value_Text)(nil),
+		(*Value_Number)(nil),
+		(*Value_Boolean)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_se_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   34,
+			NumEnums:      1,
+			NumMessages:   88,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_se_proto_goTypes,
 		DependencyIndexes: file_proto_se_proto_depIdxs,
+		EnumInfos:         file_proto_se_proto_enumTypes,
 		MessageInfos:      file_proto_se_proto_msgTypes,
 	}.Build()
 	File_proto_se_proto = out.File
@@ -19,6 +19,13 @@ const _ = grpc.SupportPackageIsVersion7
 type SearchEngineClient interface {
 	Feed(ctx context.Context, opts ...grpc.CallOption) (SearchEngine_FeedClient, error)
 	Search(ctx context.Context, in *ResearchRequest, opts ...grpc.CallOption) (SearchEngine_SearchClient, error)
+	Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error)
+	MappedIds(ctx context.Context, in *MappedIdsRequest, opts ...grpc.CallOption) (*MappedIdsResponse, error)
+	UnmappedIds(ctx context.Context, in *UnmappedIdsRequest, opts ...grpc.CallOption) (*UnmappedIdsResponse, error)
+	ObjectTokens(ctx context.Context, in *ObjectTokensRequest, opts ...grpc.CallOption) (*ObjectTokensResponse, error)
+	TokenDocuments(ctx context.Context, in *TokenDocumentsRequest, opts ...grpc.CallOption) (*TokenDocumentsResponse, error)
+	Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
+	Explain(ctx context.Context, in *ResearchRequest, opts ...grpc.CallOption) (*SearchExplanation, error)
 }
 
 type searchEngineClient struct {
@@ -95,12 +102,82 @@ func (x *searchEngineSearchClient) Recv() (*SearchResult, error) {
 	return m, nil
 }
 
+func (c *searchEngineClient) Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error) {
+	out := new(SuggestResponse)
+	err := c.cc.Invoke(ctx, "/SearchEngine/Suggest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchEngineClient) MappedIds(ctx context.Context, in *MappedIdsRequest, opts ...grpc.CallOption) (*MappedIdsResponse, error) {
+	out := new(MappedIdsResponse)
+	err := c.cc.Invoke(ctx, "/SearchEngine/MappedIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchEngineClient) UnmappedIds(ctx context.Context, in *UnmappedIdsRequest, opts ...grpc.CallOption) (*UnmappedIdsResponse, error) {
+	out := new(UnmappedIdsResponse)
+	err := c.cc.Invoke(ctx, "/SearchEngine/UnmappedIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchEngineClient) ObjectTokens(ctx context.Context, in *ObjectTokensRequest, opts ...grpc.CallOption) (*ObjectTokensResponse, error) {
+	out := new(ObjectTokensResponse)
+	err := c.cc.Invoke(ctx, "/SearchEngine/ObjectTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchEngineClient) TokenDocuments(ctx context.Context, in *TokenDocumentsRequest, opts ...grpc.CallOption) (*TokenDocumentsResponse, error) {
+	out := new(TokenDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/SearchEngine/TokenDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchEngineClient) Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error) {
+	out := new(AnalyzeResponse)
+	err := c.cc.Invoke(ctx, "/SearchEngine/Analyze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchEngineClient) Explain(ctx context.Context, in *ResearchRequest, opts ...grpc.CallOption) (*SearchExplanation, error) {
+	out := new(SearchExplanation)
+	err := c.cc.Invoke(ctx, "/SearchEngine/Explain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SearchEngineServer is the server API for SearchEngine service.
 // All implementations must embed UnimplementedSearchEngineServer
 // for forward compatibility
 type SearchEngineServer interface {
 	Feed(SearchEngine_FeedServer) error
 	Search(*ResearchRequest, SearchEngine_SearchServer) error
+	Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error)
+	MappedIds(context.Context, *MappedIdsRequest) (*MappedIdsResponse, error)
+	UnmappedIds(context.Context, *UnmappedIdsRequest) (*UnmappedIdsResponse, error)
+	ObjectTokens(context.Context, *ObjectTokensRequest) (*ObjectTokensResponse, error)
+	TokenDocuments(context.Context, *TokenDocumentsRequest) (*TokenDocumentsResponse, error)
+	Analyze(context.Context, *AnalyzeRequest) (*AnalyzeResponse, error)
+	Explain(context.Context, *ResearchRequest) (*SearchExplanation, error)
 	mustEmbedUnimplementedSearchEngineServer()
 }
 
@@ -114,6 +191,27 @@ func (UnimplementedSearchEngineServer) Feed(SearchEngine_FeedServer) error {
 func (UnimplementedSearchEngineServer) Search(*ResearchRequest, SearchEngine_SearchServer) error {
 	return status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedSearchEngineServer) Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
+func (UnimplementedSearchEngineServer) MappedIds(context.Context, *MappedIdsRequest) (*MappedIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MappedIds not implemented")
+}
+func (UnimplementedSearchEngineServer) UnmappedIds(context.Context, *UnmappedIdsRequest) (*UnmappedIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmappedIds not implemented")
+}
+func (UnimplementedSearchEngineServer) ObjectTokens(context.Context, *ObjectTokensRequest) (*ObjectTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectTokens not implemented")
+}
+func (UnimplementedSearchEngineServer) TokenDocuments(context.Context, *TokenDocumentsRequest) (*TokenDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenDocuments not implemented")
+}
+func (UnimplementedSearchEngineServer) Analyze(context.Context, *AnalyzeRequest) (*AnalyzeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Analyze not implemented")
+}
+func (UnimplementedSearchEngineServer) Explain(context.Context, *ResearchRequest) (*SearchExplanation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Explain not implemented")
+}
 func (UnimplementedSearchEngineServer) mustEmbedUnimplementedSearchEngineServer() {}
 
 // UnsafeSearchEngineServer may be embedded to opt out of forward compatibility for this service.
@@ -174,10 +272,165 @@ func (x *searchEngineSearchServer) Send(m *SearchResult) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _SearchEngine_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchEngineServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SearchEngine/Suggest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchEngineServer).Suggest(ctx, req.(*SuggestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchEngine_MappedIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MappedIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchEngineServer).MappedIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SearchEngine/MappedIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchEngineServer).MappedIds(ctx, req.(*MappedIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchEngine_UnmappedIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmappedIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchEngineServer).UnmappedIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SearchEngine/UnmappedIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchEngineServer).UnmappedIds(ctx, req.(*UnmappedIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchEngine_ObjectTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchEngineServer).ObjectTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SearchEngine/ObjectTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchEngineServer).ObjectTokens(ctx, req.(*ObjectTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchEngine_TokenDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchEngineServer).TokenDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SearchEngine/TokenDocuments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchEngineServer).TokenDocuments(ctx, req.(*TokenDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchEngine_Analyze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchEngineServer).Analyze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SearchEngine/Analyze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchEngineServer).Analyze(ctx, req.(*AnalyzeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchEngine_Explain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchEngineServer).Explain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SearchEngine/Explain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchEngineServer).Explain(ctx, req.(*ResearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SearchEngine_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "SearchEngine",
 	HandlerType: (*SearchEngineServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Suggest",
+			Handler:    _SearchEngine_Suggest_Handler,
+		},
+		{
+			MethodName: "MappedIds",
+			Handler:    _SearchEngine_MappedIds_Handler,
+		},
+		{
+			MethodName: "UnmappedIds",
+			Handler:    _SearchEngine_UnmappedIds_Handler,
+		},
+		{
+			MethodName: "ObjectTokens",
+			Handler:    _SearchEngine_ObjectTokens_Handler,
+		},
+		{
+			MethodName: "TokenDocuments",
+			Handler:    _SearchEngine_TokenDocuments_Handler,
+		},
+		{
+			MethodName: "Analyze",
+			Handler:    _SearchEngine_Analyze_Handler,
+		},
+		{
+			MethodName: "Explain",
+			Handler:    _SearchEngine_Explain_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "Feed",
@@ -3,7 +3,7 @@ module github.com/omecodes/store
 go 1.15
 
 require (
-	github.com/PaesslerAG/gval v1.1.0 // indirect
+	github.com/PaesslerAG/gval v1.1.0
 	github.com/PaesslerAG/jsonpath v0.1.1
 	github.com/antlr/antlr4 v0.0.0-20210203043838-a60c32d36933 // indirect
 	github.com/golang/protobuf v1.4.3
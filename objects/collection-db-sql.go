@@ -9,18 +9,30 @@ import (
 	"github.com/omecodes/bome"
 	"github.com/omecodes/errors"
 	"github.com/omecodes/libome/logs"
+	"github.com/omecodes/store/auth"
 	"github.com/omecodes/store/common/utime"
 	pb "github.com/omecodes/store/gen/go/proto"
 	se "github.com/omecodes/store/search-engine"
 	"github.com/tidwall/gjson"
 	"io"
-	"strconv"
 	"strings"
+	"sync"
 )
 
 const objectScanner = "object"
 
 func NewSQLCollection(collection *pb.Collection, db *sql.DB, dialect string, tablePrefix string) (*sqlCollection, error) {
+	indexTablePrefix := tablePrefix + "_index"
+	indexStore, err := se.NewSQLIndexStore(db, dialect, indexTablePrefix)
+	if err != nil {
+		return nil, err
+	}
+	return newSQLCollection(collection, db, dialect, tablePrefix, se.NewEngine(indexStore), IndexingOptions{})
+}
+
+// newSQLCollection creates a collection whose objects are stored in tables prefixed with tablePrefix and indexed by
+// engine. Index changes are written in an outbox table along with the objects, then applied by a worker tuned by indexing
+func newSQLCollection(collection *pb.Collection, db *sql.DB, dialect string, tablePrefix string, engine se.Service, indexing IndexingOptions) (*sqlCollection, error) {
 	objectsTableName := tablePrefix + "_objects"
 	objects, err := bome.Build().
 		SetDialect(dialect).
@@ -53,8 +65,7 @@ func NewSQLCollection(collection *pb.Collection, db *sql.DB, dialect string, tab
 		return nil, err
 	}
 
-	indexTablePrefix := tablePrefix + "_index"
-	indexStore, err := se.NewSQLIndexStore(db, dialect, indexTablePrefix)
+	indexer, err := newIndexer(db, dialect, tablePrefix+"_index_outbox", engine, indexing)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +76,8 @@ func NewSQLCollection(collection *pb.Collection, db *sql.DB, dialect string, tab
 		objects: objects,
 		headers: headers,
 		info:    collection,
-		engine:  se.NewEngine(indexStore),
+		engine:  engine,
+		indexer: indexer,
 	}
 
 	objects.RegisterScanner(objectScanner, bome.NewScannerFunc(s.scanFullObject))
@@ -76,7 +88,17 @@ type sqlCollection struct {
 	info    *pb.Collection
 	dialect string
 	db      *sql.DB
-	engine  *se.Engine
+	engine  se.Service
+	indexer *indexer
+
+	// synonyms returns the synonym sets that apply to the collection. Collections created without an objects DB
+	// have none
+	synonyms func() ([]*pb.SynonymSet, error)
+
+	// saveInfo saves the collection info after the type of an indexed field is detected. typesMutex guards the
+	// types of the indexes fields
+	saveInfo   func(*pb.Collection) error
+	typesMutex sync.Mutex
 
 	indexes []*pb.Index
 
@@ -84,6 +106,18 @@ type sqlCollection struct {
 	headers *bome.JSONMap
 }
 
+// Close stops the indexing worker, then the search engine client when the objects are indexed by a search engine
+// service. Disk index stores are shared by the managers of a collection: the objects DB closes them
+func (s *sqlCollection) Close() error {
+	err := s.indexer.Close()
+	if closer, ok := s.engine.(io.Closer); ok {
+		if cerr := closer.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
 func (s *sqlCollection) Objects() *bome.JSONMappingList {
 	return s.objects
 }
@@ -97,7 +131,23 @@ func (s *sqlCollection) Save(ctx context.Context, object *pb.Object, indexes ...
 		object.Header.CreatedAt = utime.Now()
 	}
 
-	var err error
+	if object.Header.UpdatedAt == 0 {
+		object.Header.UpdatedAt = object.Header.CreatedAt
+		object.Header.UpdatedBy = object.Header.CreatedBy
+	}
+
+	// the indexed values are checked before anything is written: recording the types detected for the fields of the
+	// number and properties indexes writes the collections table
+	err := s.detectTypes(object)
+	if err != nil {
+		return err
+	}
+
+	feeds, err := s.indexFeeds(object, indexes...)
+	if err != nil {
+		return err
+	}
+
 	var objects *bome.JSONMappingList
 	var headers *bome.JSONMap
 
@@ -112,8 +162,46 @@ func (s *sqlCollection) Save(ctx context.Context, object *pb.Object, indexes ...
 		return errors.Internal("database error")
 	}
 
+	ctx, headers, err = s.headers.Transaction(ctx)
+	if err != nil {
+		logs.Error("Save: failed to continue transactions with headers", logs.Err(err))
+		if err2 := bome.Rollback(ctx); err2 != nil {
+			logs.Error("Save: rollback failed", logs.Err(err2))
+		}
+		return err
+	}
+
+	// an object saved again replaces the previous one: it keeps its creation metadata and counts as an edit, and the
+	// mappings of its previous data are deleted before the new ones are applied
+	hv, err := headers.Get(object.Header.Id)
+	if err == nil {
+		previous := &pb.Header{}
+		err = json.Unmarshal([]byte(hv), previous)
+		if err != nil {
+			logs.Error("Save: could not decode object header", logs.Details("id", object.Header.Id), logs.Err(err))
+			if err2 := bome.Rollback(ctx); err2 != nil {
+				logs.Error("Save: rollback failed", logs.Err(err2))
+			}
+			return errors.Internal("could not decode object header")
+		}
+
+		object.Header.CreatedAt = previous.CreatedAt
+		object.Header.CreatedBy = previous.CreatedBy
+		object.Header.Edits = previous.Edits
+		touchHeader(ctx, object.Header)
+
+		deletion := &pb.MessageFeed{Message: &pb.MessageFeed_Delete{Delete: &pb.ObjectDeletedNotification{Id: object.Header.Id}}}
+		feeds = append([]*pb.MessageFeed{deletion}, feeds...)
+	} else if !errors.IsNotFound(err) {
+		logs.Error("Save: could not get object header", logs.Details("id", object.Header.Id), logs.Err(err))
+		if err2 := bome.Rollback(ctx); err2 != nil {
+			logs.Error("Save: rollback failed", logs.Err(err2))
+		}
+		return errors.Internal("database error")
+	}
+
 	// Save object
-	err = objects.Save(&bome.PairListEntry{
+	err = objects.Upsert(&bome.PairListEntry{
 		Index: object.Header.CreatedAt,
 		Key:   object.Header.Id,
 		Value: object.Data,
@@ -148,16 +236,7 @@ func (s *sqlCollection) Save(ctx context.Context, object *pb.Object, indexes ...
 	}
 
 	// Save object header
-	ctx, headers, err = s.headers.Transaction(ctx)
-	if err != nil {
-		logs.Error("Save: failed to continue transactions with headers", logs.Err(err))
-		if err2 := bome.Rollback(ctx); err2 != nil {
-			logs.Error("Save: rollback failed", logs.Err(err2))
-		}
-		return err
-	}
-
-	err = headers.Save(&bome.MapEntry{
+	err = headers.Upsert(&bome.MapEntry{
 		Key:   object.Header.Id,
 		Value: string(headersData),
 	})
@@ -169,101 +248,10 @@ func (s *sqlCollection) Save(ctx context.Context, object *pb.Object, indexes ...
 		return err
 	}
 
-	textIndexes := append(s.info.TextIndexes, indexes...)
-	for _, index := range textIndexes {
-		result := gjson.Get(object.Data, strings.TrimPrefix(index.Path, "$."))
-		if !result.Exists() {
-			logs.Error("Save: Text index references path that does not exists", logs.Details("path", index.Path))
-			continue
-		}
-
-		if result.Type != gjson.String {
-			logs.Error("Save: Text index supports only text field", logs.Err(err))
-			if err2 := headers.Rollback(); err2 != nil {
-				logs.Error("Save: rollback failed", logs.Err(err2))
-			}
-			return errors.BadRequest("expecting string value at the index path", errors.Details{Key: index.Path, Value: result.Value()})
-		}
-
-		mp := &pb.TextMapping{
-			Text:     result.Str,
-			Name:     index.Alias,
-			ObjectId: object.Header.Id,
-		}
-		err = s.engine.CreateTextMapping(mp)
-		if err != nil {
-			logs.Error("Save: failed to create text mapping", logs.Details("path", index.Path), logs.Details("data", object.Data), logs.Err(err))
-			if err2 := bome.Rollback(ctx); err2 != nil {
-				logs.Error("Save: rollback failed", logs.Err(err2))
-			}
-			return errors.Internal("could not create index mapping")
-		}
-	}
-
-	if s.info.NumberIndex != nil {
-		result := gjson.Get(object.Data, strings.TrimPrefix(s.info.NumberIndex.Path, "$."))
-		if !result.Exists() {
-			logs.Error("Save: Number index references path that does not exists", logs.Details("path", s.info.NumberIndex.Path))
-		} else {
-			if result.Type != gjson.Number {
-				logs.Error("Save: Number index supports only number field", logs.Err(err))
-				if err2 := bome.Rollback(ctx); err2 != nil {
-					logs.Error("Save: rollback failed", logs.Err(err2))
-				}
-				return errors.BadRequest("expecting number value at the index path", errors.Details{Key: s.info.NumberIndex.Path, Value: result.Value()})
-			}
-
-			mp := &pb.NumberMapping{
-				Number:   result.Int(),
-				Name:     s.info.NumberIndex.Alias,
-				ObjectId: object.Header.Id,
-			}
-			err = s.engine.CreateNumberMapping(mp)
-			if err != nil {
-				logs.Error("Save: failed to create number mapping", logs.Err(err))
-				if err2 := bome.Rollback(ctx); err2 != nil {
-					logs.Error("Save: rollback failed", logs.Err(err2))
-				}
-				return errors.Internal("could not save index mapping")
-			}
-		}
-	}
-
-	if s.info.FieldsIndex != nil && len(s.info.FieldsIndex.Aliases) > 0 {
-		props := map[string]interface{}{}
-		for path, alias := range s.info.FieldsIndex.Aliases {
-			result := gjson.Get(object.Data, strings.TrimPrefix(path, "$."))
-			if !result.Exists() {
-				logs.Error("Save: Field index references path that does not exists", logs.Details("path", path))
-				continue
-			}
-
-			if result.Type == gjson.JSON {
-				logs.Error("Save: Text index supports only text text, number and boolean", logs.Err(err))
-				if err2 := bome.Rollback(ctx); err2 != nil {
-					logs.Error("Save: rollback failed", logs.Err(err2))
-				}
-				return errors.BadRequest("expecting json value at the index path", errors.Details{Key: s.info.NumberIndex.Path, Value: result.Value()})
-			}
-			props[alias] = result.Value()
-		}
-
-		value, err := json.Marshal(props)
-		if err != nil {
-			logs.Error("Save: could not create properties index", logs.Err(err))
-			if err2 := bome.Rollback(ctx); err2 != nil {
-				logs.Error("Save: rollback failed", logs.Err(err2))
-			}
-			return errors.BadRequest("could not encode indexed sub object")
-		}
-
-		mp := &pb.PropertiesMapping{
-			ObjectId: object.Header.Id,
-			Json:     string(value),
-		}
-		err = s.engine.CreatePropertiesMapping(mp)
+	for _, feed := range feeds {
+		err = s.indexer.enqueue(ctx, object.Header.Id, feed)
 		if err != nil {
-			logs.Error("Save: failed to create fields mapping", logs.Err(err))
+			logs.Error("Save: failed to queue index mapping", logs.Details("data", object.Data), logs.Err(err))
 			if err2 := bome.Rollback(ctx); err2 != nil {
 				logs.Error("Save: rollback failed", logs.Err(err2))
 			}
@@ -277,19 +265,22 @@ func (s *sqlCollection) Save(ctx context.Context, object *pb.Object, indexes ...
 		return errors.Internal("database transaction commit error")
 	}
 	logs.Debug("Save: object saved", logs.Details("id", object.Header.Id))
+
+	s.indexer.notify()
+	if waitsIndexVisibility(ctx) {
+		return s.waitVisible(ctx, object.Header.Id)
+	}
 	return nil
 }
 
 func (s *sqlCollection) Patch(ctx context.Context, patch *pb.Patch) error {
-	value := sqlJSONSetValue(patch.Data)
-
 	txCtx, objects, err := s.objects.Transaction(ctx)
 	if err != nil {
 		logs.Error("Patch: could not start objects DB transaction", logs.Err(err))
 		return errors.Internal("database transaction initialization")
 	}
 
-	err = objects.EditAt(patch.ObjectId, patch.At, bome.RawExpr(value))
+	err = s.editAt(objects, patch.ObjectId, patch.At, patch.Data)
 	if err != nil {
 		logs.Error("Update: object patch failed", logs.Details("id", patch.ObjectId), logs.Err(err))
 		return errors.Internal("could not edit object")
@@ -305,7 +296,73 @@ func (s *sqlCollection) Patch(ctx context.Context, patch *pb.Patch) error {
 	}
 
 	_, headers, _ := s.headers.Transaction(txCtx)
-	err = headers.EditAt(patch.ObjectId, "$.size", bome.IntExpr(size))
+	hv, err := headers.Get(patch.ObjectId)
+	if err != nil {
+		logs.Error("Patch: could not get object header", logs.Details("id", patch.ObjectId), logs.Err(err))
+		if err := bome.Rollback(txCtx); err != nil {
+			logs.Error("Patch: rollback failed", logs.Err(err))
+		}
+		return errors.Internal("could not edit object")
+	}
+
+	header := &pb.Header{}
+	err = json.Unmarshal([]byte(hv), header)
+	if err != nil {
+		logs.Error("Patch: could not decode object header", logs.Details("id", patch.ObjectId), logs.Err(err))
+		if err := bome.Rollback(txCtx); err != nil {
+			logs.Error("Patch: rollback failed", logs.Err(err))
+		}
+		return errors.Internal("could not decode object header")
+	}
+
+	header.Size = size
+	touchHeader(ctx, header)
+
+	// the patched object is indexed again from its new data. Detected types are recorded once the patch is committed:
+	// it writes the collections table
+	entry, err := objects.Get(patch.ObjectId)
+	if err != nil {
+		logs.Error("Patch: could not load patched object", logs.Details("id", patch.ObjectId), logs.Err(err))
+		if err := bome.Rollback(txCtx); err != nil {
+			logs.Error("Patch: rollback failed", logs.Err(err))
+		}
+		return errors.Internal("could not edit object")
+	}
+
+	patched := &pb.Object{Header: header, Data: entry.Value}
+	feeds, err := s.indexFeeds(patched)
+	if err != nil {
+		if err := bome.Rollback(txCtx); err != nil {
+			logs.Error("Patch: rollback failed", logs.Err(err))
+		}
+		return err
+	}
+
+	deletion := &pb.MessageFeed{Message: &pb.MessageFeed_Delete{Delete: &pb.ObjectDeletedNotification{Id: patch.ObjectId}}}
+	for _, feed := range append([]*pb.MessageFeed{deletion}, feeds...) {
+		err = s.indexer.enqueue(txCtx, patch.ObjectId, feed)
+		if err != nil {
+			logs.Error("Patch: failed to queue index mapping", logs.Details("id", patch.ObjectId), logs.Err(err))
+			if err := bome.Rollback(txCtx); err != nil {
+				logs.Error("Patch: rollback failed", logs.Err(err))
+			}
+			return errors.Internal("could not create index mapping")
+		}
+	}
+
+	headersData, err := json.Marshal(header)
+	if err != nil {
+		logs.Error("Patch: could not encode object header", logs.Err(err))
+		if err := bome.Rollback(txCtx); err != nil {
+			logs.Error("Patch: rollback failed", logs.Err(err))
+		}
+		return errors.Internal("could not encode object header")
+	}
+
+	err = headers.Update(&bome.MapEntry{
+		Key:   patch.ObjectId,
+		Value: string(headersData),
+	})
 	if err != nil {
 		logs.Error("Patch: failed to save object headers", logs.Err(err))
 		if err := bome.Rollback(txCtx); err != nil {
@@ -321,23 +378,67 @@ func (s *sqlCollection) Patch(ctx context.Context, patch *pb.Patch) error {
 	}
 
 	logs.Debug("Patch: object updated", logs.Details("id", patch.ObjectId))
+
+	err = s.detectTypes(patched)
+	if err != nil {
+		logs.Error("Patch: could not record the types of the indexed values", logs.Details("id", patch.ObjectId), logs.Err(err))
+	}
+
+	s.indexer.notify()
+	if waitsIndexVisibility(ctx) {
+		return s.waitVisible(ctx, patch.ObjectId)
+	}
 	return nil
 }
 
-func (s *sqlCollection) Delete(_ context.Context, objectID string) error {
-	go func() {
-		if der := s.engine.DeleteObjectMappings(objectID); der != nil {
-			logs.Error("failed to delete object index mappings", logs.Err(der))
-		}
-	}()
+func (s *sqlCollection) Delete(ctx context.Context, objectID string) error {
+	txCtx, objects, err := s.objects.Transaction(ctx)
+	if err != nil {
+		logs.Error("Delete: could not start objects DB transaction", logs.Err(err))
+		return errors.Internal("database transaction initialization")
+	}
 
-	err := s.objects.Delete(objectID)
+	err = objects.Delete(objectID)
 	if err != nil {
 		logs.Error("Delete: object deletion failed", logs.Err(err))
+		if err := bome.Rollback(txCtx); err != nil {
+			logs.Error("Delete: rollback failed", logs.Err(err))
+		}
 		return errors.Internal("could not delete object")
 	}
 
+	msg := &pb.MessageFeed{Message: &pb.MessageFeed_Delete{Delete: &pb.ObjectDeletedNotification{Id: objectID}}}
+	err = s.indexer.enqueue(txCtx, objectID, msg)
+	if err != nil {
+		logs.Error("Delete: failed to queue object index mappings deletion", logs.Err(err))
+		if err := bome.Rollback(txCtx); err != nil {
+			logs.Error("Delete: rollback failed", logs.Err(err))
+		}
+		return errors.Internal("could not delete object")
+	}
+
+	err = bome.Commit(txCtx)
+	if err != nil {
+		logs.Error("Delete: operations commit failed", logs.Err(err))
+		return errors.Internal("database transaction commit error")
+	}
 	logs.Debug("Delete: object deleted", logs.Details("id", objectID))
+
+	s.indexer.notify()
+	if waitsIndexVisibility(ctx) {
+		return s.waitVisible(ctx, objectID)
+	}
+	return nil
+}
+
+// waitVisible waits for the committed changes of the object objectID to be applied to the search index. The write
+// that made them is done whatever the outcome: failures are returned as a NotIndexedError
+func (s *sqlCollection) waitVisible(ctx context.Context, objectID string) error {
+	err := s.indexer.wait(ctx, objectID)
+	if err != nil {
+		logs.Error("object changes are not applied to the search index", logs.Details("id", objectID), logs.Err(err))
+		return &NotIndexedError{ObjectID: objectID, Err: err}
+	}
 	return nil
 }
 
@@ -393,13 +494,28 @@ func (s *sqlCollection) Info(_ context.Context, id string) (*pb.Header, error) {
 	return &info, nil
 }
 
+// List returns the objects matching the filters of opts, the most recent first. The listing starts before the offset
+// of opts or, when it is zero, at the current time included, so that objects changed in the current millisecond are
+// listed
 func (s *sqlCollection) List(_ context.Context, opts ListOptions) (*Cursor, error) {
+	bound := "<"
 	if opts.Offset == 0 {
 		opts.Offset = utime.Now()
+		bound = "<="
+	}
+
+	sortExpr := "objects.ind"
+	if opts.SortBy == SortByUpdatedAt {
+		sortExpr = fmt.Sprintf("coalesce(%s, objects.ind)", s.headerField("updated_at", false))
 	}
 
-	sqlQuery := fmt.Sprintf("select headers.value as header, objects.value as object from %s as headers, %s as objects where headers.name=objects.name and objects.ind < ?", s.headers.Table(), s.objects.Table())
-	cursor, err := s.objects.Query(sqlQuery, objectScanner, opts.Offset)
+	conditions, params := s.listFilters(opts)
+	conditions = append([]string{"headers.name=objects.name", sortExpr + " " + bound + " ?"}, conditions...)
+	params = append([]interface{}{opts.Offset}, params...)
+
+	sqlQuery := fmt.Sprintf("select headers.value as header, objects.value as object from %s as headers, %s as objects where %s order by %s desc",
+		s.headers.Table(), s.objects.Table(), strings.Join(conditions, " and "), sortExpr)
+	cursor, err := s.objects.Query(sqlQuery, objectScanner, params...)
 	if err != nil {
 		return nil, err
 	}
@@ -422,145 +538,493 @@ func (s *sqlCollection) List(_ context.Context, opts ListOptions) (*Cursor, erro
 	return NewCursor(browser, closer), nil
 }
 
-func (s *sqlCollection) Search(ctx context.Context, query *pb.SearchQuery) (*Cursor, error) {
-	ids, err := s.engine.Search(query)
-	if err != nil {
-		return nil, err
+// listFilters translates the header filters of opts into SQL conditions on the headers table and their bound parameters
+func (s *sqlCollection) listFilters(opts ListOptions) ([]string, []interface{}) {
+	var (
+		conditions []string
+		params     []interface{}
+	)
+
+	add := func(expr string, operator string, value interface{}) {
+		conditions = append(conditions, fmt.Sprintf("%s %s ?", expr, operator))
+		params = append(params, value)
 	}
 
-	c := &idsListCursor{
-		ids: ids,
-		getObjectFunc: func(id string) (*pb.Object, error) {
-			return s.Get(ctx, id, GetObjectOptions{})
-		},
+	createdBy := s.headerField("created_by", true)
+	if opts.CreatedBy != "" {
+		add(createdBy, "=", opts.CreatedBy)
 	}
-	return NewCursor(c, c), nil
+
+	createdAt := s.headerField("created_at", false)
+	if opts.CreatedAfter > 0 {
+		add(createdAt, ">", opts.CreatedAfter)
+	}
+	if opts.CreatedBefore > 0 {
+		add(createdAt, "<", opts.CreatedBefore)
+	}
+
+	size := fmt.Sprintf("coalesce(%s, 0)", s.headerField("size", false))
+	if opts.MinSize > 0 {
+		add(size, ">=", opts.MinSize)
+	}
+	if opts.MaxSize > 0 {
+		add(size, "<=", opts.MaxSize)
+	}
+
+	updatedAt := fmt.Sprintf("coalesce(%s, %s)", s.headerField("updated_at", false), createdAt)
+	if opts.UpdatedAfter > 0 {
+		add(updatedAt, ">", opts.UpdatedAfter)
+	}
+	if opts.UpdatedBefore > 0 {
+		add(updatedAt, "<", opts.UpdatedBefore)
+	}
+
+	if opts.UpdatedBy != "" {
+		add(fmt.Sprintf("coalesce(%s, %s)", s.headerField("updated_by", true), createdBy), "=", opts.UpdatedBy)
+	}
+
+	return conditions, params
 }
 
-func (s *sqlCollection) Clear() error {
-	ctx, objects, err := s.objects.Transaction(context.Background())
-	if err != nil {
-		logs.Error("Clear: could not start transaction in objects DB", logs.Err(err))
-		return errors.Internal("database transaction initialization")
+// headerField returns the SQL expression that extracts the header field with the given JSON name
+func (s *sqlCollection) headerField(name string, text bool) string {
+	expr := fmt.Sprintf("json_extract(headers.value, '$.%s')", name)
+	if text && s.dialect == bome.MySQL {
+		expr = fmt.Sprintf("json_unquote(%s)", expr)
 	}
+	return expr
+}
 
-	err = objects.Clear()
+func (s *sqlCollection) Search(ctx context.Context, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error) {
+	searchOptions, err := s.searchOptions()
 	if err != nil {
-		logs.Error("Clear: could not clear objects", logs.Err(err))
-		if err := bome.Rollback(ctx); err != nil {
-			logs.Error("Clear: operations rollback failed", logs.Err(err))
-		}
-		return errors.Internal("could not clear objects")
+		return nil, err
 	}
 
-	_, headers, _ := s.headers.Transaction(ctx)
-	err = headers.Clear()
+	searchOptions.Origin = opts.SortByDistance
+	hits, err := s.engine.Search(query, searchOptions)
 	if err != nil {
-		logs.Error("Clear: could not clear objects headers", logs.Err(err))
-		if err := bome.Rollback(ctx); err != nil {
-			logs.Error("Clear: operations rollback failed", logs.Err(err))
+		return nil, err
+	}
+
+	var facets []*pb.FacetResult
+	if len(opts.Facets) > 0 {
+		facets, err = s.engine.Facets(query, searchOptions, s.facets(opts.Facets))
+		if err != nil {
+			return nil, err
 		}
-		return errors.Internal("could not clear object headers")
 	}
 
-	if err := bome.Commit(ctx); err != nil {
-		logs.Error("Clear: operations commit failed", logs.Err(err))
+	var highlighter *se.Highlighter
+	if opts.Highlight {
+		highlighter, err = se.NewHighlighter(query, searchOptions, int(opts.FragmentSize))
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	logs.Debug("Clear: objects store has been cleared")
-	return nil
+	c := &hitsListCursor{
+		hits:   hits,
+		facets: facets,
+		getObjectFunc: func(id string) (*pb.Object, error) {
+			o, err := s.Get(ctx, id, GetObjectOptions{})
+			if err != nil || highlighter == nil {
+				return o, err
+			}
+
+			o.Highlights, err = s.highlight(highlighter, o)
+			return o, err
+		},
+	}
+	return NewCursor(c, c), nil
 }
 
-func (s *sqlCollection) validateSearchQuery(query *pb.SearchQuery) bool {
-	switch q := query.Query.(type) {
-	case *pb.SearchQuery_Fields:
-		return s.validatePropertiesSearchingQuery(q.Fields)
-	default:
-		return true
+func (s *sqlCollection) Suggest(_ context.Context, field string, prefix string, opts SuggestOptions) ([]*pb.Suggestion, error) {
+	for _, index := range s.info.TextIndexes {
+		if index.Alias == field {
+			return s.engine.Suggest(field, prefix, se.SuggestOptions{
+				Size:        int(opts.Size),
+				MaxDistance: int(opts.MaxDistance),
+				Analyzer:    index.Analyzer,
+			})
+		}
 	}
+	return nil, errors.BadRequest("suggestions field is not a text index alias", errors.Details{Key: "field", Value: field})
 }
 
-func (s *sqlCollection) validatePropertiesSearchingQuery(query *pb.FieldQuery) bool {
-	var fieldQueries []*pb.FieldQuery
-	fieldQueries = append(fieldQueries, query)
-	for len(fieldQueries) > 0 {
+func (s *sqlCollection) ObjectTokens(_ context.Context, objectID string) ([]*pb.FieldTokens, error) {
+	return s.engine.ObjectTokens(objectID)
+}
 
-		q := fieldQueries[0]
-		fieldQueries = fieldQueries[1:]
+func (s *sqlCollection) TokenDocuments(_ context.Context, token string, opts TokenDocumentsOptions) ([]*pb.TokenPosting, error) {
+	return s.engine.TokenDocuments(token, se.TokenDocumentsOptions{
+		Field: opts.Field,
+		After: opts.After,
+		Count: int(opts.Count),
+	})
+}
 
-		switch v := q.Bool.(type) {
+func (s *sqlCollection) Analyze(_ context.Context, text string, opts AnalyzeOptions) ([]string, error) {
+	return s.engine.Analyze(opts.Analyzer, text)
+}
 
-		case *pb.FieldQuery_And:
-			for _, ox := range v.And.Queries {
-				fieldQueries = append(fieldQueries, ox)
+// Explain explains query with the options searches of the collection use
+func (s *sqlCollection) Explain(_ context.Context, query *pb.SearchQuery) (*pb.SearchExplanation, error) {
+	searchOptions, err := s.searchOptions()
+	if err != nil {
+		return nil, err
+	}
+	return s.engine.Explain(query, searchOptions)
+}
+
+// facets returns the search engine facets of the requested ones: facets on the number index alias count $number
+func (s *sqlCollection) facets(requested []*pb.Facet) []*pb.Facet {
+	facets := make([]*pb.Facet, len(requested))
+	for ind, facet := range requested {
+		facets[ind] = facet
+		if s.info.NumberIndex != nil && s.info.NumberIndex.Alias != "" && facet.Field == s.info.NumberIndex.Alias {
+			name := facet.Name
+			if name == "" {
+				name = facet.Field
 			}
+			facets[ind] = &pb.Facet{Name: name, Field: se.NumberField, Size: facet.Size, Ranges: facet.Ranges}
+		}
+	}
+	return facets
+}
+
+// highlight returns the highlighted fragments of the text indexed fields of o, read from the text indexes paths
+func (s *sqlCollection) highlight(highlighter *se.Highlighter, o *pb.Object) ([]*pb.Highlight, error) {
+	var highlights []*pb.Highlight
+	for _, index := range s.info.TextIndexes {
+		result := gjson.Get(o.Data, strings.TrimPrefix(index.Path, "$."))
+		if result.Type != gjson.String {
 			continue
+		}
 
-		case *pb.FieldQuery_Or:
-			for _, ox := range v.Or.Queries {
-				fieldQueries = append(fieldQueries, ox)
-			}
+		hl, err := highlighter.Highlight(index.Alias, result.Str)
+		if err != nil {
+			return nil, err
+		}
+		if hl != nil {
+			highlights = append(highlights, hl)
+		}
+	}
+	return highlights, nil
+}
+
+// indexFeeds returns the index changes that map object: the texts of its text indexes, including the ones of
+// indexes, its number, point and properties. Values of another type than their index are rejected
+func (s *sqlCollection) indexFeeds(object *pb.Object, indexes ...*pb.TextIndex) ([]*pb.MessageFeed, error) {
+	number, props, err := s.indexedValues(object)
+	if err != nil {
+		return nil, err
+	}
+
+	point, err := s.indexedPoint(object)
+	if err != nil {
+		return nil, err
+	}
+
+	var feeds []*pb.MessageFeed
+
+	// texts are analyzed the way search queries on their alias are: with the analyzer the collection declares for it
+	analyzers := map[string]string{}
+	for _, index := range s.info.TextIndexes {
+		analyzers[index.Alias] = index.Analyzer
+	}
+
+	textIndexes := append(s.info.TextIndexes[:len(s.info.TextIndexes):len(s.info.TextIndexes)], indexes...)
+	for _, index := range textIndexes {
+		result := gjson.Get(object.Data, strings.TrimPrefix(index.Path, "$."))
+		if !result.Exists() {
+			logs.Error("Save: Text index references path that does not exists", logs.Details("path", index.Path))
 			continue
+		}
 
-		case *pb.FieldQuery_Contains:
-			if !s.indexFieldExists(v.Contains.Field) {
-				return false
-			}
+		if result.Type != gjson.String {
+			logs.Error("Save: Text index supports only text field", logs.Details("path", index.Path))
+			return nil, errors.BadRequest("expecting string value at the index path", errors.Details{Key: index.Path, Value: result.Value()})
+		}
+
+		mp := &pb.TextMapping{
+			Text:     result.Str,
+			Name:     index.Alias,
+			ObjectId: object.Header.Id,
+			Analyzer: analyzers[index.Alias],
+		}
+		feeds = append(feeds, &pb.MessageFeed{Message: &pb.MessageFeed_TextMapping{TextMapping: mp}})
+	}
+
+	if number != nil {
+		mp := &pb.NumberMapping{
+			Number:   *number,
+			Name:     s.info.NumberIndex.Alias,
+			ObjectId: object.Header.Id,
+		}
+		feeds = append(feeds, &pb.MessageFeed{Message: &pb.MessageFeed_NumMapping{NumMapping: mp}})
+	}
+
+	if point != nil {
+		mp := &pb.GeoMapping{
+			ObjectId: object.Header.Id,
+			Point:    point,
+		}
+		feeds = append(feeds, &pb.MessageFeed{Message: &pb.MessageFeed_GeoMapping{GeoMapping: mp}})
+	}
+
+	if props != nil {
+		value, err := json.Marshal(props)
+		if err != nil {
+			logs.Error("Save: could not create properties index", logs.Err(err))
+			return nil, errors.BadRequest("could not encode indexed sub object")
+		}
 
-		case *pb.FieldQuery_StartsWith:
-			if !s.indexFieldExists(v.StartsWith.Field) {
-				return false
+		mp := &pb.PropertiesMapping{
+			ObjectId: object.Header.Id,
+			Json:     string(value),
+		}
+		feeds = append(feeds, &pb.MessageFeed{Message: &pb.MessageFeed_PropertiesMapping{PropertiesMapping: mp}})
+	}
+	return feeds, nil
+}
+
+// indexedValues returns the number indexed for object by the number index and the properties indexed by the
+// properties index, nil when the object has none. Values of another type than their field are rejected
+func (s *sqlCollection) indexedValues(object *pb.Object) (*float64, map[string]interface{}, error) {
+	var number *float64
+	if s.info.NumberIndex != nil {
+		result := gjson.Get(object.Data, strings.TrimPrefix(s.info.NumberIndex.Path, "$."))
+		if !result.Exists() || result.Type == gjson.Null {
+			logs.Error("Save: Number index references path that does not exists", logs.Details("path", s.info.NumberIndex.Path))
+		} else {
+			if _, isTimestamp := se.ParseTimestamp(result.Str); result.Type != gjson.Number && (result.Type != gjson.String || !isTimestamp) {
+				logs.Error("Save: Number index supports only number and timestamp fields", logs.Details("path", s.info.NumberIndex.Path))
+				return nil, nil, errors.BadRequest("expecting number or timestamp value at the index path", errors.Details{Key: s.info.NumberIndex.Path, Value: result.Value()})
 			}
 
-		case *pb.FieldQuery_EndsWith:
-			if !s.indexFieldExists(v.EndsWith.Field) {
-				return false
+			value, err := s.indexedValue(result.Value(), func() pb.ValueType {
+				return s.info.NumberIndex.Type
+			})
+			if err != nil {
+				return nil, nil, err
 			}
 
-		case *pb.FieldQuery_StrEqual:
-			if !s.indexFieldExists(v.StrEqual.Field) {
-				return false
+			var n float64
+			switch v := value.(type) {
+			case float64:
+				n = v
+			case int64:
+				n = float64(v)
 			}
+			number = &n
+		}
+	}
 
-		case *pb.FieldQuery_Lt:
-			if !s.indexFieldExists(v.Lt.Field) {
-				return false
+	var props map[string]interface{}
+	if s.info.FieldsIndex != nil && len(s.info.FieldsIndex.Aliases) > 0 {
+		props = map[string]interface{}{}
+		for path, alias := range s.info.FieldsIndex.Aliases {
+			result := gjson.Get(object.Data, strings.TrimPrefix(path, "$."))
+			if !result.Exists() {
+				logs.Error("Save: Field index references path that does not exists", logs.Details("path", path))
+				continue
 			}
 
-		case *pb.FieldQuery_Lte:
-			if !s.indexFieldExists(v.Lte.Field) {
-				return false
+			alias := alias
+			value, err := s.indexedValue(result.Value(), func() pb.ValueType {
+				return s.info.FieldsIndex.Types[alias]
+			})
+			if err != nil {
+				logs.Error("Save: Field index supports only text, number, boolean, timestamp and null values and arrays of them", logs.Details("path", path), logs.Err(err))
+				return nil, nil, err
 			}
+			props[alias] = value
+		}
+	}
+	return number, props, nil
+}
+
+// indexedPoint returns the point indexed for object by the geo index, nil when the object has none. It is read from
+// the coordinates of a GeoJSON point, which come longitude first, or from the latitude and longitude paths
+func (s *sqlCollection) indexedPoint(object *pb.Object) (*pb.GeoPoint, error) {
+	index := s.info.GeoIndex
+	if index == nil {
+		return nil, nil
+	}
 
-		case *pb.FieldQuery_Gt:
-			if !s.indexFieldExists(v.Gt.Field) {
-				return false
+	var lat, lon gjson.Result
+	if index.Path != "" {
+		result := gjson.Get(object.Data, strings.TrimPrefix(index.Path, "$."))
+		if !result.Exists() || result.Type == gjson.Null {
+			logs.Error("Save: Geo index references path that does not exists", logs.Details("path", index.Path))
+			return nil, nil
+		}
+
+		coordinates := result.Get("coordinates").Array()
+		if result.Get("type").String() != "Point" || len(coordinates) < 2 {
+			logs.Error("Save: Geo index supports only GeoJSON points", logs.Details("path", index.Path))
+			return nil, errors.BadRequest("expecting GeoJSON point at the index path", errors.Details{Key: index.Path, Value: result.Value()})
+		}
+		lon, lat = coordinates[0], coordinates[1]
+	} else {
+		lat = gjson.Get(object.Data, strings.TrimPrefix(index.LatitudePath, "$."))
+		lon = gjson.Get(object.Data, strings.TrimPrefix(index.LongitudePath, "$."))
+		if !lat.Exists() || lat.Type == gjson.Null || !lon.Exists() || lon.Type == gjson.Null {
+			logs.Error("Save: Geo index references paths that do not exist", logs.Details("latitude", index.LatitudePath), logs.Details("longitude", index.LongitudePath))
+			return nil, nil
+		}
+	}
+
+	if lat.Type != gjson.Number || lon.Type != gjson.Number {
+		logs.Error("Save: Geo index supports only number coordinates", logs.Details("lat", lat.Raw), logs.Details("lon", lon.Raw))
+		return nil, errors.BadRequest("expecting number coordinates", errors.Details{Key: "lat", Value: lat.Value()}, errors.Details{Key: "lon", Value: lon.Value()})
+	}
+
+	point := &pb.GeoPoint{Lat: lat.Float(), Lon: lon.Float()}
+	if err := se.ValidateGeoPoint(point); err != nil {
+		return nil, err
+	}
+	return point, nil
+}
+
+// indexedValue returns the value indexed for value, found at the path of the number index or of a properties index
+// alias whose type is read with get. Values of untyped fields are indexed with the type detected from them, without
+// recording it: detectTypes does
+func (s *sqlCollection) indexedValue(value interface{}, get func() pb.ValueType) (interface{}, error) {
+	s.typesMutex.Lock()
+	typ := get()
+	s.typesMutex.Unlock()
+
+	indexed, _, err := se.IndexedValue(value, typ)
+	return indexed, err
+}
+
+// detectTypes gives the untyped fields of the number and properties indexes the type detected from their value in
+// object, their first non null one, and saves it in the collection info. Values that cannot be indexed are left to
+// indexFeeds, which rejects them
+func (s *sqlCollection) detectTypes(object *pb.Object) error {
+	s.typesMutex.Lock()
+	defer s.typesMutex.Unlock()
+
+	var undo []func()
+
+	if index := s.info.NumberIndex; index != nil && index.Type == pb.ValueType_UntypedValue {
+		result := gjson.Get(object.Data, strings.TrimPrefix(index.Path, "$."))
+		_, typ, err := se.IndexedValue(result.Value(), index.Type)
+		if result.Exists() && err == nil && (typ == pb.ValueType_NumberValue || typ == pb.ValueType_TimestampValue) {
+			index.Type = typ
+			undo = append(undo, func() {
+				index.Type = pb.ValueType_UntypedValue
+			})
+		}
+	}
+
+	if index := s.info.FieldsIndex; index != nil {
+		for path, alias := range index.Aliases {
+			if index.Types[alias] != pb.ValueType_UntypedValue {
+				continue
 			}
 
-		case *pb.FieldQuery_Gte:
-			if !s.indexFieldExists(v.Gte.Field) {
-				return false
+			result := gjson.Get(object.Data, strings.TrimPrefix(path, "$."))
+			_, typ, err := se.IndexedValue(result.Value(), pb.ValueType_UntypedValue)
+			if !result.Exists() || err != nil || typ == pb.ValueType_UntypedValue {
+				continue
 			}
 
-		case *pb.FieldQuery_NumbEq:
-			if !s.indexFieldExists(v.NumbEq.Field) {
-				return false
+			if index.Types == nil {
+				index.Types = map[string]pb.ValueType{}
 			}
+			index.Types[alias] = typ
+
+			alias := alias
+			undo = append(undo, func() {
+				delete(index.Types, alias)
+			})
+		}
+	}
+
+	if len(undo) == 0 || s.saveInfo == nil {
+		return nil
+	}
+
+	err := s.saveInfo(s.info)
+	if err != nil {
+		logs.Error("could not record the type of an indexed field", logs.Err(err))
+		for _, u := range undo {
+			u()
+		}
+		return errors.Internal("could not save collection info")
+	}
+	return nil
+}
+
+// searchOptions returns the options search queries on this collection are compiled with. Synonym sets are read for
+// each search, so that queries use them as soon as they change
+func (s *sqlCollection) searchOptions() (se.SearchOptions, error) {
+	opts := se.SearchOptions{Boosts: map[string]float64{}, Analyzers: map[string]string{}}
+	for _, index := range s.info.TextIndexes {
+		opts.Boosts[index.Alias] = index.Boost
+		opts.Analyzers[index.Alias] = index.Analyzer
+	}
+
+	if s.info.FieldsIndex != nil {
+		for _, alias := range s.info.FieldsIndex.Aliases {
+			opts.Fields = append(opts.Fields, alias)
 		}
 	}
-	return true
+
+	if s.synonyms != nil {
+		var err error
+		opts.Synonyms, err = s.synonyms()
+		if err != nil {
+			return opts, err
+		}
+	}
+	return opts, nil
 }
 
-func (s *sqlCollection) indexFieldExists(name string) bool {
-	if s.info == nil || s.info.FieldsIndex != nil {
-		return false
+func (s *sqlCollection) Clear() error {
+	ctx, objects, err := s.objects.Transaction(context.Background())
+	if err != nil {
+		logs.Error("Clear: could not start transaction in objects DB", logs.Err(err))
+		return errors.Internal("database transaction initialization")
+	}
+
+	err = objects.Clear()
+	if err != nil {
+		logs.Error("Clear: could not clear objects", logs.Err(err))
+		if err := bome.Rollback(ctx); err != nil {
+			logs.Error("Clear: operations rollback failed", logs.Err(err))
+		}
+		return errors.Internal("could not clear objects")
 	}
-	for _, alias := range s.info.FieldsIndex.Aliases {
-		if alias == name {
-			return true
+
+	_, headers, _ := s.headers.Transaction(ctx)
+	err = headers.Clear()
+	if err != nil {
+		logs.Error("Clear: could not clear objects headers", logs.Err(err))
+		if err := bome.Rollback(ctx); err != nil {
+			logs.Error("Clear: operations rollback failed", logs.Err(err))
 		}
+		return errors.Internal("could not clear object headers")
 	}
-	return false
+
+	if err := bome.Commit(ctx); err != nil {
+		logs.Error("Clear: operations commit failed", logs.Err(err))
+	}
+
+	logs.Debug("Clear: objects store has been cleared")
+	return nil
+}
+
+// touchHeader records a modification of the object described by header
+func touchHeader(ctx context.Context, header *pb.Header) {
+	header.UpdatedAt = utime.Now()
+	if user := auth.Get(ctx); user != nil {
+		header.UpdatedBy = user.Name
+	}
+	header.Edits++
 }
 
 func (s *sqlCollection) scanFullObject(row bome.Row) (interface{}, error) {
@@ -576,18 +1040,22 @@ func (s *sqlCollection) scanFullObject(row bome.Row) (interface{}, error) {
 	return object, err
 }
 
-func sqlJSONSetValue(value string) string {
-	var o interface{}
-	err := json.Unmarshal([]byte(value), &o)
-	if err != nil {
-		val := strings.Replace(value, "'", `\'`, -1)
-		return "'" + val + "'"
+// editAt sets the patch data at the given path of an object. The data is set as a JSON value, numbers and objects
+// included, unless it is not valid JSON: it is then set as a string
+func (s *sqlCollection) editAt(objects *bome.JSONMappingList, id string, path string, data string) error {
+	value := "?"
+	if json.Valid([]byte(data)) {
+		value = "json(?)"
+		if s.dialect == bome.MySQL {
+			value = "CAST(? AS JSON)"
+		}
 	}
 
-	if _, err := strconv.Atoi(value); err == nil {
-		return value
+	path = strings.Replace(path, "/", ".", -1)
+	if !strings.HasPrefix(path, "$") {
+		path = "$." + strings.TrimPrefix(path, ".")
 	}
 
-	val := strings.Replace(value, "'", `\'`, -1)
-	return "CAST('" + val + "' AS JSON)"
+	rawQuery := fmt.Sprintf("update $table$ set value=json_set(value, ?, %s) where name=?;", value)
+	return objects.Client().Exec(rawQuery, path, data, id).Error
 }
@@ -0,0 +1,909 @@
+package objects
+
+import (
+	"context"
+	"database/sql"
+	"github.com/omecodes/bome"
+	"github.com/omecodes/errors"
+	"github.com/omecodes/store/auth"
+	pb "github.com/omecodes/store/gen/go/proto"
+	se "github.com/omecodes/store/search-engine"
+	. "github.com/smartystreets/goconvey/convey"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+// newTestConn opens an in-memory database. Its single connection is shared by the indexing workers and the requests
+func newTestConn() *sql.DB {
+	conn, err := sql.Open(bome.SQLite3, ":memory:")
+	So(err, ShouldBeNil)
+	conn.SetMaxOpenConns(1)
+	return conn
+}
+
+// newTestCollection returns a collection on an in-memory database, closed with its indexing worker when the current
+// convey ends
+func newTestCollection(collection *pb.Collection) *sqlCollection {
+	col, err := NewSQLCollection(collection, newTestConn(), bome.SQLite3, "test_"+collection.Id)
+	So(err, ShouldBeNil)
+	Reset(func() {
+		So(col.Close(), ShouldBeNil)
+	})
+	return col
+}
+
+// newTestDB returns an objects DB on an in-memory database, closed with its indexing workers when the current
+// convey ends, and a context whose writes wait for their changes to be searchable
+func newTestDB() (DB, context.Context) {
+	db, err := NewSqlDB(newTestConn(), bome.SQLite3, "test")
+	So(err, ShouldBeNil)
+	Reset(func() {
+		So(db.Close(), ShouldBeNil)
+	})
+	return db, ContextWithIndexVisibility(context.Background())
+}
+
+func listedIDs(col *sqlCollection, opts ListOptions) []string {
+	cursor, err := col.List(context.Background(), opts)
+	So(err, ShouldBeNil)
+	defer func() {
+		So(cursor.Close(), ShouldBeNil)
+	}()
+
+	var ids []string
+	for {
+		o, err := cursor.Browse()
+		if err == io.EOF {
+			return ids
+		}
+		So(err, ShouldBeNil)
+		ids = append(ids, o.Header.Id)
+	}
+}
+
+func TestSqlCollection_ModificationMetadata(t *testing.T) {
+	Convey("Saved objects are created and last modified by their creator", t, func() {
+		col := newTestCollection(&pb.Collection{Id: "metadata"})
+		ctx := context.Background()
+
+		So(col.Save(ctx, &pb.Object{Header: &pb.Header{Id: "a", CreatedBy: "ome", CreatedAt: 10}, Data: `{"v": 1}`}), ShouldBeNil)
+		So(col.Save(ctx, &pb.Object{Header: &pb.Header{Id: "b", CreatedBy: "ome", CreatedAt: 20}, Data: `{"v": 2}`}), ShouldBeNil)
+
+		header, err := col.Info(ctx, "a")
+		So(err, ShouldBeNil)
+		So(header.UpdatedAt, ShouldEqual, 10)
+		So(header.UpdatedBy, ShouldEqual, "ome")
+		So(header.Edits, ShouldEqual, 0)
+
+		Convey("Patches record the editor and increment the edit counter", func() {
+			userCtx := auth.ContextWithUser(ctx, &pb.User{Name: "codes"})
+			So(col.Patch(userCtx, &pb.Patch{ObjectId: "a", At: "$.v", Data: "3"}), ShouldBeNil)
+			So(col.Patch(userCtx, &pb.Patch{ObjectId: "a", At: "$.v", Data: "4"}), ShouldBeNil)
+
+			header, err := col.Info(ctx, "a")
+			So(err, ShouldBeNil)
+			So(header.UpdatedAt, ShouldBeGreaterThan, 20)
+			So(header.UpdatedBy, ShouldEqual, "codes")
+			So(header.Edits, ShouldEqual, 2)
+
+			Convey("Objects can be sorted and filtered by last modification", func() {
+				So(listedIDs(col, ListOptions{}), ShouldResemble, []string{"b", "a"})
+				So(listedIDs(col, ListOptions{SortBy: SortByUpdatedAt}), ShouldResemble, []string{"a", "b"})
+				So(listedIDs(col, ListOptions{UpdatedBy: "codes"}), ShouldResemble, []string{"a"})
+				So(listedIDs(col, ListOptions{UpdatedBefore: 15}), ShouldBeEmpty)
+				So(listedIDs(col, ListOptions{UpdatedAfter: 15, UpdatedBefore: 25}), ShouldResemble, []string{"b"})
+			})
+		})
+
+		Convey("Objects can be filtered by creator, creation date and size", func() {
+			So(col.Save(ctx, &pb.Object{Header: &pb.Header{Id: "c", CreatedBy: "codes", CreatedAt: 30}, Data: `{"v": 3, "long": true}`}), ShouldBeNil)
+
+			So(listedIDs(col, ListOptions{CreatedBy: "ome"}), ShouldResemble, []string{"b", "a"})
+			So(listedIDs(col, ListOptions{CreatedBy: "codes"}), ShouldResemble, []string{"c"})
+			So(listedIDs(col, ListOptions{CreatedAfter: 10}), ShouldResemble, []string{"c", "b"})
+			So(listedIDs(col, ListOptions{CreatedAfter: 5, CreatedBefore: 30}), ShouldResemble, []string{"b", "a"})
+			So(listedIDs(col, ListOptions{MinSize: 12}), ShouldResemble, []string{"c"})
+			So(listedIDs(col, ListOptions{MaxSize: 11, CreatedBy: "ome"}), ShouldResemble, []string{"b", "a"})
+		})
+	})
+}
+
+func TestSqlCollection_SearchHighlights(t *testing.T) {
+	Convey("Found objects carry the fragments of their text fields that match the query", t, func() {
+		col := newTestCollection(&pb.Collection{
+			Id: "books",
+			TextIndexes: []*pb.TextIndex{
+				{Path: "$.title", Alias: "title"},
+				{Path: "$.summary", Alias: "summary", Analyzer: se.EnglishAnalyzer},
+			},
+		})
+		ctx := ContextWithIndexVisibility(context.Background())
+
+		objects := map[string]string{
+			"b1": `{"title": "Le Café", "summary": "Running a café in Paris"}`,
+			"b2": `{"title": "Runners", "summary": "Stories of marathon runners"}`,
+		}
+		for id, data := range objects {
+			So(col.Save(ctx, &pb.Object{Header: &pb.Header{Id: id}, Data: data}), ShouldBeNil)
+		}
+
+		search := func(text string, opts SearchObjectsOptions) []*pb.Object {
+			q, err := se.ParseQuery(text)
+			So(err, ShouldBeNil)
+
+			cursor, err := col.Search(ctx, q, opts)
+			So(err, ShouldBeNil)
+			defer func() {
+				So(cursor.Close(), ShouldBeNil)
+			}()
+
+			var objects []*pb.Object
+			for {
+				o, err := cursor.Browse()
+				if err == io.EOF {
+					return objects
+				}
+				So(err, ShouldBeNil)
+				objects = append(objects, o)
+			}
+		}
+
+		found := search(`$text = "cafe" or $text = "run"`, SearchObjectsOptions{Highlight: true})
+		So(found, ShouldHaveLength, 1)
+		So(found[0].Header.Id, ShouldEqual, "b1")
+		So(found[0].Highlights, ShouldHaveLength, 2)
+
+		title := found[0].Highlights[0]
+		So(title.Field, ShouldEqual, "title")
+		So(title.Fragments[0].Text, ShouldEqual, "Le Café")
+		So(title.Fragments[0].Matches[0].Start, ShouldEqual, 3)
+		So(title.Fragments[0].Matches[0].End, ShouldEqual, 7)
+
+		summary := found[0].Highlights[1]
+		So(summary.Field, ShouldEqual, "summary")
+		So(summary.Fragments[0].Matches, ShouldHaveLength, 2)
+		So(summary.Fragments[0].Matches[0].End, ShouldEqual, 7)
+
+		So(search(`$text = "cafe"`, SearchObjectsOptions{})[0].Highlights, ShouldBeNil)
+	})
+}
+
+func TestSqlCollection_SearchFacets(t *testing.T) {
+	Convey("The first found object carries the facets counted over all the found objects", t, func() {
+		col := newTestCollection(&pb.Collection{
+			Id:          "products",
+			FieldsIndex: &pb.PropertiesIndex{Aliases: map[string]string{"$.brand": "brand"}},
+			NumberIndex: &pb.NumberIndex{Path: "$.price", Alias: "price"},
+		})
+		ctx := ContextWithIndexVisibility(context.Background())
+
+		objects := map[string]string{
+			"p1": `{"brand": "acme", "price": 5}`,
+			"p2": `{"brand": "acme", "price": 20}`,
+			"p3": `{"brand": "globex", "price": 40}`,
+		}
+		for id, data := range objects {
+			So(col.Save(ctx, &pb.Object{Header: &pb.Header{Id: id}, Data: data}), ShouldBeNil)
+		}
+
+		q, err := se.ParseQuery(`brand startswith "a" or brand = "globex"`)
+		So(err, ShouldBeNil)
+
+		price, err := se.ParseFacet("price:..10,10..")
+		So(err, ShouldBeNil)
+
+		cursor, err := col.Search(ctx, q, SearchObjectsOptions{Facets: []*pb.Facet{{Field: "brand"}, price}})
+		So(err, ShouldBeNil)
+		defer func() {
+			So(cursor.Close(), ShouldBeNil)
+		}()
+
+		first, err := cursor.Browse()
+		So(err, ShouldBeNil)
+		So(first.Facets, ShouldHaveLength, 2)
+		So(first.Facets[0].Name, ShouldEqual, "brand")
+		So(first.Facets[0].Buckets, ShouldHaveLength, 2)
+		So(first.Facets[0].Buckets[0].Key, ShouldEqual, "acme")
+		So(first.Facets[0].Buckets[0].Count, ShouldEqual, 2)
+		So(first.Facets[1].Name, ShouldEqual, "price")
+		So(first.Facets[1].Buckets[0].Count, ShouldEqual, 1)
+		So(first.Facets[1].Buckets[1].Count, ShouldEqual, 2)
+
+		second, err := cursor.Browse()
+		So(err, ShouldBeNil)
+		So(second.Facets, ShouldBeNil)
+
+		_, err = col.Search(ctx, q, SearchObjectsOptions{Facets: []*pb.Facet{{Field: "color"}}})
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestSqlDB_DiskIndexStore(t *testing.T) {
+	Convey("Collections configured with the disk index store keep their index in the index directory", t, func() {
+		conn := newTestConn()
+		ctx := ContextWithIndexVisibility(context.Background())
+
+		collection := &pb.Collection{
+			Id:          "books",
+			IndexStore:  pb.IndexStore_Disk,
+			TextIndexes: []*pb.TextIndex{{Path: "$.title", Alias: "title"}},
+		}
+
+		db, err := NewSqlDB(conn, bome.SQLite3, "test")
+		So(err, ShouldBeNil)
+		So(errors.HTTPStatus(db.CreateCollection(ctx, collection)) == http.StatusBadRequest, ShouldBeTrue)
+		So(db.Close(), ShouldBeNil)
+
+		dir := t.TempDir()
+		db, err = NewSqlDB(conn, bome.SQLite3, "test", WithIndexDir(dir))
+		So(err, ShouldBeNil)
+		So(db.CreateCollection(ctx, collection), ShouldBeNil)
+
+		for id, title := range map[string]string{"b1": "The old man and the sea", "b2": "Twenty thousand leagues"} {
+			So(db.Save(ctx, "books", &pb.Object{Header: &pb.Header{Id: id}, Data: `{"title": "` + title + `"}`}), ShouldBeNil)
+		}
+
+		q, err := se.ParseQuery(`$text = "sea"`)
+		So(err, ShouldBeNil)
+		cursor, err := db.Search(ctx, "books", q, SearchObjectsOptions{})
+		So(err, ShouldBeNil)
+		defer func() {
+			So(cursor.Close(), ShouldBeNil)
+		}()
+
+		o, err := cursor.Browse()
+		So(err, ShouldBeNil)
+		So(o.Header.Id, ShouldEqual, "b1")
+		_, err = cursor.Browse()
+		So(err, ShouldEqual, io.EOF)
+
+		_, err = os.Stat(filepath.Join(dir, "test_books"))
+		So(err, ShouldBeNil)
+
+		Convey("Closing the DB commits the disk index stores", func() {
+			So(db.Close(), ShouldBeNil)
+
+			db, err := NewSqlDB(conn, bome.SQLite3, "test", WithIndexDir(dir))
+			So(err, ShouldBeNil)
+			defer func() {
+				So(db.Close(), ShouldBeNil)
+			}()
+
+			cursor, err := db.Search(ctx, "books", q, SearchObjectsOptions{})
+			So(err, ShouldBeNil)
+			defer func() {
+				So(cursor.Close(), ShouldBeNil)
+			}()
+
+			o, err := cursor.Browse()
+			So(err, ShouldBeNil)
+			So(o.Header.Id, ShouldEqual, "b1")
+		})
+
+		Convey("Deleting a collection stops its indexing worker and closes its disk index store", func() {
+			defer func() {
+				So(db.Close(), ShouldBeNil)
+			}()
+
+			col, err := db.(*sqlStore).ResolveCollection(ctx, "books")
+			So(err, ShouldBeNil)
+			So(db.DeleteCollection(ctx, "books"), ShouldBeNil)
+
+			select {
+			case <-col.(*sqlCollection).indexer.stopped:
+			default:
+				So("indexing worker is running", ShouldBeEmpty)
+			}
+			So(db.(*sqlStore).diskIndexes, ShouldNotContainKey, "books")
+		})
+	})
+}
+
+func TestExecHandler_SearchCollections(t *testing.T) {
+	Convey("Searching several collections ranks their objects together and tags them with their collection", t, func() {
+		db, ctx := newTestDB()
+		ctx = ContextWithStore(ctx, db)
+
+		So(db.CreateCollection(ctx, &pb.Collection{
+			Id:          "books",
+			TextIndexes: []*pb.TextIndex{{Path: "$.title", Alias: "title"}},
+		}), ShouldBeNil)
+		So(db.CreateCollection(ctx, &pb.Collection{
+			Id:          "movies",
+			TextIndexes: []*pb.TextIndex{{Path: "$.title", Alias: "title"}},
+			FieldsIndex: &pb.PropertiesIndex{Aliases: map[string]string{"$.genre": "genre"}},
+		}), ShouldBeNil)
+
+		So(db.Save(ctx, "books", &pb.Object{Header: &pb.Header{Id: "b1"}, Data: `{"title": "The old man and the sea"}`}), ShouldBeNil)
+		So(db.Save(ctx, "books", &pb.Object{Header: &pb.Header{Id: "b2"}, Data: `{"title": "Twenty thousand leagues"}`}), ShouldBeNil)
+		So(db.Save(ctx, "movies", &pb.Object{Header: &pb.Header{Id: "m1"}, Data: `{"title": "The sea", "genre": "drama"}`}), ShouldBeNil)
+		So(db.Save(ctx, "movies", &pb.Object{Header: &pb.Header{Id: "m2"}, Data: `{"title": "Twenty years after", "genre": "adventure"}`}), ShouldBeNil)
+		So(db.Save(ctx, "movies", &pb.Object{Header: &pb.Header{Id: "m3"}, Data: `{"title": "The last emperor", "genre": "drama"}`}), ShouldBeNil)
+
+		h := &ExecHandler{}
+		search := func(collections []string, text string) ([]string, error) {
+			q, err := se.ParseQuery(text)
+			So(err, ShouldBeNil)
+
+			cursor, err := h.SearchCollections(ctx, collections, q, SearchObjectsOptions{})
+			if err != nil {
+				return nil, err
+			}
+			defer func() {
+				So(cursor.Close(), ShouldBeNil)
+			}()
+
+			var found []string
+			previous := -1.0
+			for {
+				o, err := cursor.Browse()
+				if err == io.EOF {
+					return found, nil
+				}
+				So(err, ShouldBeNil)
+				if previous >= 0 {
+					So(o.Score, ShouldBeLessThanOrEqualTo, previous)
+				}
+				previous = o.Score
+				found = append(found, o.Collection+"/"+o.Header.Id)
+			}
+		}
+
+		found, err := search(nil, `$text = "sea"`)
+		So(err, ShouldBeNil)
+		So(found, ShouldResemble, []string{"movies/m1", "books/b1"})
+
+		found, err = search([]string{"books"}, `$text = "sea"`)
+		So(err, ShouldBeNil)
+		So(found, ShouldResemble, []string{"books/b1"})
+
+		Convey("Collections that cannot run the query are skipped", func() {
+			found, err := search(nil, `genre = "drama"`)
+			So(err, ShouldBeNil)
+			So(found, ShouldResemble, []string{"movies/m1", "movies/m3"})
+
+			_, err = search([]string{"books"}, `genre = "drama"`)
+			So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+		})
+	})
+}
+
+func TestSqlDB_Suggest(t *testing.T) {
+	Convey("Suggestions complete prefixes with the terms of a text index of a collection", t, func() {
+		db, ctx := newTestDB()
+
+		So(db.CreateCollection(ctx, &pb.Collection{
+			Id:          "cities",
+			TextIndexes: []*pb.TextIndex{{Path: "$.name", Alias: "name", Analyzer: se.KeywordAnalyzer}},
+		}), ShouldBeNil)
+
+		for id, name := range map[string]string{"c1": "Paris", "c2": "Parme", "c3": "Pau", "c4": "Paris"} {
+			So(db.Save(ctx, "cities", &pb.Object{Header: &pb.Header{Id: id}, Data: `{"name": "` + name + `"}`}), ShouldBeNil)
+		}
+
+		suggestions, err := db.Suggest(ctx, "cities", "name", "Par", SuggestOptions{})
+		So(err, ShouldBeNil)
+		So(suggestions, ShouldHaveLength, 2)
+		So(suggestions[0].Text, ShouldEqual, "paris")
+		So(suggestions[0].Docs, ShouldEqual, 2)
+		So(suggestions[1].Text, ShouldEqual, "parme")
+
+		suggestions, err = db.Suggest(ctx, "cities", "name", "Pao", SuggestOptions{Size: 1, MaxDistance: 1})
+		So(err, ShouldBeNil)
+		So(suggestions, ShouldHaveLength, 1)
+		So(suggestions[0].Text, ShouldEqual, "paris")
+
+		_, err = db.Suggest(ctx, "cities", "country", "Par", SuggestOptions{})
+		So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+	})
+}
+
+func TestSqlDB_Synonyms(t *testing.T) {
+	Convey("Searches look for the synonyms of the sets that apply to the collection as soon as they change", t, func() {
+		db, ctx := newTestDB()
+
+		for _, id := range []string{"products", "shows"} {
+			So(db.CreateCollection(ctx, &pb.Collection{
+				Id:          id,
+				TextIndexes: []*pb.TextIndex{{Path: "$.name", Alias: "name"}},
+			}), ShouldBeNil)
+		}
+
+		for id, name := range map[string]string{"p1": "Television", "p2": "TV stand"} {
+			So(db.Save(ctx, "products", &pb.Object{Header: &pb.Header{Id: id}, Data: `{"name": "` + name + `"}`}), ShouldBeNil)
+		}
+
+		search := func() []string {
+			cursor, err := db.Search(ctx, "products", &pb.SearchQuery{Query: &pb.SearchQuery_Text{Text: &pb.StrQuery{
+				Bool: &pb.StrQuery_Eq{Eq: &pb.StrEqual{Value: "tv"}},
+			}}}, SearchObjectsOptions{})
+			So(err, ShouldBeNil)
+			defer func() {
+				So(cursor.Close(), ShouldBeNil)
+			}()
+
+			var ids []string
+			for {
+				o, err := cursor.Browse()
+				if err == io.EOF {
+					break
+				}
+				So(err, ShouldBeNil)
+				ids = append(ids, o.Header.Id)
+			}
+			sort.Strings(ids)
+			return ids
+		}
+		So(search(), ShouldResemble, []string{"p2"})
+
+		So(db.SaveSynonymSet(ctx, &pb.SynonymSet{Id: "tv", Collection: "shows", Synonyms: []string{"tv", "television"}}), ShouldBeNil)
+		So(search(), ShouldResemble, []string{"p2"})
+
+		So(db.SaveSynonymSet(ctx, &pb.SynonymSet{Id: "tv", Collection: "products", Synonyms: []string{"tv", "television"}}), ShouldBeNil)
+		So(search(), ShouldResemble, []string{"p1", "p2"})
+
+		So(db.SaveSynonymSet(ctx, &pb.SynonymSet{Id: "screens", Analyzer: se.StandardAnalyzer, Synonyms: []string{"screen", "display"}}), ShouldBeNil)
+
+		sets, err := db.ListSynonymSets(ctx, ListSynonymSetsOptions{Collection: "shows"})
+		So(err, ShouldBeNil)
+		So(sets, ShouldHaveLength, 1)
+		So(sets[0].Id, ShouldEqual, "screens")
+
+		sets, err = db.ListSynonymSets(ctx, ListSynonymSetsOptions{Analyzer: se.StandardAnalyzer})
+		So(err, ShouldBeNil)
+		So(sets, ShouldHaveLength, 1)
+
+		So(db.DeleteSynonymSet(ctx, "tv"), ShouldBeNil)
+		So(search(), ShouldResemble, []string{"p2"})
+
+		Convey("Changes made by other processes sharing the tables are seen", func() {
+			other, err := NewSqlDB(db.(*sqlStore).db, bome.SQLite3, "test")
+			So(err, ShouldBeNil)
+			defer func() {
+				So(other.Close(), ShouldBeNil)
+			}()
+
+			So(other.SaveSynonymSet(ctx, &pb.SynonymSet{Id: "tv", Collection: "products", Synonyms: []string{"tv", "television"}}), ShouldBeNil)
+			So(search(), ShouldResemble, []string{"p1", "p2"})
+
+			So(other.DeleteSynonymSet(ctx, "tv"), ShouldBeNil)
+			So(search(), ShouldResemble, []string{"p2"})
+		})
+	})
+}
+
+func TestSqlDB_TypedIndexes(t *testing.T) {
+	Convey("Indexed fields get the type of their first value, timestamps are searched as numbers", t, func() {
+		db, ctx := newTestDB()
+
+		So(db.CreateCollection(ctx, &pb.Collection{
+			Id:          "games",
+			NumberIndex: &pb.NumberIndex{Path: "$.released", Alias: "released"},
+			FieldsIndex: &pb.PropertiesIndex{
+				Aliases: map[string]string{"$.rating": "rating", "$.online": "online", "$.sequel": "sequel", "$.code": "code"},
+				Types:   map[string]pb.ValueType{"code": pb.ValueType_StringValue},
+			},
+		}), ShouldBeNil)
+
+		for id, data := range map[string]string{
+			"g1": `{"released": "2019-06-01", "rating": 4.5, "online": true, "sequel": null, "code": "2019-01-01"}`,
+			"g2": `{"released": "2021-03-15T10:00:00Z", "rating": 3.8, "online": false, "sequel": "g1", "code": "x2"}`,
+			"g3": `{"released": "2020-11-30", "rating": 4, "code": "x3"}`,
+		} {
+			So(db.Save(ctx, "games", &pb.Object{Header: &pb.Header{Id: id}, Data: data}), ShouldBeNil)
+		}
+
+		collection, err := db.GetCollection(ctx, "games")
+		So(err, ShouldBeNil)
+		So(collection.NumberIndex.Type, ShouldEqual, pb.ValueType_TimestampValue)
+		So(collection.FieldsIndex.Types, ShouldResemble, map[string]pb.ValueType{
+			"rating": pb.ValueType_NumberValue,
+			"online": pb.ValueType_BooleanValue,
+			"sequel": pb.ValueType_StringValue,
+			"code":   pb.ValueType_StringValue,
+		})
+
+		for _, data := range []string{
+			`{"released": 1600000000000}`,
+			`{"released": true}`,
+			`{"rating": "good"}`,
+			`{"online": 1}`,
+			`{"sequel": [1]}`,
+			`{"sequel": [["g1"]]}`,
+			`{"sequel": {"id": "g1"}}`,
+		} {
+			err = db.Save(ctx, "games", &pb.Object{Header: &pb.Header{Id: "g4"}, Data: data})
+			So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+		}
+
+		search := func(query string) []string {
+			q, err := se.ParseQuery(query)
+			So(err, ShouldBeNil)
+
+			cursor, err := db.Search(ctx, "games", q, SearchObjectsOptions{})
+			So(err, ShouldBeNil)
+			defer func() {
+				So(cursor.Close(), ShouldBeNil)
+			}()
+
+			var ids []string
+			for {
+				o, err := cursor.Browse()
+				if err == io.EOF {
+					break
+				}
+				So(err, ShouldBeNil)
+				ids = append(ids, o.Header.Id)
+			}
+			sort.Strings(ids)
+			return ids
+		}
+
+		So(search(`$number >= 2020-01-01`), ShouldResemble, []string{"g2", "g3"})
+		So(search(`$number < "2021-03-15T11:00:00+02:00"`), ShouldResemble, []string{"g1", "g3"})
+		So(search(`rating > 3.9`), ShouldResemble, []string{"g1", "g3"})
+		So(search(`online = false or sequel = null`), ShouldResemble, []string{"g1", "g2"})
+		So(search(`not online exists`), ShouldResemble, []string{"g3"})
+		So(search(`code = "2019-01-01"`), ShouldResemble, []string{"g1"})
+	})
+}
+
+func TestSqlDB_ReplacedIndex(t *testing.T) {
+	Convey("Objects saved again or patched are only found by their current values", t, func() {
+		db, ctx := newTestDB()
+
+		So(db.CreateCollection(ctx, &pb.Collection{
+			Id:          "items",
+			NumberIndex: &pb.NumberIndex{Path: "$.price", Alias: "price"},
+			FieldsIndex: &pb.PropertiesIndex{Aliases: map[string]string{"$.color": "color"}},
+		}), ShouldBeNil)
+		So(db.Save(ctx, "items", &pb.Object{Header: &pb.Header{Id: "i1"}, Data: `{"price": 10, "color": "red"}`}), ShouldBeNil)
+
+		search := func(query string) []string {
+			q, err := se.ParseQuery(query)
+			So(err, ShouldBeNil)
+
+			cursor, err := db.Search(ctx, "items", q, SearchObjectsOptions{})
+			So(err, ShouldBeNil)
+			defer func() {
+				So(cursor.Close(), ShouldBeNil)
+			}()
+
+			var ids []string
+			for {
+				o, err := cursor.Browse()
+				if err == io.EOF {
+					break
+				}
+				So(err, ShouldBeNil)
+				ids = append(ids, o.Header.Id)
+			}
+			return ids
+		}
+		So(search(`$number = 10`), ShouldResemble, []string{"i1"})
+		So(search(`color = "red"`), ShouldResemble, []string{"i1"})
+
+		So(db.Save(ctx, "items", &pb.Object{Header: &pb.Header{Id: "i1"}, Data: `{"price": 20, "color": "blue"}`}), ShouldBeNil)
+		So(search(`$number = 10`), ShouldBeEmpty)
+		So(search(`color = "red"`), ShouldBeEmpty)
+		So(search(`$number = 20`), ShouldResemble, []string{"i1"})
+		So(search(`color = "blue"`), ShouldResemble, []string{"i1"})
+
+		So(db.Patch(ctx, "items", &pb.Patch{ObjectId: "i1", At: "$.price", Data: "30"}), ShouldBeNil)
+		So(db.Patch(ctx, "items", &pb.Patch{ObjectId: "i1", At: "$.color", Data: `"green"`}), ShouldBeNil)
+		So(search(`$number = 20`), ShouldBeEmpty)
+		So(search(`color = "blue"`), ShouldBeEmpty)
+		So(search(`$number = 30`), ShouldResemble, []string{"i1"})
+		So(search(`color = "green"`), ShouldResemble, []string{"i1"})
+	})
+}
+
+func TestSqlDB_ArrayIndexes(t *testing.T) {
+	Convey("Array properties are indexed element by element and searched with list operators", t, func() {
+		db, ctx := newTestDB()
+
+		So(db.CreateCollection(ctx, &pb.Collection{
+			Id:          "posts",
+			FieldsIndex: &pb.PropertiesIndex{Aliases: map[string]string{"$.tags": "tags", "$.votes": "votes"}},
+		}), ShouldBeNil)
+
+		for id, data := range map[string]string{
+			"p1": `{"tags": ["Go", "databases"], "votes": [3, 5]}`,
+			"p2": `{"tags": ["go"], "votes": 4}`,
+			"p3": `{"tags": ["rust", "databases"], "votes": []}`,
+			"p4": `{"tags": "python"}`,
+		} {
+			So(db.Save(ctx, "posts", &pb.Object{Header: &pb.Header{Id: id}, Data: data}), ShouldBeNil)
+		}
+
+		collection, err := db.GetCollection(ctx, "posts")
+		So(err, ShouldBeNil)
+		So(collection.FieldsIndex.Types, ShouldResemble, map[string]pb.ValueType{
+			"tags":  pb.ValueType_StringValue,
+			"votes": pb.ValueType_NumberValue,
+		})
+
+		err = db.Save(ctx, "posts", &pb.Object{Header: &pb.Header{Id: "p5"}, Data: `{"tags": ["go", 1]}`})
+		So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+
+		search := func(query string) []string {
+			q, err := se.ParseQuery(query)
+			So(err, ShouldBeNil)
+
+			cursor, err := db.Search(ctx, "posts", q, SearchObjectsOptions{})
+			So(err, ShouldBeNil)
+			defer func() {
+				So(cursor.Close(), ShouldBeNil)
+			}()
+
+			var ids []string
+			for {
+				o, err := cursor.Browse()
+				if err == io.EOF {
+					break
+				}
+				So(err, ShouldBeNil)
+				ids = append(ids, o.Header.Id)
+			}
+			sort.Strings(ids)
+			return ids
+		}
+
+		So(search(`tags = "go"`), ShouldResemble, []string{"p1", "p2"})
+		So(search(`tags in ("go", "python")`), ShouldResemble, []string{"p2", "p4"})
+		So(search(`tags any_of ("rust", "python")`), ShouldResemble, []string{"p3", "p4"})
+		So(search(`tags all_of ("go", "databases")`), ShouldResemble, []string{"p1"})
+		So(search(`votes > 4`), ShouldResemble, []string{"p1"})
+		So(search(`votes exists and not votes any_of (3, 4, 5)`), ShouldResemble, []string{"p3"})
+	})
+}
+
+func TestSqlDB_GeoIndex(t *testing.T) {
+	Convey("Points of GeoJSON or coordinates paths are searched by area and sorted by distance", t, func() {
+		db, ctx := newTestDB()
+
+		So(db.CreateCollection(ctx, &pb.Collection{
+			Id:       "stadiums",
+			GeoIndex: &pb.GeoIndex{Path: "$.location"},
+		}), ShouldBeNil)
+		So(db.CreateCollection(ctx, &pb.Collection{
+			Id:       "shops",
+			GeoIndex: &pb.GeoIndex{LatitudePath: "$.lat", LongitudePath: "$.lng"},
+		}), ShouldBeNil)
+
+		for id, data := range map[string]string{
+			"parc":     `{"location": {"type": "Point", "coordinates": [2.253, 48.8414]}}`,
+			"allianz":  `{"location": {"type": "Point", "coordinates": [7.6412, 45.1096]}}`,
+			"bernabeu": `{"location": {"type": "Point", "coordinates": [-3.6883, 40.4531]}}`,
+			"unknown":  `{"name": "somewhere"}`,
+		} {
+			So(db.Save(ctx, "stadiums", &pb.Object{Header: &pb.Header{Id: id}, Data: data}), ShouldBeNil)
+		}
+		So(db.Save(ctx, "shops", &pb.Object{Header: &pb.Header{Id: "louvre"}, Data: `{"lat": 48.8606, "lng": 2.3376}`}), ShouldBeNil)
+
+		for _, data := range []string{
+			`{"location": {"type": "LineString", "coordinates": [[2.25, 48.84], [2.26, 48.85]]}}`,
+			`{"location": {"type": "Point", "coordinates": ["2.25", 48.84]}}`,
+			`{"location": {"type": "Point", "coordinates": [2.25, 98.84]}}`,
+		} {
+			err := db.Save(ctx, "stadiums", &pb.Object{Header: &pb.Header{Id: "invalid"}, Data: data})
+			So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+		}
+
+		browse := func(cursor *Cursor) []*pb.Object {
+			defer func() {
+				So(cursor.Close(), ShouldBeNil)
+			}()
+
+			var objects []*pb.Object
+			for {
+				o, err := cursor.Browse()
+				if err == io.EOF {
+					return objects
+				}
+				So(err, ShouldBeNil)
+				objects = append(objects, o)
+			}
+		}
+
+		paris := &pb.GeoPoint{Lat: 48.8566, Lon: 2.3522}
+		q, err := se.ParseQuery(`$geo within_radius (48.8566, 2.3522, 700000) or $geo within_box (40, -4, 41, -3)`)
+		So(err, ShouldBeNil)
+
+		cursor, err := db.Search(ctx, "stadiums", q, SearchObjectsOptions{SortByDistance: paris})
+		So(err, ShouldBeNil)
+		objects := browse(cursor)
+		So(objects, ShouldHaveLength, 3)
+		So([]string{objects[0].Header.Id, objects[1].Header.Id, objects[2].Header.Id}, ShouldResemble, []string{"parc", "allianz", "bernabeu"})
+		So(objects[0].Distance, ShouldAlmostEqual, 7453, 10)
+
+		q, err = se.ParseQuery(`$geo within_radius (48.8566, 2.3522, 20000)`)
+		So(err, ShouldBeNil)
+
+		cursor, err = (&ExecHandler{}).SearchCollections(ContextWithStore(ctx, db), []string{"stadiums", "shops"}, q, SearchObjectsOptions{SortByDistance: paris})
+		So(err, ShouldBeNil)
+		objects = browse(cursor)
+		So(objects, ShouldHaveLength, 2)
+		So(objects[0].Header.Id, ShouldEqual, "louvre")
+		So(objects[0].Collection, ShouldEqual, "shops")
+		So(objects[1].Header.Id, ShouldEqual, "parc")
+		So(objects[0].Distance, ShouldBeLessThan, objects[1].Distance)
+	})
+}
+
+func TestSqlDB_CheckIndex(t *testing.T) {
+	Convey("Index checks report and repair mappings without object and objects without mappings", t, func() {
+		db, ctx := newTestDB()
+
+		So(db.CreateCollection(ctx, &pb.Collection{
+			Id:          "notes",
+			TextIndexes: []*pb.TextIndex{{Path: "$.title", Alias: "title"}},
+		}), ShouldBeNil)
+
+		for _, id := range []string{"n1", "n2", "n3", "n4"} {
+			So(db.Save(ctx, "notes", &pb.Object{Header: &pb.Header{Id: id}, Data: `{"title": "note ` + id + `"}`}), ShouldBeNil)
+		}
+		So(db.Save(ctx, "notes", &pb.Object{Header: &pb.Header{Id: "n5"}, Data: `{"body": "not indexed"}`}), ShouldBeNil)
+
+		col, err := db.(*sqlStore).ResolveCollection(ctx, "notes")
+		So(err, ShouldBeNil)
+		engine := col.(*sqlCollection).engine
+
+		report, err := db.CheckIndex(ctx, "notes", CheckIndexOptions{BatchSize: 2})
+		So(err, ShouldBeNil)
+		So(report.Collection, ShouldEqual, "notes")
+		So(report.Objects, ShouldEqual, 5)
+		So(report.Mapped, ShouldEqual, 4)
+		So(report.OrphansCount, ShouldEqual, 0)
+		So(report.UnmappedCount, ShouldEqual, 0)
+
+		So(engine.Feed(&pb.MessageFeed{Message: &pb.MessageFeed_TextMapping{TextMapping: &pb.TextMapping{ObjectId: "n0", Name: "title", Text: "lost note"}}}), ShouldBeNil)
+		So(engine.Feed(&pb.MessageFeed{Message: &pb.MessageFeed_Delete{Delete: &pb.ObjectDeletedNotification{Id: "n3"}}}), ShouldBeNil)
+
+		report, err = db.CheckIndex(ctx, "notes", CheckIndexOptions{BatchSize: 2})
+		So(err, ShouldBeNil)
+		So(report.Orphans, ShouldResemble, []string{"n0"})
+		So(report.OrphansCount, ShouldEqual, 1)
+		So(report.Unmapped, ShouldResemble, []string{"n3"})
+		So(report.UnmappedCount, ShouldEqual, 1)
+		So(report.Repaired, ShouldEqual, 0)
+
+		report, err = db.CheckIndex(ctx, "notes", CheckIndexOptions{Repair: true})
+		So(err, ShouldBeNil)
+		So(report.OrphansCount, ShouldEqual, 1)
+		So(report.UnmappedCount, ShouldEqual, 1)
+		So(report.Repaired, ShouldEqual, 2)
+
+		col.(*sqlCollection).indexer.notify()
+		So(col.(*sqlCollection).indexer.wait(ctx, "n3"), ShouldBeNil)
+		So(col.(*sqlCollection).indexer.wait(ctx, "n0"), ShouldBeNil)
+
+		report, err = db.CheckIndex(ctx, "notes", CheckIndexOptions{})
+		So(err, ShouldBeNil)
+		So(report.Mapped, ShouldEqual, 4)
+		So(report.OrphansCount, ShouldEqual, 0)
+		So(report.UnmappedCount, ShouldEqual, 0)
+
+		Convey("Checks that only report do not record the types of the indexed fields", func() {
+			So(db.CreateCollection(ctx, &pb.Collection{
+				Id:          "tasks",
+				FieldsIndex: &pb.PropertiesIndex{Aliases: map[string]string{"$.rank": "rank"}},
+			}), ShouldBeNil)
+			So(db.Save(ctx, "tasks", &pb.Object{Header: &pb.Header{Id: "t1"}, Data: `{"rank": 3}`}), ShouldBeNil)
+
+			tasks, err := db.(*sqlStore).ResolveCollection(ctx, "tasks")
+			So(err, ShouldBeNil)
+			index := tasks.(*sqlCollection).info.FieldsIndex
+			So(index.Types["rank"], ShouldEqual, pb.ValueType_NumberValue)
+
+			delete(index.Types, "rank")
+			report, err := db.CheckIndex(ctx, "tasks", CheckIndexOptions{})
+			So(err, ShouldBeNil)
+			So(report.Objects, ShouldEqual, 1)
+			So(index.Types, ShouldNotContainKey, "rank")
+		})
+	})
+}
+
+func TestSqlDB_IndexIntrospection(t *testing.T) {
+	Convey("The search index of a collection lists object tokens and token postings, and explains searches", t, func() {
+		db, ctx := newTestDB()
+
+		So(db.CreateCollection(ctx, &pb.Collection{
+			Id:          "notes",
+			TextIndexes: []*pb.TextIndex{{Path: "$.title", Alias: "title", Analyzer: se.EnglishAnalyzer}},
+		}), ShouldBeNil)
+
+		So(db.Save(ctx, "notes", &pb.Object{Header: &pb.Header{Id: "n1"}, Data: `{"title": "Running notes"}`}), ShouldBeNil)
+		So(db.Save(ctx, "notes", &pb.Object{Header: &pb.Header{Id: "n2"}, Data: `{"title": "Notes on notes"}`}), ShouldBeNil)
+
+		fields, err := db.ObjectTokens(ctx, "notes", "n1", ObjectTokensOptions{})
+		So(err, ShouldBeNil)
+		So(fields, ShouldHaveLength, 1)
+		So(fields[0].Field, ShouldEqual, "title")
+		So(fields[0].Tokens, ShouldHaveLength, 2)
+		So(fields[0].Tokens[0].Token, ShouldEqual, "note")
+		So(fields[0].Tokens[1].Token, ShouldEqual, "run")
+
+		postings, err := db.TokenDocuments(ctx, "notes", "note", TokenDocumentsOptions{})
+		So(err, ShouldBeNil)
+		So(postings, ShouldHaveLength, 2)
+		So(postings[1].Id, ShouldEqual, "n2")
+		So(postings[1].Tf, ShouldEqual, 2)
+
+		postings, err = db.TokenDocuments(ctx, "notes", "note", TokenDocumentsOptions{After: "n1", Count: 1})
+		So(err, ShouldBeNil)
+		So(postings, ShouldHaveLength, 1)
+		So(postings[0].Id, ShouldEqual, "n2")
+
+		tokens, err := db.Analyze(ctx, "notes", "The runners", AnalyzeOptions{Analyzer: se.EnglishAnalyzer})
+		So(err, ShouldBeNil)
+		So(tokens, ShouldResemble, []string{"runner"})
+
+		query, err := se.ParseQuery(`$text = "notes"`)
+		So(err, ShouldBeNil)
+
+		explanation, err := db.ExplainSearch(ctx, "notes", query, ExplainSearchOptions{})
+		So(err, ShouldBeNil)
+		So(explanation.Sql, ShouldNotBeEmpty)
+		So(explanation.HitsCount, ShouldEqual, 2)
+		So(explanation.Hits, ShouldHaveLength, 2)
+		So(explanation.Hits[0].Id, ShouldEqual, "n2")
+		So(explanation.Hits[0].Terms, ShouldNotBeEmpty)
+		So(explanation.Conditions, ShouldNotBeEmpty)
+
+		var terms []string
+		for _, condition := range explanation.Conditions {
+			if condition.Analyzer == se.EnglishAnalyzer {
+				terms = condition.Terms
+			}
+		}
+		So(terms, ShouldResemble, []string{"note"})
+	})
+}
+
+func TestSqlDB_MigratedIndex(t *testing.T) {
+	Convey("Collections whose words table predates fields are indexed again when loaded", t, func() {
+		db, ctx := newTestDB()
+
+		So(db.CreateCollection(ctx, &pb.Collection{
+			Id:          "cities",
+			TextIndexes: []*pb.TextIndex{{Path: "$.name", Alias: "name"}},
+		}), ShouldBeNil)
+		for id, name := range map[string]string{"c1": "Paris", "c2": "Parme"} {
+			So(db.Save(ctx, "cities", &pb.Object{Header: &pb.Header{Id: id}, Data: `{"name": "` + name + `"}`}), ShouldBeNil)
+		}
+
+		conn := db.(*sqlStore).db
+		for _, statement := range []string{
+			"drop table test_cities_index_words",
+			"create table test_cities_index_words (token varchar(255) not null, id varchar(255) not null, primary key(token, id))",
+		} {
+			_, err := conn.Exec(statement)
+			So(err, ShouldBeNil)
+		}
+		So(db.Close(), ShouldBeNil)
+
+		db, err := NewSqlDB(conn, bome.SQLite3, "test")
+		So(err, ShouldBeNil)
+		defer func() {
+			So(db.Close(), ShouldBeNil)
+		}()
+		col, err := db.(*sqlStore).ResolveCollection(ctx, "cities")
+		So(err, ShouldBeNil)
+		for _, id := range []string{"c1", "c2"} {
+			So(col.(*sqlCollection).indexer.wait(ctx, id), ShouldBeNil)
+		}
+
+		suggestions, err := db.Suggest(ctx, "cities", "name", "Par", SuggestOptions{})
+		So(err, ShouldBeNil)
+		So(suggestions, ShouldHaveLength, 2)
+
+		_, err = conn.Exec("select count(*) from test_cities_index_words_legacy")
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -26,10 +26,33 @@ type CollectionDB interface {
 	// Info gets header of the object associated with objectID
 	Info(ctx context.Context, objectID string) (*pb.Header, error)
 
-	Search(ctx context.Context, query *pb.SearchQuery) (*Cursor, error)
+	// Search returns the objects that match query, the most relevant first
+	Search(ctx context.Context, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error)
+
+	// Suggest returns the terms indexed for the text index aliased field that complete prefix, the ones indexed for
+	// the most objects first
+	Suggest(ctx context.Context, field string, prefix string, opts SuggestOptions) ([]*pb.Suggestion, error)
+
+	// CheckIndex compares the search index to the objects and repairs it when opts.Repair is set
+	CheckIndex(ctx context.Context, opts CheckIndexOptions) (*pb.IndexReport, error)
+
+	// ObjectTokens returns the tokens the search index stores for the object that has the given id, by field
+	ObjectTokens(ctx context.Context, objectID string) ([]*pb.FieldTokens, error)
+
+	// TokenDocuments returns the postings of token in the search index, by increasing object id
+	TokenDocuments(ctx context.Context, token string, opts TokenDocumentsOptions) ([]*pb.TokenPosting, error)
+
+	// Analyze returns the tokens text is analyzed as by the analyzer of opts
+	Analyze(ctx context.Context, text string, opts AnalyzeOptions) ([]string, error)
+
+	// Explain evaluates query and explains how it was compiled and scored
+	Explain(ctx context.Context, query *pb.SearchQuery) (*pb.SearchExplanation, error)
 
 	// Clear removes all objects store
 	Clear() error
+
+	// Close stops the indexing of the collection. The manager cannot be used afterwards
+	Close() error
 }
 
 type collectionContainer struct {
@@ -55,3 +78,12 @@ func (c *collectionContainer) Delete(name string) {
 	defer c.Unlock()
 	delete(c.container, name)
 }
+
+// DeleteAll empties the container and returns the collections it held
+func (c *collectionContainer) DeleteAll() map[string]CollectionDB {
+	c.Lock()
+	defer c.Unlock()
+	collections := c.container
+	c.container = make(map[string]CollectionDB)
+	return collections
+}
@@ -5,24 +5,36 @@ import (
 	"io"
 )
 
-type idsListCursor struct {
-	ids           []string
+// hitsListCursor browses the objects of search hits, in order. Each object carries the score of its hit, the first
+// one carries the facets of the search
+type hitsListCursor struct {
+	hits          []*pb.SearchHit
+	facets        []*pb.FacetResult
 	getObjectFunc func(string) (*pb.Object, error)
 	pos           int
 }
 
-func (i *idsListCursor) Browse() (*pb.Object, error) {
-	if i.pos == len(i.ids) {
+func (i *hitsListCursor) Browse() (*pb.Object, error) {
+	if i.pos == len(i.hits) {
 		return nil, io.EOF
 	}
 
-	id := i.ids[i.pos]
+	hit := i.hits[i.pos]
 	i.pos++
 
-	return i.getObjectFunc(id)
+	o, err := i.getObjectFunc(hit.Id)
+	if err != nil {
+		return nil, err
+	}
+	o.Score = hit.Score
+	o.Distance = hit.Distance
+	if i.pos == 1 {
+		o.Facets = i.facets
+	}
+	return o, nil
 }
 
-func (i *idsListCursor) Close() error {
+func (i *hitsListCursor) Close() error {
 	return nil
 }
 
@@ -81,3 +93,79 @@ func (c *Cursor) SetCloser(closer Closer) {
 func (c *Cursor) SetBrowser(browser Browser) {
 	c.browser = browser
 }
+
+// mergedHitsCursor browses the objects of the search cursors of several collections by decreasing score, or by
+// increasing distance when byDistance is set. Objects with the same score are returned in the order of the
+// collections. Each object carries its collection
+type mergedHitsCursor struct {
+	collections []string
+	cursors     []*Cursor
+	heads       []*pb.Object
+	byDistance  bool
+}
+
+func (m *mergedHitsCursor) Browse() (*pb.Object, error) {
+	if m.heads == nil {
+		m.heads = make([]*pb.Object, len(m.cursors))
+		for i := range m.cursors {
+			err := m.advance(i)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	best := -1
+	for i, o := range m.heads {
+		if o != nil && (best < 0 || m.before(o, m.heads[best])) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return nil, io.EOF
+	}
+
+	o := m.heads[best]
+	err := m.advance(best)
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// before tells whether a comes before b. Objects without distance come after the others
+func (m *mergedHitsCursor) before(a, b *pb.Object) bool {
+	if !m.byDistance {
+		return a.Score > b.Score
+	}
+	if (a.Distance < 0) != (b.Distance < 0) {
+		return b.Distance < 0
+	}
+	return a.Distance < b.Distance
+}
+
+// advance loads the next object of the cursor at position i
+func (m *mergedHitsCursor) advance(i int) error {
+	o, err := m.cursors[i].Browse()
+	if err == io.EOF {
+		m.heads[i] = nil
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	o.Collection = m.collections[i]
+	m.heads[i] = o
+	return nil
+}
+
+func (m *mergedHitsCursor) Close() error {
+	var err error
+	for _, c := range m.cursors {
+		if cErr := c.Close(); cErr != nil && err == nil {
+			err = cErr
+		}
+	}
+	return err
+}
@@ -4,14 +4,48 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"github.com/google/uuid"
 	"github.com/iancoleman/strcase"
 	"github.com/omecodes/bome"
 	"github.com/omecodes/errors"
 	"github.com/omecodes/libome/logs"
 	pb "github.com/omecodes/store/gen/go/proto"
+	se "github.com/omecodes/store/search-engine"
+	"os"
+	"path/filepath"
+	"sync"
 )
 
-func NewSqlDB(db *sql.DB, dialect string, tablePrefix string) (DB, error) {
+// synonymsVersionKey is the key of the version of the synonym sets table in its version table
+const synonymsVersionKey = "synonyms"
+
+// SqlDBOption configures a SQL objects DB
+type SqlDBOption func(*sqlStore)
+
+// WithSearchEngineService makes collections index and search their objects with the search engine services provider
+// connects to, instead of their embedded engine. ctx holds what provider needs to connect
+func WithSearchEngineService(ctx context.Context, provider se.ClientProvider) SqlDBOption {
+	return func(s *sqlStore) {
+		s.searchCtx = ctx
+		s.searchClients = provider
+	}
+}
+
+// WithIndexDir sets the directory under which collections configured with the disk index store keep their index
+func WithIndexDir(dir string) SqlDBOption {
+	return func(s *sqlStore) {
+		s.indexDir = dir
+	}
+}
+
+// WithIndexingOptions tunes the workers that apply the index changes of the objects of collections
+func WithIndexingOptions(opts IndexingOptions) SqlDBOption {
+	return func(s *sqlStore) {
+		s.indexing = opts
+	}
+}
+
+func NewSqlDB(db *sql.DB, dialect string, tablePrefix string, opts ...SqlDBOption) (DB, error) {
 	col, err := bome.Build().
 		SetDialect(dialect).
 		SetConn(db).
@@ -21,12 +55,36 @@ func NewSqlDB(db *sql.DB, dialect string, tablePrefix string) (DB, error) {
 		return nil, err
 	}
 
+	synonyms, err := bome.Build().
+		SetDialect(dialect).
+		SetConn(db).
+		SetTableName(tablePrefix + "_synonyms").
+		JSONMap()
+	if err != nil {
+		return nil, err
+	}
+
+	synonymsVersion, err := bome.Build().
+		SetDialect(dialect).
+		SetConn(db).
+		SetTableName(tablePrefix + "_synonyms_version").
+		Map()
+	if err != nil {
+		return nil, err
+	}
+
 	s := &sqlStore{
 		db:                db,
 		dialect:           dialect,
 		collections:       col,
+		synonyms:          synonyms,
+		synonymsVersion:   synonymsVersion,
 		tablePrefix:       tablePrefix,
 		loadedCollections: &collectionContainer{container: make(map[string]CollectionDB)},
+		diskIndexes:       map[string]*se.DiskStore{},
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
 	return s, nil
 }
@@ -37,11 +95,172 @@ type sqlStore struct {
 	dialect           string
 	tablePrefix       string
 	collections       *bome.JSONMap
+	searchCtx         context.Context
+	searchClients     se.ClientProvider
+	indexDir          string
+	indexing          IndexingOptions
+
+	// loadMutex serializes the creation of collection managers
+	loadMutex sync.Mutex
+
+	// diskIndexes holds the opened disk index stores, a directory can only be opened once. They are closed with the
+	// DB, after what closed is set and no collection is loaded
+	diskIndexes map[string]*se.DiskStore
+	closed      bool
+
+	// synonymSets caches the synonym sets table at the version synonymsLoaded. Each change of the table writes a new
+	// version in the synonymsVersion table, the cache is loaded again when it differs, whatever the process that made
+	// the change. synonymsMutex guards it
+	synonyms        *bome.JSONMap
+	synonymsVersion *bome.Map
+	synonymsMutex   sync.Mutex
+	synonymSets     []*pb.SynonymSet
+	synonymsLoaded  string
+}
+
+// newCollection creates the manager of collection. Its objects are indexed by the search engine service when one
+// is configured, otherwise by an embedded engine using the index store selected by the collection
+func (ms *sqlStore) newCollection(collection *pb.Collection) (*sqlCollection, error) {
+	tableName := strcase.ToSnake(collection.Id)
+	tablePrefix := ms.tablePrefix + "_" + tableName
+
+	var engine se.Service
+	switch {
+	case ms.searchClients != nil:
+		engine = se.NewClient(ms.searchCtx, collection.Id, ms.searchClients)
+
+	case collection.IndexStore == pb.IndexStore_Disk:
+		indexStore, err := ms.diskIndex(tableName)
+		if err != nil {
+			return nil, err
+		}
+		engine = se.NewEngine(indexStore)
+
+	default:
+		indexStore, err := se.NewSQLIndexStore(ms.db, ms.dialect, tablePrefix+"_index")
+		if err != nil {
+			return nil, err
+		}
+		engine = se.NewEngine(indexStore)
+	}
+
+	col, err := newSQLCollection(collection, ms.db, ms.dialect, tablePrefix, engine, ms.indexing)
+	if err != nil {
+		return nil, err
+	}
+
+	col.synonyms = func() ([]*pb.SynonymSet, error) {
+		return ms.ListSynonymSets(context.Background(), ListSynonymSetsOptions{Collection: collection.Id})
+	}
+	col.saveInfo = func(info *pb.Collection) error {
+		encoded, err := json.Marshal(info)
+		if err != nil {
+			return err
+		}
+		return ms.collections.Update(&bome.MapEntry{Key: info.Id, Value: string(encoded)})
+	}
+
+	// an index migrated from a layout whose mappings could not be converted is rebuilt from the objects. The
+	// migrated data is dropped once the mappings are queued, the outbox keeps them until they are applied
+	if m, ok := engine.(se.Migrator); ok && m.PendingReindex() {
+		err = col.reindexAll(context.Background())
+		if err == nil {
+			err = m.Reindexed()
+		}
+		if err != nil {
+			if cerr := col.Close(); cerr != nil {
+				logs.Error("could not close collection manager", logs.Details("collection", collection.Id), logs.Err(cerr))
+			}
+			return nil, err
+		}
+		logs.Info("search index rebuilt from the objects", logs.Details("collection", collection.Id))
+	}
+	return col, nil
+}
+
+// diskIndex returns the disk index store saved in the directory of the index directory named after the table
+// prefix and name, so that DBs sharing an index directory do not share stores. It must be called with loadMutex held
+func (ms *sqlStore) diskIndex(name string) (*se.DiskStore, error) {
+	if ms.indexDir == "" {
+		return nil, errors.BadRequest("disk index store is not available", errors.Details{Key: "reason", Value: "no index directory is configured"})
+	}
+
+	indexStore, found := ms.diskIndexes[name]
+	if !found {
+		dir := filepath.Join(ms.indexDir, ms.tablePrefix+"_"+name)
+		err := renameLegacyIndexDir(filepath.Join(ms.indexDir, name), dir)
+		if err != nil {
+			return nil, err
+		}
+
+		indexStore, err = se.NewDiskIndexStore(dir, se.DiskStoreOptions{})
+		if err != nil {
+			return nil, err
+		}
+		ms.diskIndexes[name] = indexStore
+	}
+	return indexStore, nil
+}
+
+// renameLegacyIndexDir moves the index directory created at legacy, before directories were named after the table
+// prefix, to dir when dir does not exist yet
+func renameLegacyIndexDir(legacy string, dir string) error {
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		return nil
+	}
+	if _, err := os.Stat(legacy); err != nil {
+		return nil
+	}
+	return os.Rename(legacy, dir)
+}
+
+// Close stops the indexing of the loaded collections, then commits and closes the disk index stores they opened
+func (ms *sqlStore) Close() error {
+	ms.loadMutex.Lock()
+	defer ms.loadMutex.Unlock()
+
+	ms.closed = true
+
+	var closeErr error
+	for id, col := range ms.loadedCollections.DeleteAll() {
+		err := col.Close()
+		if err != nil {
+			logs.Error("could not close collection manager", logs.Details("collection", id), logs.Err(err))
+			if closeErr == nil {
+				closeErr = err
+			}
+		}
+	}
+
+	for name, indexStore := range ms.diskIndexes {
+		err := indexStore.Close()
+		if err != nil {
+			logs.Error("could not close disk index store", logs.Details("index", name), logs.Err(err))
+			if closeErr == nil {
+				closeErr = err
+			}
+		}
+		delete(ms.diskIndexes, name)
+	}
+	return closeErr
 }
 
 func (ms *sqlStore) ResolveCollection(_ context.Context, name string) (CollectionDB, error) {
 	col, found := ms.loadedCollections.Get(name)
 	if !found || col == nil {
+		// collection managers run the indexing worker of the collection: a single one must be created
+		ms.loadMutex.Lock()
+		defer ms.loadMutex.Unlock()
+
+		col, found = ms.loadedCollections.Get(name)
+		if found && col != nil {
+			return col, nil
+		}
+
+		if ms.closed {
+			return nil, errors.ServiceUnavailable("objects DB is closed")
+		}
+
 		encoded, err := ms.collections.Get(name)
 		if err != nil {
 			return nil, err
@@ -53,8 +272,7 @@ func (ms *sqlStore) ResolveCollection(_ context.Context, name string) (Collectio
 			return nil, err
 		}
 
-		tableName := strcase.ToSnake(name)
-		col, err = NewSQLCollection(collection, ms.db, ms.dialect, ms.tablePrefix+"_"+tableName)
+		col, err = ms.newCollection(collection)
 		if err != nil {
 			logs.Error("could not create collection", logs.Err(err))
 			return nil, errors.Internal("failed to load collection manager")
@@ -74,8 +292,10 @@ func (ms *sqlStore) CreateCollection(_ context.Context, collection *pb.Collectio
 		return errors.Conflict("duplicate collection")
 	}
 
-	tableName := strcase.ToSnake(collection.Id)
-	col, err := NewSQLCollection(collection, ms.db, ms.dialect, ms.tablePrefix+"_"+tableName)
+	ms.loadMutex.Lock()
+	defer ms.loadMutex.Unlock()
+
+	col, err := ms.newCollection(collection)
 	if err != nil {
 		return err
 	}
@@ -134,7 +354,39 @@ func (ms *sqlStore) ListCollections(_ context.Context) ([]*pb.Collection, error)
 }
 
 func (ms *sqlStore) DeleteCollection(_ context.Context, id string) error {
-	return ms.collections.Delete(id)
+	err := ms.collections.Delete(id)
+	if err != nil {
+		return err
+	}
+	ms.unload(id)
+	return nil
+}
+
+// unload closes the manager of the collection id when it is loaded, and the disk index store it used. A collection
+// created again with the same id gets a new manager
+func (ms *sqlStore) unload(id string) {
+	ms.loadMutex.Lock()
+	defer ms.loadMutex.Unlock()
+
+	col, found := ms.loadedCollections.Get(id)
+	if !found {
+		return
+	}
+	ms.loadedCollections.Delete(id)
+
+	err := col.Close()
+	if err != nil {
+		logs.Error("could not close collection manager", logs.Details("collection", id), logs.Err(err))
+	}
+
+	name := strcase.ToSnake(id)
+	if indexStore, found := ms.diskIndexes[name]; found {
+		delete(ms.diskIndexes, name)
+		err = indexStore.Close()
+		if err != nil {
+			logs.Error("could not close disk index store", logs.Details("index", name), logs.Err(err))
+		}
+	}
 }
 
 func (ms *sqlStore) Save(ctx context.Context, collection string, object *pb.Object, indexes ...*pb.TextIndex) error {
@@ -153,6 +405,26 @@ func (ms *sqlStore) Patch(ctx context.Context, collection string, patch *pb.Patc
 	return col.Patch(ctx, patch)
 }
 
+func (ms *sqlStore) Move(ctx context.Context, collection string, objectID string, targetCollection string) error {
+	source, err := ms.ResolveCollection(ctx, collection)
+	if err != nil {
+		return err
+	}
+
+	target, err := ms.ResolveCollection(ctx, targetCollection)
+	if err != nil {
+		return err
+	}
+
+	object, err := source.Get(ctx, objectID, GetObjectOptions{})
+	if err != nil {
+		return err
+	}
+
+	touchHeader(ctx, object.Header)
+	return target.Save(ctx, object)
+}
+
 func (ms *sqlStore) Delete(ctx context.Context, collection string, objectID string) error {
 	col, err := ms.ResolveCollection(ctx, collection)
 	if err != nil {
@@ -186,10 +458,191 @@ func (ms *sqlStore) List(ctx context.Context, collection string, opts ListOption
 	return col.List(ctx, opts)
 }
 
-func (ms *sqlStore) Search(ctx context.Context, collection string, query *pb.SearchQuery) (*Cursor, error) {
+func (ms *sqlStore) Search(ctx context.Context, collection string, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error) {
+	col, err := ms.ResolveCollection(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+	return col.Search(ctx, query, opts)
+}
+
+func (ms *sqlStore) Suggest(ctx context.Context, collection string, field string, prefix string, opts SuggestOptions) ([]*pb.Suggestion, error) {
+	col, err := ms.ResolveCollection(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+	return col.Suggest(ctx, field, prefix, opts)
+}
+
+func (ms *sqlStore) CheckIndex(ctx context.Context, collection string, opts CheckIndexOptions) (*pb.IndexReport, error) {
+	col, err := ms.ResolveCollection(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := col.CheckIndex(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	report.Collection = collection
+	return report, nil
+}
+
+func (ms *sqlStore) ObjectTokens(ctx context.Context, collection string, id string, _ ObjectTokensOptions) ([]*pb.FieldTokens, error) {
+	col, err := ms.ResolveCollection(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+	return col.ObjectTokens(ctx, id)
+}
+
+func (ms *sqlStore) TokenDocuments(ctx context.Context, collection string, token string, opts TokenDocumentsOptions) ([]*pb.TokenPosting, error) {
+	col, err := ms.ResolveCollection(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+	return col.TokenDocuments(ctx, token, opts)
+}
+
+func (ms *sqlStore) Analyze(ctx context.Context, collection string, text string, opts AnalyzeOptions) ([]string, error) {
 	col, err := ms.ResolveCollection(ctx, collection)
 	if err != nil {
 		return nil, err
 	}
-	return col.Search(ctx, query)
+	return col.Analyze(ctx, text, opts)
+}
+
+func (ms *sqlStore) ExplainSearch(ctx context.Context, collection string, query *pb.SearchQuery, _ ExplainSearchOptions) (*pb.SearchExplanation, error) {
+	col, err := ms.ResolveCollection(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+	return col.Explain(ctx, query)
+}
+
+func (ms *sqlStore) IndexingMetrics(ctx context.Context, collection string) (*IndexingMetrics, error) {
+	col, err := ms.ResolveCollection(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+	return col.(*sqlCollection).indexer.metrics()
+}
+
+func (ms *sqlStore) SaveSynonymSet(ctx context.Context, set *pb.SynonymSet) error {
+	encoded, err := json.Marshal(set)
+	if err != nil {
+		return err
+	}
+
+	return ms.changeSynonymSets(ctx, func(synonyms *bome.JSONMap) error {
+		return synonyms.Upsert(&bome.MapEntry{
+			Key:   set.Id,
+			Value: string(encoded),
+		})
+	})
+}
+
+// changeSynonymSets applies change to the synonym sets table and writes a new version of it, in a transaction
+func (ms *sqlStore) changeSynonymSets(ctx context.Context, change func(synonyms *bome.JSONMap) error) error {
+	ms.synonymsMutex.Lock()
+	defer ms.synonymsMutex.Unlock()
+
+	ctx, synonyms, err := ms.synonyms.Transaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = change(synonyms)
+	if err != nil {
+		if rerr := bome.Rollback(ctx); rerr != nil {
+			logs.Error("synonym sets: rollback failed", logs.Err(rerr))
+		}
+		return err
+	}
+
+	ctx, versions, err := ms.synonymsVersion.Transaction(ctx)
+	if err != nil {
+		if rerr := bome.Rollback(ctx); rerr != nil {
+			logs.Error("synonym sets: rollback failed", logs.Err(rerr))
+		}
+		return err
+	}
+
+	err = versions.Upsert(&bome.MapEntry{Key: synonymsVersionKey, Value: uuid.New().String()})
+	if err != nil {
+		if rerr := bome.Rollback(ctx); rerr != nil {
+			logs.Error("synonym sets: rollback failed", logs.Err(rerr))
+		}
+		return err
+	}
+	return bome.Commit(ctx)
+}
+
+// ListSynonymSets returns the synonym sets matching opts from the cache of the synonym sets table, loaded again when
+// the table changed since
+func (ms *sqlStore) ListSynonymSets(_ context.Context, opts ListSynonymSetsOptions) ([]*pb.SynonymSet, error) {
+	ms.synonymsMutex.Lock()
+	defer ms.synonymsMutex.Unlock()
+
+	version, err := ms.synonymsVersion.Get(synonymsVersionKey)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	// the empty version of a table that never changed is loaded too
+	if ms.synonymSets == nil || version != ms.synonymsLoaded {
+		sets, err := ms.loadSynonymSets()
+		if err != nil {
+			return nil, err
+		}
+		ms.synonymSets = append([]*pb.SynonymSet{}, sets...)
+		ms.synonymsLoaded = version
+	}
+
+	var sets []*pb.SynonymSet
+	for _, set := range ms.synonymSets {
+		if opts.Collection != "" && set.Collection != "" && set.Collection != opts.Collection {
+			continue
+		}
+		if opts.Analyzer != "" && set.Analyzer != opts.Analyzer {
+			continue
+		}
+		sets = append(sets, set)
+	}
+	return sets, nil
+}
+
+func (ms *sqlStore) loadSynonymSets() ([]*pb.SynonymSet, error) {
+	cursor, err := ms.synonyms.List()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if cer := cursor.Close(); cer != nil {
+			logs.Error("DB cursor closing", logs.Err(cer))
+		}
+	}()
+
+	var sets []*pb.SynonymSet
+	for cursor.HasNext() {
+		o, err := cursor.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		var set *pb.SynonymSet
+		err = json.Unmarshal([]byte(o.(*bome.MapEntry).Value), &set)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, set)
+	}
+	return sets, nil
+}
+
+func (ms *sqlStore) DeleteSynonymSet(ctx context.Context, id string) error {
+	return ms.changeSynonymSets(ctx, func(synonyms *bome.JSONMap) error {
+		return synonyms.Delete(id)
+	})
 }
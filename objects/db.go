@@ -19,6 +19,10 @@ type DB interface {
 
 	Patch(ctx context.Context, collection string, patch *pb.Patch) error
 
+	// Move saves the object associated with objectID in targetCollection as a modification made by the context user.
+	// The object is kept in collection
+	Move(ctx context.Context, collection string, objectID string, targetCollection string) error
+
 	// Delete removes all content associated with objectID
 	Delete(ctx context.Context, collection string, objectID string) error
 
@@ -31,5 +35,36 @@ type DB interface {
 	// List returns a list of at most 'opts.Count' objects
 	List(ctx context.Context, collection string, opts ListOptions) (*Cursor, error)
 
-	Search(ctx context.Context, collection string, query *pb.SearchQuery) (*Cursor, error)
+	// Search returns the objects of collection that match query, the most relevant first
+	Search(ctx context.Context, collection string, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error)
+
+	// Suggest returns the terms indexed for the text index aliased field of collection that complete prefix
+	Suggest(ctx context.Context, collection string, field string, prefix string, opts SuggestOptions) ([]*pb.Suggestion, error)
+
+	// SaveSynonymSet creates or replaces the synonym set that has the id of set
+	SaveSynonymSet(ctx context.Context, set *pb.SynonymSet) error
+
+	// ListSynonymSets returns the synonym sets matching opts
+	ListSynonymSets(ctx context.Context, opts ListSynonymSetsOptions) ([]*pb.SynonymSet, error)
+
+	// DeleteSynonymSet removes the synonym set that has the given id
+	DeleteSynonymSet(ctx context.Context, id string) error
+
+	// CheckIndex compares the search index of collection to its objects and repairs it when opts.Repair is set
+	CheckIndex(ctx context.Context, collection string, opts CheckIndexOptions) (*pb.IndexReport, error)
+
+	// ObjectTokens returns the tokens the search index of collection stores for the object that has the given id
+	ObjectTokens(ctx context.Context, collection string, id string, opts ObjectTokensOptions) ([]*pb.FieldTokens, error)
+
+	// TokenDocuments returns the postings of token in the search index of collection
+	TokenDocuments(ctx context.Context, collection string, token string, opts TokenDocumentsOptions) ([]*pb.TokenPosting, error)
+
+	// Analyze returns the tokens text is analyzed as by the search index of collection
+	Analyze(ctx context.Context, collection string, text string, opts AnalyzeOptions) ([]string, error)
+
+	// ExplainSearch evaluates query on collection and explains how it was compiled and scored
+	ExplainSearch(ctx context.Context, collection string, query *pb.SearchQuery, opts ExplainSearchOptions) (*pb.SearchExplanation, error)
+
+	// Close commits and releases the index stores of the collections. The DB cannot be used afterwards
+	Close() error
 }
@@ -15,7 +15,7 @@ type ACLHandler struct {
 	BaseHandler
 }
 
-func (p *ACLHandler) assertUserIsAdmin(ctx context.Context) error {
+func assertUserIsAdmin(ctx context.Context) error {
 	user := auth.Get(ctx)
 	if user == nil {
 		return errors.Forbidden("no authenticated user")
@@ -180,12 +180,55 @@ func (p *ACLHandler) checkObjectDeletable(ctx context.Context, collection string
 	return nil
 }
 
-func (p *ACLHandler) CreateCollection(ctx context.Context, collection *pb.Collection, opts CreateCollectionOptions) error {
+// checkCollectionReadable checks that the authenticated user satisfies the view rule of the collection root. A rule
+// that names no object applies to the collection itself, in the namespace of its objects
+func (p *ACLHandler) checkCollectionReadable(ctx context.Context, collection *pb.Collection) error {
+	var username string
+	user := auth.Get(ctx)
+	if user != nil {
+		username = user.Name
+	}
+
+	var action *pb.ObjectActionsUsers
+	if collection.ActionAuthorizedUsers != nil {
+		action = collection.ActionAuthorizedUsers.AccessRules["$"]
+	}
+	if action == nil || action.View == nil {
+		return errors.Unauthorized("permission denied")
+	}
+
+	set := &pb.SubjectSet{Object: action.View.Object, Relation: action.View.Relation}
+	if set.Object == "" {
+		var namespace string
+		if collection.AclConfig != nil {
+			namespace = collection.AclConfig.Namespace
+		}
+		set.Object = fmt.Sprintf("%s:%s", namespace, collection.Id)
+	}
+
+	checked, err := acl.CheckACL(ctx, username, set, acl.CheckACLOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		logs.Error("Check ACL", logs.Err(err))
+		return err
+	}
+
+	if !checked {
+		return errors.Unauthorized("permission denied")
+	}
+	return nil
+}
+
+// assertCollectionsEditor checks that the context comes from an admin app used by an admin, as required to create
+// collections and define their indexes and write rules
+func assertCollectionsEditor(ctx context.Context) error {
 	if !auth.IsAdminAppFromContext(ctx) {
 		return errors.Forbidden("only admin app are allowed to create collections")
 	}
+	return assertUserIsAdmin(ctx)
+}
 
-	err := p.assertUserIsAdmin(ctx)
+func (p *ACLHandler) CreateCollection(ctx context.Context, collection *pb.Collection, opts CreateCollectionOptions) error {
+	err := assertCollectionsEditor(ctx)
 	if err != nil {
 		return err
 	}
@@ -212,13 +255,104 @@ func (p *ACLHandler) DeleteCollection(ctx context.Context, id string, opts Delet
 		return errors.Forbidden("only admin app are allowed to create collections")
 	}
 
-	err := p.assertUserIsAdmin(ctx)
+	err := assertUserIsAdmin(ctx)
 	if err != nil {
 		return err
 	}
 	return p.BaseHandler.DeleteCollection(ctx, id, opts)
 }
 
+func (p *ACLHandler) SaveSynonymSet(ctx context.Context, set *pb.SynonymSet, opts SaveSynonymSetOptions) error {
+	if !auth.IsAdminAppFromContext(ctx) {
+		return errors.Forbidden("only admin app are allowed to edit synonyms")
+	}
+
+	err := assertUserIsAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	return p.BaseHandler.SaveSynonymSet(ctx, set, opts)
+}
+
+func (p *ACLHandler) ListSynonymSets(ctx context.Context, opts ListSynonymSetsOptions) ([]*pb.SynonymSet, error) {
+	if !auth.IsContextFromAuthorizedApp(ctx) {
+		return nil, errors.Forbidden("application is not allowed to list synonyms")
+	}
+	return p.BaseHandler.ListSynonymSets(ctx, opts)
+}
+
+func (p *ACLHandler) DeleteSynonymSet(ctx context.Context, id string, opts DeleteSynonymSetOptions) error {
+	if !auth.IsAdminAppFromContext(ctx) {
+		return errors.Forbidden("only admin app are allowed to edit synonyms")
+	}
+
+	err := assertUserIsAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	return p.BaseHandler.DeleteSynonymSet(ctx, id, opts)
+}
+
+func (p *ACLHandler) CheckIndex(ctx context.Context, collection string, opts CheckIndexOptions) (*pb.IndexReport, error) {
+	if !auth.IsAdminAppFromContext(ctx) {
+		return nil, errors.Forbidden("only admin app are allowed to check indexes")
+	}
+
+	err := assertUserIsAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return p.BaseHandler.CheckIndex(ctx, collection, opts)
+}
+
+func (p *ACLHandler) ObjectTokens(ctx context.Context, collection string, id string, opts ObjectTokensOptions) ([]*pb.FieldTokens, error) {
+	if !auth.IsAdminAppFromContext(ctx) {
+		return nil, errors.Forbidden("only admin app are allowed to inspect indexes")
+	}
+
+	err := assertUserIsAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return p.BaseHandler.ObjectTokens(ctx, collection, id, opts)
+}
+
+func (p *ACLHandler) TokenDocuments(ctx context.Context, collection string, token string, opts TokenDocumentsOptions) ([]*pb.TokenPosting, error) {
+	if !auth.IsAdminAppFromContext(ctx) {
+		return nil, errors.Forbidden("only admin app are allowed to inspect indexes")
+	}
+
+	err := assertUserIsAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return p.BaseHandler.TokenDocuments(ctx, collection, token, opts)
+}
+
+func (p *ACLHandler) Analyze(ctx context.Context, collection string, text string, opts AnalyzeOptions) ([]string, error) {
+	if !auth.IsAdminAppFromContext(ctx) {
+		return nil, errors.Forbidden("only admin app are allowed to inspect indexes")
+	}
+
+	err := assertUserIsAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return p.BaseHandler.Analyze(ctx, collection, text, opts)
+}
+
+func (p *ACLHandler) ExplainSearch(ctx context.Context, collection string, query *pb.SearchQuery, opts ExplainSearchOptions) (*pb.SearchExplanation, error) {
+	if !auth.IsAdminAppFromContext(ctx) {
+		return nil, errors.Forbidden("only admin app are allowed to inspect indexes")
+	}
+
+	err := assertUserIsAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return p.BaseHandler.ExplainSearch(ctx, collection, query, opts)
+}
+
 func (p *ACLHandler) PutObject(ctx context.Context, collection string, object *pb.Object, authorizedUsers *pb.PathAccessRules, indexes []*pb.TextIndex, opts PutOptions) (string, error) {
 	user := auth.Get(ctx)
 	if user == nil {
@@ -235,11 +369,27 @@ func (p *ACLHandler) PutObject(ctx context.Context, collection string, object *p
 		authorizedUsers = collectionInfo.ActionAuthorizedUsers
 	}
 
+	// an existing object is replaced: it must be editable and keeps its ACL
+	if object.Header.Id != "" {
+		_, err = p.next.GetObjectHeader(ctx, collection, object.Header.Id, GetHeaderOptions{})
+		if err == nil {
+			err = p.checkObjectEditable(ctx, collection, object.Header.Id, "")
+			if err != nil {
+				return "", err
+			}
+			return p.BaseHandler.PutObject(ctx, collection, object, authorizedUsers, indexes, opts)
+		}
+		if !errors.IsNotFound(err) {
+			return "", err
+		}
+	}
+
 	object.Header.CreatedBy = user.Name
 
-	id, err := p.BaseHandler.PutObject(ctx, collection, object, authorizedUsers, indexes, opts)
-	if err != nil {
-		return "", err
+	// an object saved but not searchable yet is kept: its ACL is saved and the indexing error returned with its id
+	id, indexErr := p.BaseHandler.PutObject(ctx, collection, object, authorizedUsers, indexes, opts)
+	if indexErr != nil && !IsNotIndexed(indexErr) {
+		return "", indexErr
 	}
 
 	err = acl.SaveACL(ctx, &pb.ACL{
@@ -253,8 +403,9 @@ func (p *ACLHandler) PutObject(ctx context.Context, collection string, object *p
 		if delErr != nil {
 			logs.Error("could not delete new created object", logs.Details("id", id))
 		}
+		return id, err
 	}
-	return id, err
+	return id, indexErr
 }
 
 func (p *ACLHandler) GetObject(ctx context.Context, collection string, id string, opts GetObjectOptions) (*pb.Object, error) {
@@ -346,3 +497,66 @@ func (p *ACLHandler) SearchObjects(ctx context.Context, collection string, query
 	cursor.SetBrowser(browser)
 	return cursor, nil
 }
+
+func (p *ACLHandler) SearchCollections(ctx context.Context, collections []string, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error) {
+	// searching all collections requires to read the list of collections
+	if len(collections) == 0 && !auth.IsContextFromAuthorizedApp(ctx) {
+		return nil, errors.Forbidden("application is not allowed to list collections")
+	}
+
+	// admins read all collections. Other users search all the collections they can read, or the named ones if they
+	// can read each of them
+	admin := assertUserIsAdmin(ctx) == nil
+	if len(collections) == 0 {
+		all, err := p.next.ListCollections(ctx, ListCollectionOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, collection := range all {
+			if !admin {
+				err = p.checkCollectionReadable(ctx, collection)
+				if err != nil {
+					if errors.IsUnauthorized(err) {
+						continue
+					}
+					return nil, err
+				}
+			}
+			collections = append(collections, collection.Id)
+		}
+
+		// the base handler would search all collections again
+		if len(collections) == 0 {
+			none := &mergedHitsCursor{}
+			return NewCursor(none, none), nil
+		}
+
+	} else if !admin {
+		for _, id := range collections {
+			collection, err := p.next.GetCollection(ctx, id, GetCollectionOptions{})
+			if err != nil {
+				return nil, err
+			}
+
+			err = p.checkCollectionReadable(ctx, collection)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	cursor, err := p.BaseHandler.SearchCollections(ctx, collections, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// todo: filter viewable objects
+	cursorBrowser := cursor.GetBrowser()
+	browser := BrowseFunc(func() (*pb.Object, error) {
+		return cursorBrowser.Browse()
+	})
+
+	cursor.SetBrowser(browser)
+	return cursor, nil
+}
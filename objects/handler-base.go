@@ -56,3 +56,43 @@ func (b *BaseHandler) ListObjects(ctx context.Context, collection string, opts L
 func (b *BaseHandler) SearchObjects(ctx context.Context, collection string, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error) {
 	return b.next.SearchObjects(ctx, collection, query, opts)
 }
+
+func (b *BaseHandler) Suggest(ctx context.Context, collection string, field string, prefix string, opts SuggestOptions) ([]*pb.Suggestion, error) {
+	return b.next.Suggest(ctx, collection, field, prefix, opts)
+}
+
+func (b *BaseHandler) SaveSynonymSet(ctx context.Context, set *pb.SynonymSet, opts SaveSynonymSetOptions) error {
+	return b.next.SaveSynonymSet(ctx, set, opts)
+}
+
+func (b *BaseHandler) ListSynonymSets(ctx context.Context, opts ListSynonymSetsOptions) ([]*pb.SynonymSet, error) {
+	return b.next.ListSynonymSets(ctx, opts)
+}
+
+func (b *BaseHandler) DeleteSynonymSet(ctx context.Context, id string, opts DeleteSynonymSetOptions) error {
+	return b.next.DeleteSynonymSet(ctx, id, opts)
+}
+
+func (b *BaseHandler) CheckIndex(ctx context.Context, collection string, opts CheckIndexOptions) (*pb.IndexReport, error) {
+	return b.next.CheckIndex(ctx, collection, opts)
+}
+
+func (b *BaseHandler) ObjectTokens(ctx context.Context, collection string, id string, opts ObjectTokensOptions) ([]*pb.FieldTokens, error) {
+	return b.next.ObjectTokens(ctx, collection, id, opts)
+}
+
+func (b *BaseHandler) TokenDocuments(ctx context.Context, collection string, token string, opts TokenDocumentsOptions) ([]*pb.TokenPosting, error) {
+	return b.next.TokenDocuments(ctx, collection, token, opts)
+}
+
+func (b *BaseHandler) Analyze(ctx context.Context, collection string, text string, opts AnalyzeOptions) ([]string, error) {
+	return b.next.Analyze(ctx, collection, text, opts)
+}
+
+func (b *BaseHandler) ExplainSearch(ctx context.Context, collection string, query *pb.SearchQuery, opts ExplainSearchOptions) (*pb.SearchExplanation, error) {
+	return b.next.ExplainSearch(ctx, collection, query, opts)
+}
+
+func (b *BaseHandler) SearchCollections(ctx context.Context, collections []string, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error) {
+	return b.next.SearchCollections(ctx, collections, query, opts)
+}
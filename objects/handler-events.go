@@ -0,0 +1,61 @@
+package objects
+
+import (
+	"context"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"github.com/omecodes/store/webhooks"
+)
+
+// EventsHandler notifies registered webhooks of successful object mutations
+type EventsHandler struct {
+	BaseHandler
+}
+
+func (h *EventsHandler) PutObject(ctx context.Context, collection string, object *pb.Object, accessSecurityRules *pb.PathAccessRules, indexes []*pb.TextIndex, opts PutOptions) (string, error) {
+	id, err := h.next.PutObject(ctx, collection, object, accessSecurityRules, indexes, opts)
+	if err == nil || IsNotIndexed(err) {
+		webhooks.Notify(ctx, &webhooks.Event{
+			Type:       webhooks.EventObjectPut,
+			Collection: collection,
+			ObjectID:   id,
+		})
+	}
+	return id, err
+}
+
+func (h *EventsHandler) PatchObject(ctx context.Context, collection string, patch *pb.Patch, opts PatchOptions) error {
+	err := h.next.PatchObject(ctx, collection, patch, opts)
+	if err == nil {
+		webhooks.Notify(ctx, &webhooks.Event{
+			Type:       webhooks.EventObjectPatch,
+			Collection: collection,
+			ObjectID:   patch.ObjectId,
+		})
+	}
+	return err
+}
+
+func (h *EventsHandler) MoveObject(ctx context.Context, collection string, objectID string, targetCollection string, accessSecurityRules *pb.PathAccessRules, opts MoveOptions) error {
+	err := h.next.MoveObject(ctx, collection, objectID, targetCollection, accessSecurityRules, opts)
+	if err == nil {
+		webhooks.Notify(ctx, &webhooks.Event{
+			Type:       webhooks.EventObjectMove,
+			Collection: collection,
+			ObjectID:   objectID,
+			Target:     targetCollection,
+		})
+	}
+	return err
+}
+
+func (h *EventsHandler) DeleteObject(ctx context.Context, collection string, id string, opts DeleteObjectOptions) error {
+	err := h.next.DeleteObject(ctx, collection, id, opts)
+	if err == nil || IsNotIndexed(err) {
+		webhooks.Notify(ctx, &webhooks.Event{
+			Type:       webhooks.EventObjectDelete,
+			Collection: collection,
+			ObjectID:   id,
+		})
+	}
+	return err
+}
@@ -52,7 +52,7 @@ func (e *ExecHandler) DeleteCollection(ctx context.Context, id string, _ DeleteC
 	return storage.DeleteCollection(ctx, id)
 }
 
-func (e *ExecHandler) PutObject(ctx context.Context, collection string, object *pb.Object, _ *pb.PathAccessRules, indexes []*pb.TextIndex, _ PutOptions) (string, error) {
+func (e *ExecHandler) PutObject(ctx context.Context, collection string, object *pb.Object, _ *pb.PathAccessRules, indexes []*pb.TextIndex, opts PutOptions) (string, error) {
 	if object.Header.Id == "" {
 		object.Header.Id = uuid.New().String()
 	}
@@ -62,8 +62,15 @@ func (e *ExecHandler) PutObject(ctx context.Context, collection string, object *
 		return "", errors.Internal("missing objects storage")
 	}
 
+	if opts.WaitIndexed {
+		ctx = ContextWithIndexVisibility(ctx)
+	}
+
 	err := storage.Save(ctx, collection, object, indexes...)
 	if err != nil {
+		if IsNotIndexed(err) {
+			return object.Header.Id, err
+		}
 		logs.Error("could not save object", logs.Err(err))
 		return "", err
 	}
@@ -87,12 +94,7 @@ func (e *ExecHandler) MoveObject(ctx context.Context, collection string, objectI
 		return errors.Internal("missing objects storage")
 	}
 
-	object, err := storage.Get(ctx, collection, objectID, GetObjectOptions{})
-	if err != nil {
-		return err
-	}
-
-	return storage.Save(ctx, targetCollection, object)
+	return storage.Move(ctx, collection, objectID, targetCollection)
 }
 
 func (e *ExecHandler) GetObject(ctx context.Context, collection string, id string, opts GetObjectOptions) (*pb.Object, error) {
@@ -114,13 +116,16 @@ func (e *ExecHandler) GetObjectHeader(ctx context.Context, collection string, id
 	return storage.Info(ctx, collection, id)
 }
 
-func (e *ExecHandler) DeleteObject(ctx context.Context, collection string, id string, _ DeleteObjectOptions) error {
+func (e *ExecHandler) DeleteObject(ctx context.Context, collection string, id string, opts DeleteObjectOptions) error {
 	storage := Get(ctx)
 	if storage == nil {
 		logs.Info("exec-handler.DeleteObjet: missing DB in context")
 		return errors.Internal("missing objects storage")
 	}
 
+	if opts.WaitIndexed {
+		ctx = ContextWithIndexVisibility(ctx)
+	}
 	return storage.Delete(ctx, collection, id)
 }
 
@@ -134,12 +139,155 @@ func (e *ExecHandler) ListObjects(ctx context.Context, collection string, opts L
 	return storage.List(ctx, collection, opts)
 }
 
-func (e *ExecHandler) SearchObjects(ctx context.Context, collection string, query *pb.SearchQuery, _ SearchObjectsOptions) (*Cursor, error) {
+func (e *ExecHandler) SearchObjects(ctx context.Context, collection string, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error) {
 	storage := Get(ctx)
 	if storage == nil {
 		logs.Error("exec-handler.SearchObjects: missing storage in context")
 		return nil, errors.Internal("missing objects storage")
 	}
 
-	return storage.Search(ctx, collection, query)
+	return storage.Search(ctx, collection, query, opts)
+}
+
+func (e *ExecHandler) SearchCollections(ctx context.Context, collections []string, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error) {
+	storage := Get(ctx)
+	if storage == nil {
+		logs.Error("exec-handler.SearchCollections: missing storage in context")
+		return nil, errors.Internal("missing objects storage")
+	}
+
+	if len(collections) == 0 {
+		all, err := storage.ListCollections(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, collection := range all {
+			collections = append(collections, collection.Id)
+		}
+	}
+
+	// a query may refer to fields that are only indexed in some of the collections: the collections that reject it
+	// are skipped, unless all of them do
+	var (
+		searched []string
+		cursors  []*Cursor
+		rejected error
+	)
+	for _, collection := range collections {
+		cursor, err := storage.Search(ctx, collection, query, opts)
+		if err != nil {
+			if errors.HTTPStatus(err) == errors.HTTPStatus(errors.BadRequest("")) {
+				if rejected == nil {
+					rejected = err
+				}
+				continue
+			}
+
+			for _, c := range cursors {
+				if cErr := c.Close(); cErr != nil {
+					logs.Error("cursor closed with an error", logs.Err(cErr))
+				}
+			}
+			return nil, err
+		}
+		searched = append(searched, collection)
+		cursors = append(cursors, cursor)
+	}
+
+	if len(cursors) == 0 && rejected != nil {
+		return nil, rejected
+	}
+
+	merged := &mergedHitsCursor{collections: searched, cursors: cursors, byDistance: opts.SortByDistance != nil}
+	return NewCursor(merged, merged), nil
+}
+
+func (e *ExecHandler) Suggest(ctx context.Context, collection string, field string, prefix string, opts SuggestOptions) ([]*pb.Suggestion, error) {
+	storage := Get(ctx)
+	if storage == nil {
+		logs.Error("exec-handler.Suggest: missing storage in context")
+		return nil, errors.Internal("missing objects storage")
+	}
+
+	return storage.Suggest(ctx, collection, field, prefix, opts)
+}
+
+func (e *ExecHandler) SaveSynonymSet(ctx context.Context, set *pb.SynonymSet, _ SaveSynonymSetOptions) error {
+	storage := Get(ctx)
+	if storage == nil {
+		logs.Error("exec-handler.SaveSynonymSet: missing storage in context")
+		return errors.Internal("missing objects storage")
+	}
+
+	return storage.SaveSynonymSet(ctx, set)
+}
+
+func (e *ExecHandler) ListSynonymSets(ctx context.Context, opts ListSynonymSetsOptions) ([]*pb.SynonymSet, error) {
+	storage := Get(ctx)
+	if storage == nil {
+		logs.Error("exec-handler.ListSynonymSets: missing storage in context")
+		return nil, errors.Internal("missing objects storage")
+	}
+
+	return storage.ListSynonymSets(ctx, opts)
+}
+
+func (e *ExecHandler) DeleteSynonymSet(ctx context.Context, id string, _ DeleteSynonymSetOptions) error {
+	storage := Get(ctx)
+	if storage == nil {
+		logs.Error("exec-handler.DeleteSynonymSet: missing storage in context")
+		return errors.Internal("missing objects storage")
+	}
+
+	return storage.DeleteSynonymSet(ctx, id)
+}
+
+func (e *ExecHandler) CheckIndex(ctx context.Context, collection string, opts CheckIndexOptions) (*pb.IndexReport, error) {
+	storage := Get(ctx)
+	if storage == nil {
+		logs.Error("exec-handler.CheckIndex: missing storage in context")
+		return nil, errors.Internal("missing objects storage")
+	}
+
+	return storage.CheckIndex(ctx, collection, opts)
+}
+
+func (e *ExecHandler) ObjectTokens(ctx context.Context, collection string, id string, opts ObjectTokensOptions) ([]*pb.FieldTokens, error) {
+	storage := Get(ctx)
+	if storage == nil {
+		logs.Error("exec-handler.ObjectTokens: missing storage in context")
+		return nil, errors.Internal("missing objects storage")
+	}
+
+	return storage.ObjectTokens(ctx, collection, id, opts)
+}
+
+func (e *ExecHandler) TokenDocuments(ctx context.Context, collection string, token string, opts TokenDocumentsOptions) ([]*pb.TokenPosting, error) {
+	storage := Get(ctx)
+	if storage == nil {
+		logs.Error("exec-handler.TokenDocuments: missing storage in context")
+		return nil, errors.Internal("missing objects storage")
+	}
+
+	return storage.TokenDocuments(ctx, collection, token, opts)
+}
+
+func (e *ExecHandler) Analyze(ctx context.Context, collection string, text string, opts AnalyzeOptions) ([]string, error) {
+	storage := Get(ctx)
+	if storage == nil {
+		logs.Error("exec-handler.Analyze: missing storage in context")
+		return nil, errors.Internal("missing objects storage")
+	}
+
+	return storage.Analyze(ctx, collection, text, opts)
+}
+
+func (e *ExecHandler) ExplainSearch(ctx context.Context, collection string, query *pb.SearchQuery, opts ExplainSearchOptions) (*pb.SearchExplanation, error) {
+	storage := Get(ctx)
+	if storage == nil {
+		logs.Error("exec-handler.ExplainSearch: missing storage in context")
+		return nil, errors.Internal("missing objects storage")
+	}
+
+	return storage.ExplainSearch(ctx, collection, query, opts)
 }
@@ -2,8 +2,11 @@ package objects
 
 import (
 	"context"
+	"github.com/omecodes/errors"
 	"github.com/omecodes/store/auth"
 	pb "github.com/omecodes/store/gen/go/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // NewGRPCObjectsClientHandler creates a router ObjectsHandler that embed that calls a gRPC service to perform final actions
@@ -64,7 +67,7 @@ func (g *gRPCClientHandler) DeleteCollection(ctx context.Context, id string, _ D
 	return err
 }
 
-func (g *gRPCClientHandler) PutObject(ctx context.Context, collection string, object *pb.Object, accessSecurityRules *pb.PathAccessRules, indexes []*pb.TextIndex, _ PutOptions) (string, error) {
+func (g *gRPCClientHandler) PutObject(ctx context.Context, collection string, object *pb.Object, accessSecurityRules *pb.PathAccessRules, indexes []*pb.TextIndex, opts PutOptions) (string, error) {
 	client, err := grpcClient(ctx, g.nodeType)
 	if err != nil {
 		return "", err
@@ -75,17 +78,19 @@ func (g *gRPCClientHandler) PutObject(ctx context.Context, collection string, ob
 		return "", err
 	}
 
+	var md metadata.MD
 	rsp, err := client.PutObject(newCtx, &pb.PutObjectRequest{
 		Collection:            collection,
 		Object:                object,
 		Indexes:               indexes,
 		ActionAuthorizedUsers: accessSecurityRules,
-	})
+		WaitIndexed:           opts.WaitIndexed,
+	}, grpc.Header(&md))
 	if err != nil {
 		return "", err
 	}
 
-	return rsp.ObjectId, nil
+	return rsp.ObjectId, notIndexedError(md, rsp.ObjectId)
 }
 
 func (g *gRPCClientHandler) PatchObject(ctx context.Context, collection string, patch *pb.Patch, _ PatchOptions) error {
@@ -170,7 +175,7 @@ func (g *gRPCClientHandler) GetObjectHeader(ctx context.Context, collection stri
 	return rsp.Header, nil
 }
 
-func (g *gRPCClientHandler) DeleteObject(ctx context.Context, collection string, id string, _ DeleteObjectOptions) error {
+func (g *gRPCClientHandler) DeleteObject(ctx context.Context, collection string, id string, opts DeleteObjectOptions) error {
 	client, err := grpcClient(ctx, g.nodeType)
 	if err != nil {
 		return err
@@ -181,11 +186,25 @@ func (g *gRPCClientHandler) DeleteObject(ctx context.Context, collection string,
 		return err
 	}
 
+	var md metadata.MD
 	_, err = client.DeleteObject(newCtx, &pb.DeleteObjectRequest{
-		Collection: collection,
-		ObjectId:   id,
-	})
-	return err
+		Collection:  collection,
+		ObjectId:    id,
+		WaitIndexed: opts.WaitIndexed,
+	}, grpc.Header(&md))
+	if err != nil {
+		return err
+	}
+	return notIndexedError(md, id)
+}
+
+// notIndexedError returns the NotIndexedError the server reported in the response header md, if any
+func notIndexedError(md metadata.MD, objectID string) error {
+	values := md.Get(indexStatusMetadata)
+	if len(values) == 0 {
+		return nil
+	}
+	return &NotIndexedError{ObjectID: objectID, Err: errors.ServiceUnavailable(values[0])}
 }
 
 func (g *gRPCClientHandler) ListObjects(ctx context.Context, collection string, opts ListOptions) (*Cursor, error) {
@@ -200,9 +219,18 @@ func (g *gRPCClientHandler) ListObjects(ctx context.Context, collection string,
 	}
 
 	stream, err := client.ListObjects(newCtx, &pb.ListObjectsRequest{
-		Offset:     opts.Offset,
-		At:         opts.At,
-		Collection: collection,
+		Offset:        opts.Offset,
+		At:            opts.At,
+		Collection:    collection,
+		SortBy:        opts.SortBy,
+		UpdatedBy:     opts.UpdatedBy,
+		UpdatedAfter:  opts.UpdatedAfter,
+		UpdatedBefore: opts.UpdatedBefore,
+		CreatedBy:     opts.CreatedBy,
+		CreatedAfter:  opts.CreatedAfter,
+		CreatedBefore: opts.CreatedBefore,
+		MinSize:       opts.MinSize,
+		MaxSize:       opts.MaxSize,
 	})
 	if err != nil {
 		return nil, err
@@ -218,7 +246,7 @@ func (g *gRPCClientHandler) ListObjects(ctx context.Context, collection string,
 	return NewCursor(browser, closer), nil
 }
 
-func (g *gRPCClientHandler) SearchObjects(ctx context.Context, collection string, query *pb.SearchQuery, _ SearchObjectsOptions) (*Cursor, error) {
+func (g *gRPCClientHandler) SearchObjects(ctx context.Context, collection string, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error) {
 	client, err := grpcClient(ctx, g.nodeType)
 	if err != nil {
 		return nil, err
@@ -230,8 +258,12 @@ func (g *gRPCClientHandler) SearchObjects(ctx context.Context, collection string
 	}
 
 	stream, err := client.SearchObjects(newCtx, &pb.SearchObjectsRequest{
-		Collection: collection,
-		Query:      query,
+		Collection:     collection,
+		Query:          query,
+		Highlight:      opts.Highlight,
+		FragmentSize:   uint32(opts.FragmentSize),
+		Facets:         opts.Facets,
+		SortByDistance: opts.SortByDistance,
 	})
 	if err != nil {
 		return nil, err
@@ -246,3 +278,197 @@ func (g *gRPCClientHandler) SearchObjects(ctx context.Context, collection string
 
 	return NewCursor(browser, closer), nil
 }
+
+func (g *gRPCClientHandler) SearchCollections(ctx context.Context, collections []string, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error) {
+	client, err := grpcClient(ctx, g.nodeType)
+	if err != nil {
+		return nil, err
+	}
+
+	newCtx, err := auth.ContextWithMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := client.SearchCollections(newCtx, &pb.SearchCollectionsRequest{
+		Collections:    collections,
+		Query:          query,
+		Highlight:      opts.Highlight,
+		FragmentSize:   uint32(opts.FragmentSize),
+		SortByDistance: opts.SortByDistance,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	closer := CloseFunc(func() error {
+		return stream.CloseSend()
+	})
+	browser := BrowseFunc(func() (*pb.Object, error) {
+		return stream.Recv()
+	})
+
+	return NewCursor(browser, closer), nil
+}
+
+func (g *gRPCClientHandler) Suggest(ctx context.Context, collection string, field string, prefix string, opts SuggestOptions) ([]*pb.Suggestion, error) {
+	client, err := grpcClient(ctx, g.nodeType)
+	if err != nil {
+		return nil, err
+	}
+
+	newCtx, err := auth.ContextWithMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := client.Suggest(newCtx, &pb.SuggestionsRequest{
+		Collection:  collection,
+		Field:       field,
+		Prefix:      prefix,
+		Size:        uint32(opts.Size),
+		MaxDistance: uint32(opts.MaxDistance),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Suggestions, nil
+}
+
+func (g *gRPCClientHandler) SaveSynonymSet(ctx context.Context, set *pb.SynonymSet, _ SaveSynonymSetOptions) error {
+	client, err := grpcClient(ctx, g.nodeType)
+	if err != nil {
+		return err
+	}
+
+	newCtx, err := auth.ContextWithMeta(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.SaveSynonymSet(newCtx, &pb.SaveSynonymSetRequest{Set: set})
+	return err
+}
+
+func (g *gRPCClientHandler) ListSynonymSets(ctx context.Context, opts ListSynonymSetsOptions) ([]*pb.SynonymSet, error) {
+	client, err := grpcClient(ctx, g.nodeType)
+	if err != nil {
+		return nil, err
+	}
+
+	newCtx, err := auth.ContextWithMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := client.ListSynonymSets(newCtx, &pb.ListSynonymSetsRequest{Collection: opts.Collection, Analyzer: opts.Analyzer})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Sets, nil
+}
+
+func (g *gRPCClientHandler) DeleteSynonymSet(ctx context.Context, id string, _ DeleteSynonymSetOptions) error {
+	client, err := grpcClient(ctx, g.nodeType)
+	if err != nil {
+		return err
+	}
+
+	newCtx, err := auth.ContextWithMeta(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteSynonymSet(newCtx, &pb.DeleteSynonymSetRequest{Id: id})
+	return err
+}
+
+func (g *gRPCClientHandler) CheckIndex(ctx context.Context, collection string, opts CheckIndexOptions) (*pb.IndexReport, error) {
+	client, err := grpcClient(ctx, g.nodeType)
+	if err != nil {
+		return nil, err
+	}
+
+	newCtx, err := auth.ContextWithMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := client.CheckIndex(newCtx, &pb.CheckIndexRequest{Collection: collection, Repair: opts.Repair, BatchSize: uint32(opts.BatchSize)})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Report, nil
+}
+
+func (g *gRPCClientHandler) ObjectTokens(ctx context.Context, collection string, id string, _ ObjectTokensOptions) ([]*pb.FieldTokens, error) {
+	client, err := grpcClient(ctx, g.nodeType)
+	if err != nil {
+		return nil, err
+	}
+
+	newCtx, err := auth.ContextWithMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := client.ObjectTokens(newCtx, &pb.ObjectTokensRequest{Collection: collection, Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Fields, nil
+}
+
+func (g *gRPCClientHandler) TokenDocuments(ctx context.Context, collection string, token string, opts TokenDocumentsOptions) ([]*pb.TokenPosting, error) {
+	client, err := grpcClient(ctx, g.nodeType)
+	if err != nil {
+		return nil, err
+	}
+
+	newCtx, err := auth.ContextWithMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := client.TokenDocuments(newCtx, &pb.TokenDocumentsRequest{Collection: collection, Token: token, Field: opts.Field, After: opts.After, Count: uint32(opts.Count)})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Postings, nil
+}
+
+func (g *gRPCClientHandler) Analyze(ctx context.Context, collection string, text string, opts AnalyzeOptions) ([]string, error) {
+	client, err := grpcClient(ctx, g.nodeType)
+	if err != nil {
+		return nil, err
+	}
+
+	newCtx, err := auth.ContextWithMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := client.Analyze(newCtx, &pb.AnalyzeRequest{Collection: collection, Analyzer: opts.Analyzer, Text: text})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Tokens, nil
+}
+
+func (g *gRPCClientHandler) ExplainSearch(ctx context.Context, collection string, query *pb.SearchQuery, _ ExplainSearchOptions) (*pb.SearchExplanation, error) {
+	client, err := grpcClient(ctx, g.nodeType)
+	if err != nil {
+		return nil, err
+	}
+
+	newCtx, err := auth.ContextWithMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := client.ExplainSearch(newCtx, &pb.ExplainSearchRequest{Collection: collection, Query: query})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Explanation, nil
+}
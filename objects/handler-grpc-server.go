@@ -4,6 +4,8 @@ import (
 	"context"
 	"github.com/omecodes/store/auth"
 	pb "github.com/omecodes/store/gen/go/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"io"
 
 	"github.com/omecodes/libome/logs"
@@ -50,7 +52,10 @@ func (h *gRPCGatewayHandler) PutObject(ctx context.Context, request *pb.PutObjec
 		request.ActionAuthorizedUsers.AccessRules = map[string]*pb.ObjectActionsUsers{}
 	}
 
-	id, err := PutObject(ctx, "", request.Object, request.ActionAuthorizedUsers, request.Indexes, PutOptions{})
+	id, err := PutObject(ctx, "", request.Object, request.ActionAuthorizedUsers, request.Indexes, PutOptions{WaitIndexed: request.WaitIndexed})
+	if IsNotIndexed(err) {
+		err = sendNotIndexed(ctx, err)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -82,10 +87,70 @@ func (h *gRPCGatewayHandler) GetObject(ctx context.Context, request *pb.GetObjec
 
 func (h *gRPCGatewayHandler) DeleteObject(ctx context.Context, request *pb.DeleteObjectRequest) (*pb.DeleteObjectResponse, error) {
 	var err error
-	err = DeleteObject(ctx, "", request.ObjectId, DeleteObjectOptions{})
+	err = DeleteObject(ctx, "", request.ObjectId, DeleteObjectOptions{WaitIndexed: request.WaitIndexed})
+	if IsNotIndexed(err) {
+		err = sendNotIndexed(ctx, err)
+	}
 	return &pb.DeleteObjectResponse{}, err
 }
 
+// sendNotIndexed tells the client the write is done but not searchable, with a response header: the response
+// carries the result of the write
+func sendNotIndexed(ctx context.Context, err error) error {
+	return grpc.SetHeader(ctx, metadata.Pairs(indexStatusMetadata, err.Error()))
+}
+
+func (h *gRPCGatewayHandler) Suggest(ctx context.Context, request *pb.SuggestionsRequest) (*pb.SuggestResponse, error) {
+	suggestions, err := Suggest(ctx, request.Collection, request.Field, request.Prefix, SuggestOptions{
+		Size:        int64(request.Size),
+		MaxDistance: int64(request.MaxDistance),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SuggestResponse{Suggestions: suggestions}, nil
+}
+
+func (h *gRPCGatewayHandler) SaveSynonymSet(ctx context.Context, request *pb.SaveSynonymSetRequest) (*pb.SaveSynonymSetResponse, error) {
+	err := SaveSynonymSet(ctx, request.Set, SaveSynonymSetOptions{})
+	return &pb.SaveSynonymSetResponse{}, err
+}
+
+func (h *gRPCGatewayHandler) ListSynonymSets(ctx context.Context, request *pb.ListSynonymSetsRequest) (*pb.ListSynonymSetsResponse, error) {
+	sets, err := ListSynonymSets(ctx, ListSynonymSetsOptions{Collection: request.Collection, Analyzer: request.Analyzer})
+	return &pb.ListSynonymSetsResponse{Sets: sets}, err
+}
+
+func (h *gRPCGatewayHandler) DeleteSynonymSet(ctx context.Context, request *pb.DeleteSynonymSetRequest) (*pb.DeleteSynonymSetResponse, error) {
+	err := DeleteSynonymSet(ctx, request.Id, DeleteSynonymSetOptions{})
+	return &pb.DeleteSynonymSetResponse{}, err
+}
+
+func (h *gRPCGatewayHandler) CheckIndex(ctx context.Context, request *pb.CheckIndexRequest) (*pb.CheckIndexResponse, error) {
+	report, err := CheckIndex(ctx, request.Collection, CheckIndexOptions{Repair: request.Repair, BatchSize: int64(request.BatchSize)})
+	return &pb.CheckIndexResponse{Report: report}, err
+}
+
+func (h *gRPCGatewayHandler) ObjectTokens(ctx context.Context, request *pb.ObjectTokensRequest) (*pb.ObjectTokensResponse, error) {
+	fields, err := ObjectTokens(ctx, request.Collection, request.Id, ObjectTokensOptions{})
+	return &pb.ObjectTokensResponse{Fields: fields}, err
+}
+
+func (h *gRPCGatewayHandler) TokenDocuments(ctx context.Context, request *pb.TokenDocumentsRequest) (*pb.TokenDocumentsResponse, error) {
+	postings, err := TokenDocuments(ctx, request.Collection, request.Token, TokenDocumentsOptions{Field: request.Field, After: request.After, Count: int64(request.Count)})
+	return &pb.TokenDocumentsResponse{Postings: postings}, err
+}
+
+func (h *gRPCGatewayHandler) Analyze(ctx context.Context, request *pb.AnalyzeRequest) (*pb.AnalyzeResponse, error) {
+	tokens, err := Analyze(ctx, request.Collection, request.Text, AnalyzeOptions{Analyzer: request.Analyzer})
+	return &pb.AnalyzeResponse{Tokens: tokens}, err
+}
+
+func (h *gRPCGatewayHandler) ExplainSearch(ctx context.Context, request *pb.ExplainSearchRequest) (*pb.ExplainSearchResponse, error) {
+	explanation, err := ExplainSearch(ctx, request.Collection, request.Query, ExplainSearchOptions{})
+	return &pb.ExplainSearchResponse{Explanation: explanation}, err
+}
+
 func (h *gRPCGatewayHandler) ObjectInfo(ctx context.Context, request *pb.ObjectInfoRequest) (*pb.ObjectInfoResponse, error) {
 	var err error
 	header, err := GetObjectHeader(ctx, "", request.ObjectId, GetHeaderOptions{})
@@ -103,8 +168,17 @@ func (h *gRPCGatewayHandler) ListObjects(request *pb.ListObjectsRequest, stream
 	}
 
 	opts := ListOptions{
-		At:     request.At,
-		Offset: request.Offset,
+		At:            request.At,
+		Offset:        request.Offset,
+		SortBy:        request.SortBy,
+		UpdatedBy:     request.UpdatedBy,
+		UpdatedAfter:  request.UpdatedAfter,
+		UpdatedBefore: request.UpdatedBefore,
+		CreatedBy:     request.CreatedBy,
+		CreatedAfter:  request.CreatedAfter,
+		CreatedBefore: request.CreatedBefore,
+		MinSize:       request.MinSize,
+		MaxSize:       request.MaxSize,
 	}
 
 	cursor, err := ListObjects(ctx, request.Collection, opts)
@@ -140,7 +214,49 @@ func (h *gRPCGatewayHandler) SearchObjects(request *pb.SearchObjectsRequest, str
 		return err
 	}
 
-	cursor, err := SearchObjects(ctx, request.Collection, request.Query, SearchObjectsOptions{})
+	cursor, err := SearchObjects(ctx, request.Collection, request.Query, SearchObjectsOptions{
+		Highlight:      request.Highlight,
+		FragmentSize:   int64(request.FragmentSize),
+		Facets:         request.Facets,
+		SortByDistance: request.SortByDistance,
+	})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if ce := cursor.Close(); ce != nil {
+			logs.Error("closed cursor with error", logs.Err(err))
+		}
+	}()
+
+	for {
+		o, err := cursor.Browse()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		err = stream.Send(o)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (h *gRPCGatewayHandler) SearchCollections(request *pb.SearchCollectionsRequest, stream pb.Objects_SearchCollectionsServer) error {
+	ctx, err := auth.ParseMetaInNewContext(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	cursor, err := SearchCollections(ctx, request.Collections, request.Query, SearchObjectsOptions{
+		Highlight:      request.Highlight,
+		FragmentSize:   int64(request.FragmentSize),
+		SortByDistance: request.SortByDistance,
+	})
 	if err != nil {
 		return err
 	}
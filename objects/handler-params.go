@@ -5,6 +5,7 @@ import (
 	"github.com/omecodes/errors"
 	"github.com/omecodes/libome/logs"
 	pb "github.com/omecodes/store/gen/go/proto"
+	se "github.com/omecodes/store/search-engine"
 	"github.com/omecodes/store/settings"
 	"io"
 	"strconv"
@@ -18,6 +19,53 @@ func (p *ParamsHandler) CreateCollection(ctx context.Context, collection *pb.Col
 	if collection == nil || collection.ActionAuthorizedUsers == nil || collection.Id == "" {
 		return errors.BadRequest("requires a collection with an ID and default security rules")
 	}
+
+	for _, index := range collection.TextIndexes {
+		if index.Boost < 0 {
+			return errors.BadRequest("text index boost cannot be negative", errors.Details{Key: "alias", Value: index.Alias})
+		}
+
+		if _, err := se.GetAnalyzer(index.Analyzer); err != nil {
+			return errors.BadRequest("text index references an unknown analyzer", errors.Details{Key: "alias", Value: index.Alias}, errors.Details{Key: "analyzer", Value: index.Analyzer})
+		}
+	}
+
+	if index := collection.NumberIndex; index != nil {
+		switch index.Type {
+		case pb.ValueType_UntypedValue, pb.ValueType_NumberValue, pb.ValueType_TimestampValue:
+		default:
+			return errors.BadRequest("number index only indexes numbers and timestamps", errors.Details{Key: "type", Value: index.Type.String()})
+		}
+	}
+
+	if index := collection.GeoIndex; index != nil {
+		pointPath := index.Path != "" && index.LatitudePath == "" && index.LongitudePath == ""
+		coordinatesPaths := index.Path == "" && index.LatitudePath != "" && index.LongitudePath != ""
+		if !pointPath && !coordinatesPaths {
+			return errors.BadRequest("geo index requires either a GeoJSON point path or both latitude and longitude paths")
+		}
+	}
+
+	if index := collection.FieldsIndex; index != nil {
+		aliases := map[string]bool{}
+		for _, alias := range index.Aliases {
+			aliases[alias] = true
+		}
+
+		for alias, typ := range index.Types {
+			if !aliases[alias] {
+				return errors.BadRequest("properties index types references an unknown alias", errors.Details{Key: "alias", Value: alias})
+			}
+			if _, known := pb.ValueType_name[int32(typ)]; !known {
+				return errors.BadRequest("properties index types references an unknown type", errors.Details{Key: "alias", Value: alias}, errors.Details{Key: "type", Value: typ})
+			}
+		}
+	}
+
+	err := ValidateWriteRules(collection.WriteRules)
+	if err != nil {
+		return err
+	}
 	return p.BaseHandler.CreateCollection(ctx, collection, opts)
 }
 
@@ -63,6 +111,9 @@ func (p *ParamsHandler) PutObject(ctx context.Context, collection string, object
 	}
 
 	object.Header.Size = int64(len(object.Data))
+	object.Header.UpdatedAt = 0
+	object.Header.UpdatedBy = ""
+	object.Header.Edits = 0
 
 	if object.Header.Size > maxLength {
 		logs.Error("could not process request. Object too big", logs.Details("max", maxLength), logs.Details("received", object.Header.Size))
@@ -135,6 +186,10 @@ func (p *ParamsHandler) ListObjects(ctx context.Context, collection string, opts
 		return nil, errors.BadRequest("requires a collection ID ")
 	}
 
+	if opts.SortBy != "" && opts.SortBy != SortByCreatedAt && opts.SortBy != SortByUpdatedAt {
+		return nil, errors.BadRequest("unsupported sort field", errors.Details{Key: "sort_by", Value: opts.SortBy})
+	}
+
 	settingsManager := settings.GetManager(ctx)
 	if settingsManager == nil {
 		return nil, errors.Internal("missing settings in context")
@@ -178,5 +233,133 @@ func (p *ParamsHandler) SearchObjects(ctx context.Context, collection string, qu
 	if collection == "" || query == nil {
 		return nil, errors.BadRequest("requires a collection id and a query object")
 	}
+
+	if opts.FragmentSize < 0 || opts.FragmentSize > se.MaxFragmentSize {
+		return nil, errors.BadRequest("fragment size is out of range", errors.Details{Key: "max", Value: se.MaxFragmentSize})
+	}
+
+	if opts.SortByDistance != nil {
+		if err := se.ValidateGeoPoint(opts.SortByDistance); err != nil {
+			return nil, err
+		}
+	}
 	return p.BaseHandler.SearchObjects(ctx, collection, query, opts)
 }
+
+func (p *ParamsHandler) SearchCollections(ctx context.Context, collections []string, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error) {
+	if query == nil {
+		return nil, errors.BadRequest("requires a query object")
+	}
+
+	for _, collection := range collections {
+		if collection == "" {
+			return nil, errors.BadRequest("collection ids must not be empty")
+		}
+	}
+
+	if opts.FragmentSize < 0 || opts.FragmentSize > se.MaxFragmentSize {
+		return nil, errors.BadRequest("fragment size is out of range", errors.Details{Key: "max", Value: se.MaxFragmentSize})
+	}
+
+	if opts.SortByDistance != nil {
+		if err := se.ValidateGeoPoint(opts.SortByDistance); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(opts.Facets) > 0 {
+		return nil, errors.BadRequest("facets are not supported when searching several collections")
+	}
+	return p.BaseHandler.SearchCollections(ctx, collections, query, opts)
+}
+
+func (p *ParamsHandler) Suggest(ctx context.Context, collection string, field string, prefix string, opts SuggestOptions) ([]*pb.Suggestion, error) {
+	if collection == "" || field == "" {
+		return nil, errors.BadRequest("requires a collection id and a field")
+	}
+
+	if opts.Size < 0 || opts.Size > se.MaxSuggestSize {
+		return nil, errors.BadRequest("suggestions size is out of range", errors.Details{Key: "max", Value: se.MaxSuggestSize})
+	}
+
+	if opts.MaxDistance < 0 || opts.MaxDistance > se.MaxFuzzyDistance {
+		return nil, errors.BadRequest("fuzzy distance is out of range", errors.Details{Key: "max-distance", Value: se.MaxFuzzyDistance})
+	}
+	return p.BaseHandler.Suggest(ctx, collection, field, prefix, opts)
+}
+
+func (p *ParamsHandler) SaveSynonymSet(ctx context.Context, set *pb.SynonymSet, opts SaveSynonymSetOptions) error {
+	err := se.ValidateSynonymSet(set)
+	if err != nil {
+		return err
+	}
+	return p.BaseHandler.SaveSynonymSet(ctx, set, opts)
+}
+
+func (p *ParamsHandler) DeleteSynonymSet(ctx context.Context, id string, opts DeleteSynonymSetOptions) error {
+	if id == "" {
+		return errors.BadRequest("requires a synonym set ID")
+	}
+	return p.BaseHandler.DeleteSynonymSet(ctx, id, opts)
+}
+
+func (p *ParamsHandler) CheckIndex(ctx context.Context, collection string, opts CheckIndexOptions) (*pb.IndexReport, error) {
+	if collection == "" {
+		return nil, errors.BadRequest("requires a collection id")
+	}
+
+	if opts.BatchSize == 0 {
+		opts.BatchSize = DefaultIndexCheckBatchSize
+	}
+	if opts.BatchSize < 0 || opts.BatchSize > se.MaxMappedIDs {
+		return nil, errors.BadRequest("batch size is out of range", errors.Details{Key: "max", Value: se.MaxMappedIDs})
+	}
+	return p.BaseHandler.CheckIndex(ctx, collection, opts)
+}
+
+func (p *ParamsHandler) ObjectTokens(ctx context.Context, collection string, id string, opts ObjectTokensOptions) ([]*pb.FieldTokens, error) {
+	if collection == "" {
+		return nil, errors.BadRequest("requires a collection id")
+	}
+
+	if id == "" {
+		return nil, errors.BadRequest("requires an object id")
+	}
+	return p.BaseHandler.ObjectTokens(ctx, collection, id, opts)
+}
+
+func (p *ParamsHandler) TokenDocuments(ctx context.Context, collection string, token string, opts TokenDocumentsOptions) ([]*pb.TokenPosting, error) {
+	if collection == "" {
+		return nil, errors.BadRequest("requires a collection id")
+	}
+
+	if token == "" {
+		return nil, errors.BadRequest("requires a token")
+	}
+
+	if opts.Count == 0 {
+		opts.Count = se.DefaultTokenDocuments
+	}
+	if opts.Count < 0 || opts.Count > se.MaxTokenDocuments {
+		return nil, errors.BadRequest("count is out of range", errors.Details{Key: "max", Value: se.MaxTokenDocuments})
+	}
+	return p.BaseHandler.TokenDocuments(ctx, collection, token, opts)
+}
+
+func (p *ParamsHandler) Analyze(ctx context.Context, collection string, text string, opts AnalyzeOptions) ([]string, error) {
+	if collection == "" {
+		return nil, errors.BadRequest("requires a collection id")
+	}
+	return p.BaseHandler.Analyze(ctx, collection, text, opts)
+}
+
+func (p *ParamsHandler) ExplainSearch(ctx context.Context, collection string, query *pb.SearchQuery, opts ExplainSearchOptions) (*pb.SearchExplanation, error) {
+	if collection == "" {
+		return nil, errors.BadRequest("requires a collection id")
+	}
+
+	if query == nil {
+		return nil, errors.BadRequest("requires a search query")
+	}
+	return p.BaseHandler.ExplainSearch(ctx, collection, query, opts)
+}
@@ -0,0 +1,109 @@
+package objects
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/omecodes/errors"
+	"github.com/omecodes/libome/logs"
+	pb "github.com/omecodes/store/gen/go/proto"
+)
+
+// RulesHandler applies collections write rules to objects before they are saved
+type RulesHandler struct {
+	BaseHandler
+}
+
+func (h *RulesHandler) PutObject(ctx context.Context, collection string, object *pb.Object, accessSecurityRules *pb.PathAccessRules, indexes []*pb.TextIndex, opts PutOptions) (string, error) {
+	collectionInfo, err := h.next.GetCollection(ctx, collection, GetCollectionOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	if len(collectionInfo.WriteRules) > 0 {
+		result, err := EvaluateWriteRules(ctx, collectionInfo.WriteRules, object.Data)
+		if err != nil {
+			return "", err
+		}
+
+		if !result.Accepted {
+			logs.Info("object rejected by write rule", logs.Details("collection", collection), logs.Details("rule", result.Rule))
+			return "", errors.BadRequest(result.Message, errors.Details{Key: "rule", Value: result.Rule})
+		}
+
+		object.Data = result.Data
+		object.Header.Size = int64(len(object.Data))
+	}
+
+	return h.next.PutObject(ctx, collection, object, accessSecurityRules, indexes, opts)
+}
+
+func (h *RulesHandler) PatchObject(ctx context.Context, collection string, patch *pb.Patch, opts PatchOptions) error {
+	collectionInfo, err := h.next.GetCollection(ctx, collection, GetCollectionOptions{})
+	if err != nil {
+		return err
+	}
+
+	if len(collectionInfo.WriteRules) == 0 {
+		return h.next.PatchObject(ctx, collection, patch, opts)
+	}
+
+	object, err := h.next.GetObject(ctx, collection, patch.ObjectId, GetObjectOptions{})
+	if err != nil {
+		return err
+	}
+
+	patched, err := applyPatch(object.Data, patch)
+	if err != nil {
+		return err
+	}
+
+	result, err := EvaluateWriteRules(ctx, collectionInfo.WriteRules, patched)
+	if err != nil {
+		return err
+	}
+
+	if !result.Accepted {
+		logs.Info("patch rejected by write rule", logs.Details("collection", collection), logs.Details("rule", result.Rule))
+		return errors.BadRequest(result.Message, errors.Details{Key: "rule", Value: result.Rule})
+	}
+
+	if result.Data != patched {
+		// rules may have changed values outside the patched path. The whole object is replaced
+		patch = &pb.Patch{
+			ObjectId: patch.ObjectId,
+			At:       "$",
+			Data:     result.Data,
+		}
+	}
+	return h.next.PatchObject(ctx, collection, patch, opts)
+}
+
+// applyPatch returns the JSON encoded result of setting the patch data in the object data at the patch path
+func applyPatch(data string, patch *pb.Patch) (string, error) {
+	var value interface{}
+	err := json.Unmarshal([]byte(patch.Data), &value)
+	if err != nil {
+		return "", errors.BadRequest("patch data is not a valid JSON", errors.Details{Key: "error", Value: err.Error()})
+	}
+
+	if patch.At == "$" {
+		return patch.Data, nil
+	}
+
+	var doc interface{}
+	err = json.Unmarshal([]byte(data), &doc)
+	if err != nil {
+		return "", errors.Internal("could not decode object data", errors.Details{Key: "error", Value: err.Error()})
+	}
+
+	doc, err = setAtPath(doc, patch.At, value)
+	if err != nil {
+		return "", errors.BadRequest("patch path is not supported in collections with write rules", errors.Details{Key: "at", Value: patch.At})
+	}
+
+	encoded, err := json.Marshal(doc)
+	if err != nil {
+		return "", errors.Internal("could not encode object data", errors.Details{Key: "error", Value: err.Error()})
+	}
+	return string(encoded), nil
+}
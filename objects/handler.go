@@ -19,6 +19,18 @@ type Handler interface {
 	DeleteObject(ctx context.Context, collection string, id string, opts DeleteObjectOptions) error
 	ListObjects(ctx context.Context, collection string, opts ListOptions) (*Cursor, error)
 	SearchObjects(ctx context.Context, collection string, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error)
+	SearchCollections(ctx context.Context, collections []string, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error)
+	Suggest(ctx context.Context, collection string, field string, prefix string, opts SuggestOptions) ([]*pb.Suggestion, error)
+
+	SaveSynonymSet(ctx context.Context, set *pb.SynonymSet, opts SaveSynonymSetOptions) error
+	ListSynonymSets(ctx context.Context, opts ListSynonymSetsOptions) ([]*pb.SynonymSet, error)
+	DeleteSynonymSet(ctx context.Context, id string, opts DeleteSynonymSetOptions) error
+
+	CheckIndex(ctx context.Context, collection string, opts CheckIndexOptions) (*pb.IndexReport, error)
+	ObjectTokens(ctx context.Context, collection string, id string, opts ObjectTokensOptions) ([]*pb.FieldTokens, error)
+	TokenDocuments(ctx context.Context, collection string, token string, opts TokenDocumentsOptions) ([]*pb.TokenPosting, error)
+	Analyze(ctx context.Context, collection string, text string, opts AnalyzeOptions) ([]string, error)
+	ExplainSearch(ctx context.Context, collection string, query *pb.SearchQuery, opts ExplainSearchOptions) (*pb.SearchExplanation, error)
 }
 
 func CreateCollection(ctx context.Context, collection *pb.Collection, opts CreateCollectionOptions) error {
@@ -68,3 +80,55 @@ func ListObjects(ctx context.Context, collection string, opts ListOptions) (*Cur
 func SearchObjects(ctx context.Context, collection string, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error) {
 	return GetRouterHandler(ctx).SearchObjects(ctx, collection, query, opts)
 }
+
+// SearchCollections runs query over collections, or over all collections when it is empty. The found objects are
+// ranked together and each of them carries its collection
+func SearchCollections(ctx context.Context, collections []string, query *pb.SearchQuery, opts SearchObjectsOptions) (*Cursor, error) {
+	return GetRouterHandler(ctx).SearchCollections(ctx, collections, query, opts)
+}
+
+// Suggest returns the terms indexed for the text index aliased field of collection that complete prefix, the ones
+// indexed for the most objects first
+func Suggest(ctx context.Context, collection string, field string, prefix string, opts SuggestOptions) ([]*pb.Suggestion, error) {
+	return GetRouterHandler(ctx).Suggest(ctx, collection, field, prefix, opts)
+}
+
+// SaveSynonymSet creates or replaces a synonym set. Searches look for the new synonyms as soon as it is saved
+func SaveSynonymSet(ctx context.Context, set *pb.SynonymSet, opts SaveSynonymSetOptions) error {
+	return GetRouterHandler(ctx).SaveSynonymSet(ctx, set, opts)
+}
+
+func ListSynonymSets(ctx context.Context, opts ListSynonymSetsOptions) ([]*pb.SynonymSet, error) {
+	return GetRouterHandler(ctx).ListSynonymSets(ctx, opts)
+}
+
+func DeleteSynonymSet(ctx context.Context, id string, opts DeleteSynonymSetOptions) error {
+	return GetRouterHandler(ctx).DeleteSynonymSet(ctx, id, opts)
+}
+
+// CheckIndex reports the mappings of the search index of collection that point at missing objects and the objects
+// that miss mappings. With opts.Repair, it also queues their fix
+func CheckIndex(ctx context.Context, collection string, opts CheckIndexOptions) (*pb.IndexReport, error) {
+	return GetRouterHandler(ctx).CheckIndex(ctx, collection, opts)
+}
+
+// ObjectTokens returns the tokens the search index of collection stores for the object that has the given id, by field
+func ObjectTokens(ctx context.Context, collection string, id string, opts ObjectTokensOptions) ([]*pb.FieldTokens, error) {
+	return GetRouterHandler(ctx).ObjectTokens(ctx, collection, id, opts)
+}
+
+// TokenDocuments returns the postings of token in the search index of collection, by increasing object id
+func TokenDocuments(ctx context.Context, collection string, token string, opts TokenDocumentsOptions) ([]*pb.TokenPosting, error) {
+	return GetRouterHandler(ctx).TokenDocuments(ctx, collection, token, opts)
+}
+
+// Analyze returns the tokens text is indexed as, and looked up as in text queries, with the analyzer of opts
+func Analyze(ctx context.Context, collection string, text string, opts AnalyzeOptions) ([]string, error) {
+	return GetRouterHandler(ctx).Analyze(ctx, collection, text, opts)
+}
+
+// ExplainSearch evaluates query on collection and details the terms its text conditions look for and the scores of
+// the best hits
+func ExplainSearch(ctx context.Context, collection string, query *pb.SearchQuery, opts ExplainSearchOptions) (*pb.SearchExplanation, error) {
+	return GetRouterHandler(ctx).ExplainSearch(ctx, collection, query, opts)
+}
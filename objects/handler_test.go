@@ -5,14 +5,17 @@ import (
 	"database/sql"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/omecodes/bome"
+	"github.com/omecodes/errors"
 	"github.com/omecodes/store/acl"
 	"github.com/omecodes/store/auth"
 	"github.com/omecodes/store/common/utime"
 	pb "github.com/omecodes/store/gen/go/proto"
+	se "github.com/omecodes/store/search-engine"
 	"github.com/omecodes/store/settings"
 	. "github.com/smartystreets/goconvey/convey"
 	"io"
 	"os"
+	"sort"
 	"testing"
 	"time"
 )
@@ -280,6 +283,25 @@ func adminAppContext(ctx context.Context) context.Context {
 	return auth.ContextWithApp(ctx, adminApp)
 }
 
+// viewedCollection returns a collection whose objects titles are indexed, viewed by the users with the viewer
+// relation and edited by the users with the editor relation
+func viewedCollection(id string) *pb.Collection {
+	return &pb.Collection{
+		Id:          id,
+		TextIndexes: []*pb.TextIndex{{Path: "$.title", Alias: "title"}},
+		AclConfig:   &pb.ACLConfig{Namespace: "object", RelationWithCreated: "owner"},
+		ActionAuthorizedUsers: &pb.PathAccessRules{
+			AccessRules: map[string]*pb.ObjectActionsUsers{
+				"$": {
+					View:   &pb.SubjectSet{Relation: "viewer"},
+					Edit:   &pb.SubjectSet{Relation: "editor"},
+					Delete: &pb.SubjectSet{Relation: "owner"},
+				},
+			},
+		},
+	}
+}
+
 func Test_DBInitialization(t *testing.T) {
 	Convey("Database initialization should be executed with no errors", t, func() {
 		tearDown()
@@ -346,6 +368,46 @@ func TestHandler_CreateCollection2(t *testing.T) {
 	})
 }
 
+func TestHandler_CreateCollection3(t *testing.T) {
+	Convey("COLLECTION - CREATE: cannot create a collection declaring index types that do not apply", t, func() {
+		setup()
+		router := DefaultRouter()
+		h := router.GetHandler()
+		adminContext := userContext(baseContext(), "admin")
+
+		rules := &pb.PathAccessRules{AccessRules: map[string]*pb.ObjectActionsUsers{
+			"$": {View: &pb.SubjectSet{}, Edit: &pb.SubjectSet{}, Delete: &pb.SubjectSet{}},
+		}}
+
+		err := h.CreateCollection(adminContext, &pb.Collection{
+			Id:                    "games",
+			ActionAuthorizedUsers: rules,
+			NumberIndex:           &pb.NumberIndex{Path: "$.online", Alias: "online", Type: pb.ValueType_BooleanValue},
+		}, CreateCollectionOptions{})
+		So(err, ShouldNotBeNil)
+
+		err = h.CreateCollection(adminContext, &pb.Collection{
+			Id:                    "games",
+			ActionAuthorizedUsers: rules,
+			FieldsIndex: &pb.PropertiesIndex{
+				Aliases: map[string]string{"$.rating": "rating"},
+				Types:   map[string]pb.ValueType{"score": pb.ValueType_NumberValue},
+			},
+		}, CreateCollectionOptions{})
+		So(err, ShouldNotBeNil)
+
+		err = h.CreateCollection(adminContext, &pb.Collection{
+			Id:                    "games",
+			ActionAuthorizedUsers: rules,
+			FieldsIndex: &pb.PropertiesIndex{
+				Aliases: map[string]string{"$.rating": "rating"},
+				Types:   map[string]pb.ValueType{"rating": 42},
+			},
+		}, CreateCollectionOptions{})
+		So(err, ShouldNotBeNil)
+	})
+}
+
 func TestHandler_CreateCollection(t *testing.T) {
 	Convey("COLLECTION - CREATE: can create a collection if user is admin", t, func() {
 		setup()
@@ -698,6 +760,34 @@ func TestHandler_PutObject(t *testing.T) {
 	})
 }
 
+func TestHandler_PutObjectAgain(t *testing.T) {
+	Convey("OBJECTS - PUT: putting an existing object again keeps its creation metadata and counts as an edit", t, func() {
+		setup()
+		store, ctx := newTestDB()
+		h := DefaultRouter().GetHandler()
+		So(store.CreateCollection(ctx, viewedCollection("notes")), ShouldBeNil)
+
+		pirloCtx := ContextWithStore(ContextWithIndexVisibility(userContextFromRegisteredApplication(baseContext(), "pirlo")), store)
+		_, err := h.PutObject(pirloCtx, "notes", &pb.Object{Header: &pb.Header{Id: "a1"}, Data: `{"title": "first"}`}, nil, nil, PutOptions{})
+		So(err, ShouldBeNil)
+
+		created, err := h.GetObjectHeader(pirloCtx, "notes", "a1", GetHeaderOptions{})
+		So(err, ShouldBeNil)
+		So(created.Edits, ShouldEqual, 0)
+
+		_, err = h.PutObject(pirloCtx, "notes", &pb.Object{Header: &pb.Header{Id: "a1"}, Data: `{"title": "second"}`}, nil, nil, PutOptions{})
+		So(err, ShouldBeNil)
+
+		header, err := h.GetObjectHeader(pirloCtx, "notes", "a1", GetHeaderOptions{})
+		So(err, ShouldBeNil)
+		So(header.Edits, ShouldEqual, 1)
+		So(header.CreatedAt, ShouldEqual, created.CreatedAt)
+		So(header.CreatedBy, ShouldEqual, "pirlo")
+		So(header.UpdatedBy, ShouldEqual, "pirlo")
+		So(header.UpdatedAt, ShouldBeGreaterThanOrEqualTo, created.UpdatedAt)
+	})
+}
+
 func TestHandler_PatchObject1(t *testing.T) {
 	Convey("OBJECTS - PATCH: cannot patch object if context has no settings manager", t, func() {
 		setup()
@@ -1066,6 +1156,184 @@ func TestHandler_SearchObjects(t *testing.T) {
 	})
 }
 
+func TestHandler_SearchCollections(t *testing.T) {
+	Convey("OBJECTS - SEARCH: cannot search several collections without a query, with an empty collection id, with facets or an invalid origin", t, func() {
+		setup()
+		router := DefaultRouter()
+		h := router.GetHandler()
+
+		_, err := h.SearchCollections(baseContext(), []string{"some-collection-id"}, nil, SearchObjectsOptions{})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.SearchCollections(baseContext(), []string{"some-collection-id", ""}, &pb.SearchQuery{}, SearchObjectsOptions{})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.SearchCollections(baseContext(), nil, &pb.SearchQuery{}, SearchObjectsOptions{Facets: []*pb.Facet{{Field: "name"}}})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.SearchCollections(baseContext(), nil, &pb.SearchQuery{}, SearchObjectsOptions{SortByDistance: &pb.GeoPoint{Lat: 91}})
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestHandler_SearchReadableCollections(t *testing.T) {
+	Convey("OBJECTS - SEARCH: users only search the collections they can read", t, func() {
+		setup()
+		store, ctx := newTestDB()
+		h := DefaultRouter().GetHandler()
+
+		So(store.CreateCollection(ctx, viewedCollection("products")), ShouldBeNil)
+		So(store.CreateCollection(ctx, viewedCollection("faq")), ShouldBeNil)
+		So(store.Save(ctx, "products", &pb.Object{Header: &pb.Header{Id: "p1"}, Data: `{"title": "Delivery box"}`}), ShouldBeNil)
+		So(store.Save(ctx, "faq", &pb.Object{Header: &pb.Header{Id: "f1"}, Data: `{"title": "Delivery delays"}`}), ShouldBeNil)
+
+		So(tupleStore.Save(context.Background(), &pb.DBEntry{
+			Sid:      1,
+			Object:   "object:products",
+			Relation: "viewer",
+			Subject:  "buffon",
+		}), ShouldBeNil)
+
+		q, err := se.ParseQuery(`$text = "delivery"`)
+		So(err, ShouldBeNil)
+
+		search := func(ctx context.Context, collections []string) ([]string, error) {
+			cursor, err := h.SearchCollections(ContextWithStore(ctx, store), collections, q, SearchObjectsOptions{})
+			if err != nil {
+				return nil, err
+			}
+			defer func() {
+				So(cursor.Close(), ShouldBeNil)
+			}()
+
+			var found []string
+			for {
+				o, err := cursor.Browse()
+				if err == io.EOF {
+					sort.Strings(found)
+					return found, nil
+				}
+				So(err, ShouldBeNil)
+				found = append(found, o.Collection+"/"+o.Header.Id)
+			}
+		}
+
+		buffonCtx := userContextFromRegisteredApplication(baseContext(), "buffon")
+		found, err := search(buffonCtx, nil)
+		So(err, ShouldBeNil)
+		So(found, ShouldResemble, []string{"products/p1"})
+
+		_, err = search(buffonCtx, []string{"products", "faq"})
+		So(err, ShouldNotBeNil)
+		So(errors.IsUnauthorized(err), ShouldBeTrue)
+
+		found, err = search(userContextFromRegisteredApplication(baseContext(), "pirlo"), nil)
+		So(err, ShouldBeNil)
+		So(found, ShouldBeEmpty)
+
+		found, err = search(fullAdminContext(), nil)
+		So(err, ShouldBeNil)
+		So(found, ShouldResemble, []string{"faq/f1", "products/p1"})
+	})
+}
+
+func TestHandler_Suggest(t *testing.T) {
+	Convey("OBJECTS - SUGGEST: cannot get suggestions without a collection id or a field, or with out of range options", t, func() {
+		setup()
+		router := DefaultRouter()
+		h := router.GetHandler()
+
+		_, err := h.Suggest(baseContext(), "", "name", "pa", SuggestOptions{})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.Suggest(baseContext(), "some-collection-id", "", "pa", SuggestOptions{})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.Suggest(baseContext(), "some-collection-id", "name", "pa", SuggestOptions{Size: se.MaxSuggestSize + 1})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.Suggest(baseContext(), "some-collection-id", "name", "pa", SuggestOptions{MaxDistance: se.MaxFuzzyDistance + 1})
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestHandler_SynonymSets(t *testing.T) {
+	Convey("OBJECTS - SYNONYMS: cannot save an invalid synonym set or delete one without id", t, func() {
+		setup()
+		router := DefaultRouter()
+		h := router.GetHandler()
+
+		err := h.SaveSynonymSet(baseContext(), nil, SaveSynonymSetOptions{})
+		So(err, ShouldNotBeNil)
+
+		err = h.SaveSynonymSet(baseContext(), &pb.SynonymSet{Id: "tv", Synonyms: []string{"tv", "television"}}, SaveSynonymSetOptions{})
+		So(err, ShouldNotBeNil)
+
+		err = h.SaveSynonymSet(baseContext(), &pb.SynonymSet{Id: "tv", Collection: "some-collection-id", Synonyms: []string{"tv"}}, SaveSynonymSetOptions{})
+		So(err, ShouldNotBeNil)
+
+		err = h.DeleteSynonymSet(baseContext(), "", DeleteSynonymSetOptions{})
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestHandler_CheckIndex(t *testing.T) {
+	Convey("OBJECTS - CHECK INDEX: cannot check an index without a collection id, with an out of range batch size or if user is not admin", t, func() {
+		setup()
+		router := DefaultRouter()
+		h := router.GetHandler()
+
+		_, err := h.CheckIndex(baseContext(), "", CheckIndexOptions{})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.CheckIndex(baseContext(), "some-collection-id", CheckIndexOptions{BatchSize: se.MaxMappedIDs + 1})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.CheckIndex(userContextFromRegisteredApplication(baseContext(), "pirlo"), "some-collection-id", CheckIndexOptions{})
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestHandler_IndexIntrospection(t *testing.T) {
+	Convey("OBJECTS - INDEX INTROSPECTION: cannot inspect an index without a collection id, an object id or a token, with an out of range count or if user is not admin", t, func() {
+		setup()
+		router := DefaultRouter()
+		h := router.GetHandler()
+
+		_, err := h.ObjectTokens(baseContext(), "", "n1", ObjectTokensOptions{})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.ObjectTokens(baseContext(), "some-collection-id", "", ObjectTokensOptions{})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.TokenDocuments(baseContext(), "some-collection-id", "", TokenDocumentsOptions{})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.TokenDocuments(baseContext(), "some-collection-id", "juventus", TokenDocumentsOptions{Count: se.MaxTokenDocuments + 1})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.Analyze(baseContext(), "", "juventus", AnalyzeOptions{})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.ExplainSearch(baseContext(), "some-collection-id", nil, ExplainSearchOptions{})
+		So(err, ShouldNotBeNil)
+
+		pirloCtx := userContextFromRegisteredApplication(baseContext(), "pirlo")
+
+		_, err = h.ObjectTokens(pirloCtx, "some-collection-id", "n1", ObjectTokensOptions{})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.TokenDocuments(pirloCtx, "some-collection-id", "juventus", TokenDocumentsOptions{})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.Analyze(pirloCtx, "some-collection-id", "juventus", AnalyzeOptions{})
+		So(err, ShouldNotBeNil)
+
+		_, err = h.ExplainSearch(pirloCtx, "some-collection-id", &pb.SearchQuery{}, ExplainSearchOptions{})
+		So(err, ShouldNotBeNil)
+	})
+}
+
 func TestHandler_DeleteObject1(t *testing.T) {
 	Convey("OBJECTS - DELETE: cannot delete if one the followings parameters is not provided: collection-id, object-id", t, func() {
 		setup()
@@ -9,8 +9,10 @@ import (
 	"github.com/omecodes/libome/logs"
 	"github.com/omecodes/store/common"
 	pb "github.com/omecodes/store/gen/go/proto"
+	se "github.com/omecodes/store/search-engine"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -18,6 +20,38 @@ const (
 	queryOffset = "offset"
 	queryAt     = "at"
 	queryHeader = "header"
+	queryQ      = "q"
+
+	queryWaitIndexed = "wait_indexed"
+
+	queryHighlight      = "highlight"
+	queryFragmentSize   = "fragment_size"
+	querySortByDistance = "sort_by_distance"
+	queryFacet          = "facet"
+	queryCollection     = "collection"
+	queryAnalyzer       = "analyzer"
+
+	queryField       = "field"
+	queryPrefix      = "prefix"
+	querySize        = "size"
+	queryMaxDistance = "max_distance"
+
+	queryRepair    = "repair"
+	queryBatchSize = "batch_size"
+
+	queryAfter = "after"
+	queryCount = "count"
+	queryText  = "text"
+
+	querySortBy        = "sort_by"
+	queryUpdatedBy     = "updated_by"
+	queryUpdatedAfter  = "updated_after"
+	queryUpdatedBefore = "updated_before"
+	queryCreatedBy     = "created_by"
+	queryCreatedAfter  = "created_after"
+	queryCreatedBefore = "created_before"
+	queryMinSize       = "min_size"
+	queryMaxSize       = "max_size"
 )
 
 func MuxRouter(middleware ...mux.MiddlewareFunc) http.Handler {
@@ -27,6 +61,12 @@ func MuxRouter(middleware ...mux.MiddlewareFunc) http.Handler {
 	r.Name("ListCollections").Methods(http.MethodGet).Path(common.ApiListCollectionRoute).Handler(http.HandlerFunc(HTTPHandleListCollections))
 	r.Name("DeleteCollection").Methods(http.MethodGet).Path(common.ApiDeleteCollectionRoute).Handler(http.HandlerFunc(HTTPHandleDeleteCollection))
 	r.Name("GetCollection").Methods(http.MethodGet).Path(common.ApiGetCollectionRoute).Handler(http.HandlerFunc(HTTPHandleGetCollection))
+	r.Name("DryRunWriteRules").Methods(http.MethodPost).Path(common.ApiDryRunWriteRulesRoute).Handler(http.HandlerFunc(HTTPHandleDryRunWriteRules))
+	r.Name("CheckIndex").Methods(http.MethodPost).Path(common.ApiCheckIndexRoute).Handler(http.HandlerFunc(HTTPHandleCheckIndex))
+	r.Name("ObjectTokens").Methods(http.MethodGet).Path(common.ApiObjectTokensRoute).Handler(http.HandlerFunc(HTTPHandleObjectTokens))
+	r.Name("TokenDocuments").Methods(http.MethodGet).Path(common.ApiTokenDocumentsRoute).Handler(http.HandlerFunc(HTTPHandleTokenDocuments))
+	r.Name("Analyze").Methods(http.MethodGet).Path(common.ApiAnalyzeRoute).Handler(http.HandlerFunc(HTTPHandleAnalyze))
+	r.Name("ExplainSearch").Methods(http.MethodPost).Path(common.ApiExplainSearchRoute).Handler(http.HandlerFunc(HTTPHandleExplainSearch))
 
 	r.Name("PutObject").Methods(http.MethodPut).Path(common.ApiPutObjectRoute).Handler(http.HandlerFunc(HTTPHandlePutObject))
 	r.Name("PatchObject").Methods(http.MethodPatch).Path(common.ApiPatchObjectRoute).Handler(http.HandlerFunc(HTTPHandlePatchObject))
@@ -35,6 +75,12 @@ func MuxRouter(middleware ...mux.MiddlewareFunc) http.Handler {
 	r.Name("DeleteObject").Methods(http.MethodDelete).Path(common.ApiDeleteObjectRoute).Handler(http.HandlerFunc(HTTPHandleDeleteObject))
 	r.Name("ListObjects").Methods(http.MethodGet).Path(common.ApiListObjectsRoute).Handler(http.HandlerFunc(HTTPHandleListObjects))
 	r.Name("SearchObjects").Methods(http.MethodPost).Path(common.ApiSearchObjectsRoute).Handler(http.HandlerFunc(HTTPHandleSearchObjects))
+	r.Name("Suggest").Methods(http.MethodGet).Path(common.ApiSuggestRoute).Handler(http.HandlerFunc(HTTPHandleSuggest))
+	r.Name("SearchCollections").Methods(http.MethodPost).Path(common.ApiSearchCollectionsRoute).Handler(http.HandlerFunc(HTTPHandleSearchCollections))
+
+	r.Name("SaveSynonymSet").Methods(http.MethodPut).Path(common.ApiSaveSynonymSetRoute).Handler(http.HandlerFunc(HTTPHandleSaveSynonymSet))
+	r.Name("ListSynonymSets").Methods(http.MethodGet).Path(common.ApiListSynonymSetsRoute).Handler(http.HandlerFunc(HTTPHandleListSynonymSets))
+	r.Name("DeleteSynonymSet").Methods(http.MethodDelete).Path(common.ApiDeleteSynonymSetRoute).Handler(http.HandlerFunc(HTTPHandleDeleteSynonymSet))
 
 	var h http.Handler
 	h = r
@@ -69,13 +115,19 @@ func HTTPHandlePutObject(w http.ResponseWriter, r *http.Request) {
 	}
 	putRequest.Object.Header.Size = int64(len(putRequest.Object.Data))
 
-	id, err := PutObject(ctx, collection, putRequest.Object, putRequest.ActionAuthorizedUsers, putRequest.Indexes, PutOptions{})
-	if err != nil {
+	id, err := PutObject(ctx, collection, putRequest.Object, putRequest.ActionAuthorizedUsers, putRequest.Indexes, PutOptions{
+		WaitIndexed: putRequest.WaitIndexed || r.URL.Query().Get(queryWaitIndexed) == "true",
+	})
+	if err != nil && !IsNotIndexed(err) {
 		w.WriteHeader(errors.HTTPStatus(err))
 		return
 	}
 
+	// an object saved but not searchable yet is accepted
 	w.Header().Add(common.HttpHeaderContentType, common.ContentTypeJSON)
+	if err != nil {
+		w.WriteHeader(http.StatusAccepted)
+	}
 	_, _ = w.Write([]byte(fmt.Sprintf("{\"id\": \"%s\"}", id)))
 }
 
@@ -168,7 +220,13 @@ func HTTPHandleDeleteObject(w http.ResponseWriter, r *http.Request) {
 	collection := vars[common.ApiRouteVarCollectionName]
 	id := vars[common.ApiRouteVarIdName]
 
-	err := DeleteObject(ctx, collection, id, DeleteObjectOptions{})
+	err := DeleteObject(ctx, collection, id, DeleteObjectOptions{
+		WaitIndexed: r.URL.Query().Get(queryWaitIndexed) == "true",
+	})
+	if IsNotIndexed(err) {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
 	if err != nil {
 		w.WriteHeader(errors.HTTPStatus(err))
 		return
@@ -186,14 +244,28 @@ func HTTPHandleListObjects(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	collection := vars[common.ApiRouteVarCollectionName]
 
-	opts.Offset, err = common.Int64QueryParam(r, queryOffset)
-	if err != nil {
-		logs.Error("could not parse param 'before'")
-		w.WriteHeader(http.StatusBadRequest)
-		return
+	int64Params := map[string]*int64{
+		queryOffset:        &opts.Offset,
+		queryUpdatedAfter:  &opts.UpdatedAfter,
+		queryUpdatedBefore: &opts.UpdatedBefore,
+		queryCreatedAfter:  &opts.CreatedAfter,
+		queryCreatedBefore: &opts.CreatedBefore,
+		queryMinSize:       &opts.MinSize,
+		queryMaxSize:       &opts.MaxSize,
+	}
+	for name, value := range int64Params {
+		*value, err = common.Int64QueryParam(r, name)
+		if err != nil {
+			logs.Error("could not parse integer param", logs.Details("name", name))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
 	}
 
 	opts.At = r.URL.Query().Get(queryAt)
+	opts.SortBy = r.URL.Query().Get(querySortBy)
+	opts.UpdatedBy = r.URL.Query().Get(queryUpdatedBy)
+	opts.CreatedBy = r.URL.Query().Get(queryCreatedBy)
 
 	cursor, err := ListObjects(ctx, collection, opts)
 	if err != nil {
@@ -261,28 +333,174 @@ func HTTPHandleListObjects(w http.ResponseWriter, r *http.Request) {
 func HTTPHandleSearchObjects(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var query pb.SearchQuery
-	err := jsonpb.Unmarshal(r.Body, &query)
+	query, ok := httpSearchQuery(w, r)
+	if !ok {
+		return
+	}
+
+	vars := mux.Vars(r)
+	collection := vars[common.ApiRouteVarCollectionName]
+
+	opts, ok := httpSearchOptions(w, r)
+	if !ok {
+		return
+	}
+
+	for _, text := range r.URL.Query()[queryFacet] {
+		facet, err := se.ParseFacet(text)
+		if err != nil {
+			logs.Error("could not parse facet", logs.Details("facet", text), logs.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		opts.Facets = append(opts.Facets, facet)
+	}
+
+	cursor, err := SearchObjects(ctx, collection, query, opts)
 	if err != nil {
-		logs.Error("could not parse search query")
-		w.WriteHeader(http.StatusBadRequest)
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+	httpWriteSearchResults(w, r, cursor)
+}
+
+// HTTPHandleSearchCollections searches the collections passed as collection query params, or all collections when
+// there is none
+func HTTPHandleSearchCollections(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query, ok := httpSearchQuery(w, r)
+	if !ok {
 		return
 	}
 
+	opts, ok := httpSearchOptions(w, r)
+	if !ok {
+		return
+	}
+
+	cursor, err := SearchCollections(ctx, r.URL.Query()[queryCollection], query, opts)
+	if err != nil {
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+	httpWriteSearchResults(w, r, cursor)
+}
+
+// HTTPHandleSuggest returns the terms of the text index aliased by the field param that complete the prefix param
+func HTTPHandleSuggest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
 	vars := mux.Vars(r)
 	collection := vars[common.ApiRouteVarCollectionName]
 
-	cursor, err := SearchObjects(ctx, collection, &query, SearchObjectsOptions{})
+	var (
+		err  error
+		opts SuggestOptions
+	)
+	opts.Size, err = common.Int64QueryParam(r, querySize)
+	if err != nil {
+		logs.Error("could not parse integer param", logs.Details("name", querySize))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	opts.MaxDistance, err = common.Int64QueryParam(r, queryMaxDistance)
+	if err != nil {
+		logs.Error("could not parse integer param", logs.Details("name", queryMaxDistance))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	suggestions, err := Suggest(ctx, collection, r.URL.Query().Get(queryField), r.URL.Query().Get(queryPrefix), opts)
 	if err != nil {
 		w.WriteHeader(errors.HTTPStatus(err))
 		return
 	}
+
+	w.Header().Add(common.HttpHeaderContentType, common.ContentTypeJSON)
+	if suggestions == nil {
+		_, _ = w.Write([]byte("[]"))
+		return
+	}
+
+	data, err := json.Marshal(suggestions)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+// httpSearchQuery reads the search query of r from the q param or from the body. It writes the error response and
+// returns false when the query cannot be parsed
+func httpSearchQuery(w http.ResponseWriter, r *http.Request) (*pb.SearchQuery, bool) {
+	if text := r.URL.Query().Get(queryQ); text != "" {
+		query, err := se.ParseQuery(text)
+		if err != nil {
+			logs.Error("could not parse search query", logs.Err(err))
+			w.Header().Set(common.HttpHeaderContentType, "text/plain")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return nil, false
+		}
+		return query, true
+	}
+
+	query := new(pb.SearchQuery)
+	err := jsonpb.Unmarshal(r.Body, query)
+	if err != nil {
+		logs.Error("could not parse search query")
+		w.WriteHeader(http.StatusBadRequest)
+		return nil, false
+	}
+	return query, true
+}
+
+// httpSearchOptions reads the highlighting and distance sorting params of r. The point objects are sorted by distance
+// to is passed as "lat,lon". It writes the error response and returns false when they cannot be parsed
+func httpSearchOptions(w http.ResponseWriter, r *http.Request) (SearchObjectsOptions, bool) {
+	var err error
+	opts := SearchObjectsOptions{Highlight: r.URL.Query().Get(queryHighlight) == "true"}
+	opts.FragmentSize, err = common.Int64QueryParam(r, queryFragmentSize)
+	if err != nil {
+		logs.Error("could not parse integer param", logs.Details("name", queryFragmentSize))
+		w.WriteHeader(http.StatusBadRequest)
+		return opts, false
+	}
+
+	if origin := r.URL.Query().Get(querySortByDistance); origin != "" {
+		coordinates := strings.Split(origin, ",")
+		if len(coordinates) != 2 {
+			logs.Error("could not parse point param", logs.Details("name", querySortByDistance))
+			w.WriteHeader(http.StatusBadRequest)
+			return opts, false
+		}
+
+		opts.SortByDistance = &pb.GeoPoint{}
+		opts.SortByDistance.Lat, err = strconv.ParseFloat(strings.TrimSpace(coordinates[0]), 64)
+		if err == nil {
+			opts.SortByDistance.Lon, err = strconv.ParseFloat(strings.TrimSpace(coordinates[1]), 64)
+		}
+		if err != nil {
+			logs.Error("could not parse point param", logs.Details("name", querySortByDistance), logs.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			return opts, false
+		}
+	}
+	return opts, true
+}
+
+// httpWriteSearchResults writes the objects of cursor as a JSON array, or as a JSON stream if the client accepts it,
+// and closes cursor
+func httpWriteSearchResults(w http.ResponseWriter, r *http.Request, cursor *Cursor) {
 	defer func() {
 		if cErr := cursor.Close(); cErr != nil {
 			logs.Error("cursor closed with an error", logs.Err(cErr))
 		}
 	}()
 
+	var err error
 	accept := r.Header.Get(common.HttpHeaderAccept)
 	acceptsJsonStream := strings.Contains(accept, common.ContentTypeJSONStream)
 	if acceptsJsonStream {
@@ -399,6 +617,288 @@ func HTTPHandleGetCollection(w http.ResponseWriter, r *http.Request) {
 	_, _ = w.Write(data)
 }
 
+func HTTPHandleSaveSynonymSet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var set *pb.SynonymSet
+	err := json.NewDecoder(r.Body).Decode(&set)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = SaveSynonymSet(ctx, set, SaveSynonymSetOptions{})
+	if err != nil {
+		logs.Error("could not save synonym set", logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+}
+
+// HTTPHandleListSynonymSets lists the synonym sets that apply to the collection param, or that are scoped to the
+// analyzer param
+func HTTPHandleListSynonymSets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	sets, err := ListSynonymSets(ctx, ListSynonymSetsOptions{
+		Collection: r.URL.Query().Get(queryCollection),
+		Analyzer:   r.URL.Query().Get(queryAnalyzer),
+	})
+	if err != nil {
+		logs.Error("could not load synonym sets", logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+
+	w.Header().Add(common.HttpHeaderContentType, common.ContentTypeJSON)
+	if sets == nil {
+		_, _ = w.Write([]byte("[]"))
+		return
+	}
+
+	data, err := json.Marshal(sets)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+func HTTPHandleDeleteSynonymSet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars[common.ApiRouteVarIdName]
+
+	err := DeleteSynonymSet(ctx, id, DeleteSynonymSetOptions{})
+	if err != nil {
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+}
+
+// HTTPHandleCheckIndex compares the search index of a collection to its objects and writes the report.
+// The differences are repaired when the repair param is true
+func HTTPHandleCheckIndex(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	collection := vars[common.ApiRouteVarIdName]
+
+	var (
+		err  error
+		opts CheckIndexOptions
+	)
+	opts.Repair = r.URL.Query().Get(queryRepair) == "true"
+	opts.BatchSize, err = common.Int64QueryParam(r, queryBatchSize)
+	if err != nil {
+		logs.Error("could not parse integer param", logs.Details("name", queryBatchSize))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	report, err := CheckIndex(ctx, collection, opts)
+	if err != nil {
+		logs.Error("could not check index", logs.Details("collection", collection), logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Add(common.HttpHeaderContentType, common.ContentTypeJSON)
+	_, _ = w.Write(data)
+}
+
+// HTTPHandleObjectTokens writes the tokens the search index of a collection stores for an object, by field
+func HTTPHandleObjectTokens(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	collection := vars[common.ApiRouteVarIdName]
+	id := vars[common.ApiRouteVarObjectName]
+
+	fields, err := ObjectTokens(ctx, collection, id, ObjectTokensOptions{})
+	if err != nil {
+		logs.Error("could not load object tokens", logs.Details("collection", collection), logs.Details("id", id), logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+
+	w.Header().Add(common.HttpHeaderContentType, common.ContentTypeJSON)
+	if fields == nil {
+		_, _ = w.Write([]byte("[]"))
+		return
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+// HTTPHandleTokenDocuments writes the postings of a token in the search index of a collection. The field param
+// restricts them to a text index alias, the after and count params page them by object id
+func HTTPHandleTokenDocuments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	collection := vars[common.ApiRouteVarIdName]
+	token := vars[common.ApiRouteVarTokenName]
+
+	var (
+		err  error
+		opts TokenDocumentsOptions
+	)
+	opts.Field = r.URL.Query().Get(queryField)
+	opts.After = r.URL.Query().Get(queryAfter)
+	opts.Count, err = common.Int64QueryParam(r, queryCount)
+	if err != nil {
+		logs.Error("could not parse integer param", logs.Details("name", queryCount))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	postings, err := TokenDocuments(ctx, collection, token, opts)
+	if err != nil {
+		logs.Error("could not load token postings", logs.Details("collection", collection), logs.Details("token", token), logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+
+	w.Header().Add(common.HttpHeaderContentType, common.ContentTypeJSON)
+	if postings == nil {
+		_, _ = w.Write([]byte("[]"))
+		return
+	}
+
+	data, err := json.Marshal(postings)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+// HTTPHandleAnalyze writes the tokens the text param is analyzed as by the analyzer param
+func HTTPHandleAnalyze(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	collection := vars[common.ApiRouteVarIdName]
+
+	tokens, err := Analyze(ctx, collection, r.URL.Query().Get(queryText), AnalyzeOptions{Analyzer: r.URL.Query().Get(queryAnalyzer)})
+	if err != nil {
+		logs.Error("could not analyze text", logs.Details("collection", collection), logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+
+	w.Header().Add(common.HttpHeaderContentType, common.ContentTypeJSON)
+	if tokens == nil {
+		_, _ = w.Write([]byte("[]"))
+		return
+	}
+
+	data, err := json.Marshal(tokens)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+// HTTPHandleExplainSearch evaluates the search query of the q param or of the body on a collection and writes how it
+// was compiled and scored
+func HTTPHandleExplainSearch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	collection := vars[common.ApiRouteVarIdName]
+
+	query, ok := httpSearchQuery(w, r)
+	if !ok {
+		return
+	}
+
+	explanation, err := ExplainSearch(ctx, collection, query, ExplainSearchOptions{})
+	if err != nil {
+		logs.Error("could not explain search", logs.Details("collection", collection), logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+
+	data, err := json.Marshal(explanation)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Add(common.HttpHeaderContentType, common.ContentTypeJSON)
+	_, _ = w.Write(data)
+}
+
+// DryRunRequest holds an object data to evaluate with write rules.
+// When Rules is empty, the rules of the collection are used
+type DryRunRequest struct {
+	Data  string          `json:"data"`
+	Rules []*pb.WriteRule `json:"rules,omitempty"`
+}
+
+func HTTPHandleDryRunWriteRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars[common.ApiRouteVarIdName]
+
+	var req *DryRunRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil || req == nil || req.Data == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	collection, err := GetCollection(ctx, id, GetCollectionOptions{})
+	if err != nil {
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+
+	// trying rules requires the permission to define them
+	err = assertCollectionsEditor(ctx)
+	if err != nil {
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+
+	rules := req.Rules
+	if len(rules) == 0 {
+		rules = collection.WriteRules
+
+	} else if err = ValidateWriteRules(rules); err != nil {
+		logs.Error("invalid write rules", logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+
+	result, err := EvaluateWriteRules(ctx, rules, req.Data)
+	if err != nil {
+		logs.Error("write rules evaluation failed", logs.Details("col-id", id), logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+
+	w.Header().Add(common.HttpHeaderContentType, common.ContentTypeJSON)
+	err = json.NewEncoder(w).Encode(result)
+	if err != nil {
+		logs.Error("could not send write rules result", logs.Err(err))
+	}
+}
+
 func HTTPHandleDeleteCollection(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
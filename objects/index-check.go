@@ -0,0 +1,407 @@
+package objects
+
+import (
+	"context"
+	"fmt"
+	"github.com/omecodes/bome"
+	"github.com/omecodes/errors"
+	"github.com/omecodes/libome/logs"
+	pb "github.com/omecodes/store/gen/go/proto"
+	se "github.com/omecodes/store/search-engine"
+	"strings"
+)
+
+const (
+	// DefaultIndexCheckBatchSize is the number of ids an index check looks at at once
+	DefaultIndexCheckBatchSize = 100
+
+	// maxReportedIDs is the number of ids of each kind an index report lists
+	maxReportedIDs = 100
+)
+
+// CheckIndex compares the search index to the objects by batches of ids. It first lists the indexed ids to find the
+// ones whose object is missing, then the objects to find the ones that have indexed values but no mapping.
+// Differences are confirmed once the changes queued for their objects are applied, so that objects being indexed are
+// not reported. Each batch is read, and repaired, in its own transactions: the collection stays writable during the
+// check. Repairs go through the outbox like object writes: orphans get a deletion and unmapped objects are indexed
+// from their current data
+func (s *sqlCollection) CheckIndex(ctx context.Context, opts CheckIndexOptions) (*pb.IndexReport, error) {
+	batchSize := int(opts.BatchSize)
+	if batchSize <= 0 {
+		batchSize = DefaultIndexCheckBatchSize
+	}
+
+	report := &pb.IndexReport{Collection: s.info.Id}
+
+	for after := ""; ; {
+		ids, err := s.engine.MappedIDs(after, batchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+		after = ids[len(ids)-1]
+		report.Mapped += int64(len(ids))
+
+		orphans, err := s.orphans(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		if len(orphans) == 0 {
+			continue
+		}
+
+		report.OrphansCount += int64(len(orphans))
+		report.Orphans = appendReported(report.Orphans, orphans)
+
+		if opts.Repair {
+			repaired, err := s.deleteOrphanMappings(ctx, orphans)
+			if err != nil {
+				return nil, err
+			}
+			report.Repaired += int64(repaired)
+		}
+	}
+
+	for after := ""; ; {
+		objects, err := s.objectsAfter(after, batchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(objects) == 0 {
+			break
+		}
+		after = objects[len(objects)-1].Header.Id
+		report.Objects += int64(len(objects))
+
+		// feeds are only built to tell the objects that have indexed values: types detected from them are not recorded
+		var indexed []string
+		for _, o := range objects {
+			feeds, err := s.indexFeeds(o)
+			if err != nil {
+				logs.Error("CheckIndex: object values cannot be indexed", logs.Details("id", o.Header.Id), logs.Err(err))
+				continue
+			}
+			if len(feeds) > 0 {
+				indexed = append(indexed, o.Header.Id)
+			}
+		}
+
+		unmapped, err := s.unmapped(ctx, indexed)
+		if err != nil {
+			return nil, err
+		}
+		if len(unmapped) == 0 {
+			continue
+		}
+
+		report.UnmappedCount += int64(len(unmapped))
+		report.Unmapped = appendReported(report.Unmapped, unmapped)
+
+		if opts.Repair {
+			repaired, err := s.reindex(ctx, unmapped)
+			if err != nil {
+				return nil, err
+			}
+			report.Repaired += int64(repaired)
+		}
+	}
+
+	logs.Info("CheckIndex: index checked", logs.Details("collection", s.info.Id), logs.Details("orphans", report.OrphansCount), logs.Details("unmapped", report.UnmappedCount), logs.Details("repaired", report.Repaired))
+	return report, nil
+}
+
+// orphans returns the ids among the indexed ids that have no object, and still have mappings once the changes queued
+// for them are applied
+func (s *sqlCollection) orphans(ctx context.Context, ids []string) ([]string, error) {
+	missing, err := s.missingObjects(ids)
+	if err != nil || len(missing) == 0 {
+		return nil, err
+	}
+
+	err = s.waitIndexed(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	unmapped, err := s.engine.UnmappedIDs(missing)
+	if err != nil {
+		return nil, err
+	}
+	missing = without(missing, unmapped)
+
+	created, err := s.existingObjects(missing)
+	if err != nil {
+		return nil, err
+	}
+	return without(missing, created), nil
+}
+
+// unmapped returns the ids among the ids of objects that have indexed values that have no mapping, and still exist
+// without mappings once the changes queued for them are applied
+func (s *sqlCollection) unmapped(ctx context.Context, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	unmapped, err := s.engine.UnmappedIDs(ids)
+	if err != nil || len(unmapped) == 0 {
+		return nil, err
+	}
+
+	err = s.waitIndexed(ctx, unmapped)
+	if err != nil {
+		return nil, err
+	}
+
+	unmapped, err = s.engine.UnmappedIDs(unmapped)
+	if err != nil || len(unmapped) == 0 {
+		return nil, err
+	}
+
+	deleted, err := s.missingObjects(unmapped)
+	if err != nil {
+		return nil, err
+	}
+	return without(unmapped, deleted), nil
+}
+
+// waitIndexed returns when the changes queued for the objects of ids are applied or dropped
+func (s *sqlCollection) waitIndexed(ctx context.Context, ids []string) error {
+	err := s.indexer.wait(ctx, ids...)
+	if err != nil && errors.IsServiceUnavailable(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteOrphanMappings queues the deletion of the mappings of ids whose object still does not exist, in a single
+// transaction. It returns the number of queued deletions
+func (s *sqlCollection) deleteOrphanMappings(ctx context.Context, ids []string) (int, error) {
+	txCtx, objects, err := s.objects.Transaction(ctx)
+	if err != nil {
+		logs.Error("CheckIndex: could not start objects DB transaction", logs.Err(err))
+		return 0, errors.Internal("database transaction initialization")
+	}
+
+	queued := 0
+	for _, id := range ids {
+		_, err = objects.Get(id)
+		if err == nil {
+			continue
+		}
+		if !errors.IsNotFound(err) {
+			logs.Error("CheckIndex: could not check object", logs.Details("id", id), logs.Err(err))
+			if err := bome.Rollback(txCtx); err != nil {
+				logs.Error("CheckIndex: rollback failed", logs.Err(err))
+			}
+			return 0, errors.Internal("could not check object")
+		}
+
+		msg := &pb.MessageFeed{Message: &pb.MessageFeed_Delete{Delete: &pb.ObjectDeletedNotification{Id: id}}}
+		err = s.indexer.enqueue(txCtx, id, msg)
+		if err != nil {
+			logs.Error("CheckIndex: failed to queue index mappings deletion", logs.Details("id", id), logs.Err(err))
+			if err := bome.Rollback(txCtx); err != nil {
+				logs.Error("CheckIndex: rollback failed", logs.Err(err))
+			}
+			return 0, errors.Internal("could not repair index")
+		}
+		queued++
+	}
+
+	err = bome.Commit(txCtx)
+	if err != nil {
+		logs.Error("CheckIndex: operations commit failed", logs.Err(err))
+		return 0, errors.Internal("database transaction commit error")
+	}
+	s.indexer.notify()
+	return queued, nil
+}
+
+// reindex queues the mappings of the current data of the objects of ids that still exist, in a single transaction.
+// It returns the number of objects indexed again
+func (s *sqlCollection) reindex(ctx context.Context, ids []string) (int, error) {
+	txCtx, objects, err := s.objects.Transaction(ctx)
+	if err != nil {
+		logs.Error("CheckIndex: could not start objects DB transaction", logs.Err(err))
+		return 0, errors.Internal("database transaction initialization")
+	}
+
+	queued := 0
+	for _, id := range ids {
+		entry, err := objects.Get(id)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			logs.Error("CheckIndex: could not load object", logs.Details("id", id), logs.Err(err))
+			if err := bome.Rollback(txCtx); err != nil {
+				logs.Error("CheckIndex: rollback failed", logs.Err(err))
+			}
+			return 0, errors.Internal("could not load object")
+		}
+
+		object := &pb.Object{Header: &pb.Header{Id: id}, Data: entry.Value}
+		err = s.detectTypes(object)
+		if err != nil {
+			logs.Error("CheckIndex: could not record the types of the indexed values", logs.Details("id", id), logs.Err(err))
+			if err := bome.Rollback(txCtx); err != nil {
+				logs.Error("CheckIndex: rollback failed", logs.Err(err))
+			}
+			return 0, err
+		}
+
+		feeds, err := s.indexFeeds(object)
+		if err != nil {
+			logs.Error("CheckIndex: object values cannot be indexed", logs.Details("id", id), logs.Err(err))
+			continue
+		}
+
+		for _, feed := range feeds {
+			err = s.indexer.enqueue(txCtx, id, feed)
+			if err != nil {
+				logs.Error("CheckIndex: failed to queue index mapping", logs.Details("id", id), logs.Err(err))
+				if err := bome.Rollback(txCtx); err != nil {
+					logs.Error("CheckIndex: rollback failed", logs.Err(err))
+				}
+				return 0, errors.Internal("could not repair index")
+			}
+		}
+		queued++
+	}
+
+	err = bome.Commit(txCtx)
+	if err != nil {
+		logs.Error("CheckIndex: operations commit failed", logs.Err(err))
+		return 0, errors.Internal("database transaction commit error")
+	}
+	s.indexer.notify()
+	return queued, nil
+}
+
+// reindexAll queues the mappings of the current data of all the objects, by batches of DefaultIndexCheckBatchSize
+func (s *sqlCollection) reindexAll(ctx context.Context) error {
+	for after := ""; ; {
+		objects, err := s.objectsAfter(after, DefaultIndexCheckBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(objects) == 0 {
+			return nil
+		}
+		after = objects[len(objects)-1].Header.Id
+
+		ids := make([]string, len(objects))
+		for ind, o := range objects {
+			ids[ind] = o.Header.Id
+		}
+
+		_, err = s.reindex(ctx, ids)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// objectsAfter returns at most count objects whose id is greater than after, in increasing id order
+func (s *sqlCollection) objectsAfter(after string, count int) ([]*pb.Object, error) {
+	sqlQuery := fmt.Sprintf("select headers.value as header, objects.value as object from %s as headers, %s as objects where headers.name=objects.name and objects.name > ? order by objects.name limit ?",
+		s.headers.Table(), s.objects.Table())
+	cursor, err := s.objects.Query(sqlQuery, objectScanner, after, count)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := cursor.Close(); cerr != nil {
+			logs.Error("CheckIndex: cursor closed with an error", logs.Err(cerr))
+		}
+	}()
+
+	var objects []*pb.Object
+	for cursor.HasNext() {
+		o, err := cursor.Next()
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, o.(*pb.Object))
+	}
+	return objects, nil
+}
+
+// existingObjects returns the ids among ids that have an object. The objects are looked up by batches of
+// se.MappingScanBatchSize ids
+func (s *sqlCollection) existingObjects(ids []string) ([]string, error) {
+	var existing []string
+	for start := 0; start < len(ids); start += se.MappingScanBatchSize {
+		batch := ids[start:]
+		if len(batch) > se.MappingScanBatchSize {
+			batch = batch[:se.MappingScanBatchSize]
+		}
+
+		params := make([]interface{}, len(batch))
+		for ind, id := range batch {
+			params[ind] = id
+		}
+
+		sqlQuery := fmt.Sprintf("select name from %s where name in (%s)", s.objects.Table(), strings.TrimSuffix(strings.Repeat("?, ", len(batch)), ", "))
+		cursor, err := s.objects.Query(sqlQuery, bome.StringScanner, params...)
+		if err != nil {
+			return nil, err
+		}
+
+		for cursor.HasNext() {
+			o, err := cursor.Next()
+			if err != nil {
+				_ = cursor.Close()
+				return nil, err
+			}
+			existing = append(existing, o.(string))
+		}
+		if err = cursor.Close(); err != nil {
+			return nil, err
+		}
+	}
+	return existing, nil
+}
+
+// missingObjects returns the ids among ids that have no object
+func (s *sqlCollection) missingObjects(ids []string) ([]string, error) {
+	existing, err := s.existingObjects(ids)
+	if err != nil {
+		return nil, err
+	}
+	return without(ids, existing), nil
+}
+
+// without returns the ids that are not in excluded
+func without(ids []string, excluded []string) []string {
+	if len(excluded) == 0 {
+		return ids
+	}
+
+	set := map[string]bool{}
+	for _, id := range excluded {
+		set[id] = true
+	}
+
+	var kept []string
+	for _, id := range ids {
+		if !set[id] {
+			kept = append(kept, id)
+		}
+	}
+	return kept
+}
+
+// appendReported appends ids to reported, up to maxReportedIDs of them
+func appendReported(reported []string, ids []string) []string {
+	for _, id := range ids {
+		if len(reported) == maxReportedIDs {
+			break
+		}
+		reported = append(reported, id)
+	}
+	return reported
+}
@@ -0,0 +1,600 @@
+package objects
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/google/uuid"
+	"github.com/omecodes/bome"
+	"github.com/omecodes/errors"
+	"github.com/omecodes/libome/logs"
+	"github.com/omecodes/store/common/utime"
+	pb "github.com/omecodes/store/gen/go/proto"
+	se "github.com/omecodes/store/search-engine"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultIndexingBatchSize is the number of outbox entries the indexing worker loads at once
+	DefaultIndexingBatchSize = 100
+
+	// DefaultIndexingMaxAttempts is the number of times the indexing worker tries to apply an entry before dropping it
+	DefaultIndexingMaxAttempts = 10
+
+	// DefaultIndexingRetryDelay is the delay before the first retry of a failed entry. It doubles at each retry
+	DefaultIndexingRetryDelay = 100 * time.Millisecond
+
+	// DefaultIndexingMaxRetryDelay is the maximum delay between two attempts to apply an entry
+	DefaultIndexingMaxRetryDelay = 30 * time.Second
+
+	// DefaultIndexingWaitTimeout is the maximum time writes made with index visibility wait for their changes
+	DefaultIndexingWaitTimeout = 10 * time.Second
+
+	// indexingPollInterval is the interval at which the indexing worker looks for entries it was not notified of,
+	// like the ones left by a previous run of the process or by the workers of other processes
+	indexingPollInterval = 5 * time.Second
+
+	// indexingLeaseDuration is the time the lease of an outbox is held without being renewed. Renewals happen at
+	// each pass, the poll interval bounds their spacing
+	indexingLeaseDuration = 30 * time.Second
+
+	// indexingLeaseName is the key of the lease row of the outbox lease tables
+	indexingLeaseName = "worker"
+)
+
+// IndexingOptions tunes the workers that apply the outbox entries of collections to their search engine.
+// Zero values select the defaults
+type IndexingOptions struct {
+	// BatchSize is the number of entries loaded at once
+	BatchSize int
+
+	// MaxAttempts is the number of times an entry is tried before it is dropped
+	MaxAttempts int
+
+	// RetryDelay is the delay before the first retry of a failed entry. It doubles at each retry, up to MaxRetryDelay
+	RetryDelay time.Duration
+
+	// MaxRetryDelay is the maximum delay between two attempts to apply an entry
+	MaxRetryDelay time.Duration
+
+	// WaitTimeout is the maximum time writes made with index visibility wait for their changes to be applied
+	WaitTimeout time.Duration
+}
+
+// IndexingMetrics describes the state of the indexing of a collection
+type IndexingMetrics struct {
+	// Pending is the number of outbox entries waiting to be applied
+	Pending int64 `json:"pending"`
+
+	// Lag is the age of the oldest pending entry
+	Lag time.Duration `json:"lag"`
+
+	// Applied is the number of entries applied since the worker started
+	Applied int64 `json:"applied"`
+
+	// Retries is the number of failed attempts that were retried since the worker started
+	Retries int64 `json:"retries"`
+
+	// Dropped is the number of entries given up after MaxAttempts failed attempts since the worker started
+	Dropped int64 `json:"dropped"`
+}
+
+// IndexingMonitor is implemented by the objects DBs that index objects asynchronously
+type IndexingMonitor interface {
+	// IndexingMetrics returns the state of the indexing of collection
+	IndexingMetrics(ctx context.Context, collection string) (*IndexingMetrics, error)
+}
+
+type ctxIndexVisibility struct{}
+
+// ContextWithIndexVisibility makes writes done with the returned context wait until their changes are applied to the
+// search index, so that searches made after them find them
+func ContextWithIndexVisibility(parent context.Context) context.Context {
+	return context.WithValue(parent, ctxIndexVisibility{}, true)
+}
+
+func waitsIndexVisibility(ctx context.Context) bool {
+	wait, _ := ctx.Value(ctxIndexVisibility{}).(bool)
+	return wait
+}
+
+// NotIndexedError is returned by the writes made with index visibility that were committed, but whose changes were
+// not applied to the search index when the wait for them ended. The write is done: Err tells whether its changes are
+// still pending or were dropped
+type NotIndexedError struct {
+	ObjectID string
+	Err      error
+}
+
+func (e *NotIndexedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *NotIndexedError) Unwrap() error {
+	return e.Err
+}
+
+// indexStatusMetadata is the gRPC response header that tells a write is done but not searchable. Its value is the
+// message of the error
+const indexStatusMetadata = "not-indexed"
+
+// IsNotIndexed tells whether err reports a write that is done but whose changes are not searchable
+func IsNotIndexed(err error) bool {
+	var e *NotIndexedError
+	return goerrors.As(err, &e)
+}
+
+// outboxEntry is a change to apply to the search index of the objects of a collection
+type outboxEntry struct {
+	ObjectID  string          `json:"object_id"`
+	CreatedAt int64           `json:"created_at"`
+	Feed      json.RawMessage `json:"feed"`
+}
+
+// indexingWaiter is released when a pass over the outbox, started after it registered, leaves no entry of its object
+type indexingWaiter struct {
+	objectID string
+	pass     int64
+	dropped  bool
+	done     chan struct{}
+}
+
+// indexer applies the entries written in the outbox table of a collection by object transactions to the search
+// engine of the collection. Entries are applied in the order they were written. An entry that fails is retried
+// with an increasing delay, the following entries of the same object waiting for it, and is dropped after
+// MaxAttempts attempts. Applied entries are removed once the engine is flushed, so that changes the engine did not
+// store are applied again. Workers sharing an outbox, like the ones of replicas, take turns through a lease: only
+// its holder applies entries
+type indexer struct {
+	db     *sql.DB
+	outbox *bome.List
+	engine se.Service
+	opts   IndexingOptions
+
+	// leaseTable holds the lease of the outbox, owner identifies the worker in it
+	leaseTable string
+	owner      string
+
+	wake      chan struct{}
+	stop      chan struct{}
+	stopped   chan struct{}
+	closeOnce sync.Once
+
+	// flushFailures counts the consecutive failed flushes of the engine. It is only used by the worker
+	flushFailures int
+
+	// attempts and retryAt record the failed attempts to apply entries. They are only used by the worker
+	attempts map[int64]int
+	retryAt  map[int64]time.Time
+
+	mutex   sync.Mutex
+	pass    int64
+	waiters []*indexingWaiter
+
+	applied int64
+	retries int64
+	dropped int64
+}
+
+func newIndexer(db *sql.DB, dialect string, tableName string, engine se.Service, opts IndexingOptions) (*indexer, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultIndexingBatchSize
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = DefaultIndexingMaxAttempts
+	}
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = DefaultIndexingRetryDelay
+	}
+	if opts.MaxRetryDelay <= 0 {
+		opts.MaxRetryDelay = DefaultIndexingMaxRetryDelay
+	}
+	if opts.WaitTimeout <= 0 {
+		opts.WaitTimeout = DefaultIndexingWaitTimeout
+	}
+
+	outbox, err := bome.Build().
+		SetDialect(dialect).
+		SetConn(db).
+		SetTableName(tableName).
+		List()
+	if err != nil {
+		return nil, err
+	}
+
+	leaseTable := tableName + "_lease"
+	_, err = db.Exec(fmt.Sprintf("create table if not exists %s (name varchar(255) not null, owner varchar(255) not null, expires bigint not null, primary key(name))", leaseTable))
+	if err != nil {
+		return nil, err
+	}
+	_, err = db.Exec(fmt.Sprintf("insert into %s values(?, '', 0)", leaseTable), indexingLeaseName)
+	if err != nil && !errors.IsConflict(err) {
+		return nil, err
+	}
+
+	i := &indexer{
+		db:         db,
+		outbox:     outbox,
+		engine:     engine,
+		opts:       opts,
+		leaseTable: leaseTable,
+		owner:      uuid.New().String(),
+		wake:       make(chan struct{}, 1),
+		stop:       make(chan struct{}),
+		stopped:    make(chan struct{}),
+		attempts:   map[int64]int{},
+		retryAt:    map[int64]time.Time{},
+	}
+	go i.run()
+	return i, nil
+}
+
+// Close stops the worker once its current pass is done and gives up the lease of the outbox. Entries left in the
+// outbox are applied by the next worker
+func (i *indexer) Close() error {
+	i.closeOnce.Do(func() {
+		close(i.stop)
+	})
+	<-i.stopped
+
+	_, err := i.db.Exec(fmt.Sprintf("update %s set expires=0 where name=? and owner=?", i.leaseTable), indexingLeaseName, i.owner)
+	return err
+}
+
+// claim takes the lease of the outbox when it is free or expired, or renews it. It tells whether the worker holds it
+func (i *indexer) claim() (bool, error) {
+	now := utime.Now()
+	_, err := i.db.Exec(fmt.Sprintf("update %s set owner=?, expires=? where name=? and (owner=? or expires<?)", i.leaseTable),
+		i.owner, now+indexingLeaseDuration.Milliseconds(), indexingLeaseName, i.owner, now)
+	if err != nil {
+		return false, err
+	}
+
+	var owner string
+	err = i.db.QueryRow(fmt.Sprintf("select owner from %s where name=?", i.leaseTable), indexingLeaseName).Scan(&owner)
+	if err != nil {
+		return false, err
+	}
+	return owner == i.owner, nil
+}
+
+// enqueue writes msg in the outbox, in the transaction of ctx
+func (i *indexer) enqueue(ctx context.Context, objectID string, msg *pb.MessageFeed) error {
+	feed, err := (&jsonpb.Marshaler{}).MarshalToString(msg)
+	if err != nil {
+		return err
+	}
+
+	value, err := json.Marshal(&outboxEntry{ObjectID: objectID, CreatedAt: utime.Now(), Feed: json.RawMessage(feed)})
+	if err != nil {
+		return err
+	}
+
+	_, outbox, err := i.outbox.Transaction(ctx)
+	if err != nil {
+		return err
+	}
+	return outbox.Save(&bome.ListEntry{Value: string(value)})
+}
+
+// notify tells the worker that entries were committed
+func (i *indexer) notify() {
+	select {
+	case i.wake <- struct{}{}:
+	default:
+	}
+}
+
+// wait returns when the committed entries of the objects of objectIDs have been applied, or an error if one of them
+// was dropped. The objects are waited for together, released by the same passes. It waits at most WaitTimeout
+func (i *indexer) wait(ctx context.Context, objectIDs ...string) error {
+	ctx, cancel := context.WithTimeout(ctx, i.opts.WaitTimeout)
+	defer cancel()
+
+	i.mutex.Lock()
+	waiters := make([]*indexingWaiter, len(objectIDs))
+	for ind, objectID := range objectIDs {
+		waiters[ind] = &indexingWaiter{objectID: objectID, pass: i.pass, done: make(chan struct{})}
+	}
+	i.waiters = append(i.waiters, waiters...)
+	i.mutex.Unlock()
+
+	i.notify()
+
+	var dropped bool
+	for _, w := range waiters {
+		select {
+		case <-w.done:
+			dropped = dropped || w.dropped
+		case <-ctx.Done():
+			return errors.ServiceUnavailable("timed out waiting for changes to be applied to the search index")
+		case <-i.stopped:
+			return errors.ServiceUnavailable("search index worker is stopped")
+		}
+	}
+
+	if dropped {
+		return errors.Internal("could not apply changes to the search index")
+	}
+	return nil
+}
+
+func (i *indexer) run() {
+	defer close(i.stopped)
+
+	poll := time.NewTicker(indexingPollInterval)
+	defer poll.Stop()
+
+	for {
+		var retry <-chan time.Time
+		if delay := i.processPass(); delay > 0 {
+			retry = time.After(delay)
+		}
+
+		select {
+		case <-i.stop:
+			return
+		case <-i.wake:
+		case <-poll.C:
+		case <-retry:
+		}
+	}
+}
+
+// retryDelay returns the delay before the next attempt after attempts failed ones
+func (i *indexer) retryDelay(attempts int) time.Duration {
+	delay := i.opts.RetryDelay << uint(attempts-1)
+	if delay <= 0 || delay > i.opts.MaxRetryDelay {
+		delay = i.opts.MaxRetryDelay
+	}
+	return delay
+}
+
+// processPass applies the outbox entries that are not waiting for a retry, when the worker holds the lease of the
+// outbox. It returns the delay until the next retry, or zero when no entry is left
+func (i *indexer) processPass() time.Duration {
+	i.mutex.Lock()
+	i.pass++
+	pass := i.pass
+	i.mutex.Unlock()
+
+	holder, err := i.claim()
+	if err != nil {
+		logs.Error("indexing: could not claim the outbox", logs.Err(err))
+		return i.opts.MaxRetryDelay
+	}
+	if !holder {
+		return i.follow(pass)
+	}
+
+	var (
+		cursor    int64
+		nextRetry time.Time
+		pending   = map[string]bool{}
+		dropped   = map[string]bool{}
+		complete  = true
+	)
+
+	for complete {
+		entries, err := i.outbox.RangeFromIndex(cursor, 0, i.opts.BatchSize)
+		if err != nil {
+			logs.Error("indexing: could not load outbox entries", logs.Err(err))
+			return i.opts.MaxRetryDelay
+		}
+
+		// applied holds the entries applied to the engine, removed once it is flushed
+		var (
+			applied        []int64
+			appliedObjects = map[string]bool{}
+		)
+
+		for _, entry := range entries {
+			cursor = entry.Index
+
+			var e outboxEntry
+			err = json.Unmarshal([]byte(entry.Value), &e)
+			if err != nil {
+				logs.Error("indexing: dropping undecodable outbox entry", logs.Details("index", entry.Index), logs.Err(err))
+				i.remove(entry.Index)
+				continue
+			}
+
+			// entries of an object are applied in order: an entry waits for the failed ones that precede it
+			if pending[e.ObjectID] {
+				continue
+			}
+
+			retryAt, retrying := i.retryAt[entry.Index]
+			if retrying && time.Now().Before(retryAt) {
+				pending[e.ObjectID] = true
+				if nextRetry.IsZero() || retryAt.Before(nextRetry) {
+					nextRetry = retryAt
+				}
+				continue
+			}
+
+			err = i.apply(&e)
+			if err == nil {
+				applied = append(applied, entry.Index)
+				appliedObjects[e.ObjectID] = true
+				continue
+			}
+
+			i.attempts[entry.Index]++
+			attempts := i.attempts[entry.Index]
+
+			if attempts >= i.opts.MaxAttempts {
+				logs.Error("indexing: dropping outbox entry after too many attempts", logs.Details("object", e.ObjectID), logs.Details("attempts", attempts), logs.Err(err))
+				atomic.AddInt64(&i.dropped, 1)
+				dropped[e.ObjectID] = true
+				i.remove(entry.Index)
+				continue
+			}
+
+			retryAt = time.Now().Add(i.retryDelay(attempts))
+
+			logs.Error("indexing: could not apply outbox entry", logs.Details("object", e.ObjectID), logs.Details("attempts", attempts), logs.Err(err))
+			atomic.AddInt64(&i.retries, 1)
+
+			i.retryAt[entry.Index] = retryAt
+
+			pending[e.ObjectID] = true
+			if nextRetry.IsZero() || retryAt.Before(nextRetry) {
+				nextRetry = retryAt
+			}
+		}
+
+		if len(applied) > 0 {
+			err = i.engine.Flush()
+			if err != nil {
+				// the applied entries are kept, and applied again once the engine recovers
+				i.flushFailures++
+				logs.Error("indexing: could not flush applied outbox entries", logs.Details("entries", len(applied)), logs.Details("failures", i.flushFailures), logs.Err(err))
+
+				retryAt := time.Now().Add(i.retryDelay(i.flushFailures))
+				if nextRetry.IsZero() || retryAt.Before(nextRetry) {
+					nextRetry = retryAt
+				}
+				complete = false
+				break
+			}
+
+			i.flushFailures = 0
+			for _, index := range applied {
+				atomic.AddInt64(&i.applied, 1)
+				i.remove(index)
+			}
+		}
+
+		if len(entries) < i.opts.BatchSize {
+			break
+		}
+
+		// the lease is renewed before each batch, the pass stops if it was lost
+		holder, err = i.claim()
+		if err != nil || !holder {
+			logs.Error("indexing: lost the lease of the outbox", logs.Err(err))
+			complete = false
+		}
+	}
+
+	i.release(pass, pending, dropped, complete)
+
+	if nextRetry.IsZero() {
+		return 0
+	}
+	if delay := time.Until(nextRetry); delay > 0 {
+		return delay
+	}
+	return time.Millisecond
+}
+
+// follow lists the objects that have outbox entries while another worker holds the lease, to release the waiters
+// whose entries were applied by it. It returns the delay before the next look when waiters are left
+func (i *indexer) follow(pass int64) time.Duration {
+	var (
+		cursor  int64
+		pending = map[string]bool{}
+	)
+
+	for {
+		entries, err := i.outbox.RangeFromIndex(cursor, 0, i.opts.BatchSize)
+		if err != nil {
+			logs.Error("indexing: could not load outbox entries", logs.Err(err))
+			return i.opts.MaxRetryDelay
+		}
+
+		for _, entry := range entries {
+			cursor = entry.Index
+
+			var e outboxEntry
+			if json.Unmarshal([]byte(entry.Value), &e) == nil {
+				pending[e.ObjectID] = true
+			}
+		}
+
+		if len(entries) < i.opts.BatchSize {
+			break
+		}
+	}
+
+	i.release(pass, pending, nil, true)
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	if len(i.waiters) > 0 {
+		return i.opts.RetryDelay
+	}
+	return 0
+}
+
+// release releases the waiters registered before pass whose object has no pending entry. Waiters are only marked
+// with the dropped entries of their object when the pass did not look at all the entries
+func (i *indexer) release(pass int64, pending map[string]bool, dropped map[string]bool, complete bool) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	waiters := i.waiters[:0]
+	for _, w := range i.waiters {
+		w.dropped = w.dropped || dropped[w.objectID]
+		if complete && w.pass < pass && !pending[w.objectID] {
+			close(w.done)
+			continue
+		}
+		waiters = append(waiters, w)
+	}
+	i.waiters = waiters
+}
+
+func (i *indexer) apply(e *outboxEntry) error {
+	msg := &pb.MessageFeed{}
+	err := jsonpb.Unmarshal(strings.NewReader(string(e.Feed)), msg)
+	if err != nil {
+		return err
+	}
+	return i.engine.Feed(msg)
+}
+
+// remove deletes the stored or dropped entry from the outbox
+func (i *indexer) remove(index int64) {
+	delete(i.attempts, index)
+	delete(i.retryAt, index)
+
+	err := i.outbox.Delete(index)
+	if err != nil {
+		logs.Error("indexing: could not delete outbox entry", logs.Details("index", index), logs.Err(err))
+	}
+}
+
+func (i *indexer) metrics() (*IndexingMetrics, error) {
+	m := &IndexingMetrics{
+		Applied: atomic.LoadInt64(&i.applied),
+		Retries: atomic.LoadInt64(&i.retries),
+		Dropped: atomic.LoadInt64(&i.dropped),
+	}
+
+	var err error
+	m.Pending, err = i.outbox.Count()
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Pending > 0 {
+		entries, err := i.outbox.Range(0, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		var e outboxEntry
+		if len(entries) > 0 && json.Unmarshal([]byte(entries[0].Value), &e) == nil && e.CreatedAt > 0 {
+			m.Lag = time.Duration(utime.Now()-e.CreatedAt) * time.Millisecond
+		}
+	}
+	return m, nil
+}
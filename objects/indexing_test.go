@@ -0,0 +1,179 @@
+package objects
+
+import (
+	"context"
+	"github.com/omecodes/bome"
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	se "github.com/omecodes/store/search-engine"
+	. "github.com/smartystreets/goconvey/convey"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// flakyEngine records the messages it is fed, failing the ones of the objects listed in failures as many times as
+// their count. Its flushes fail flushFailures times
+type flakyEngine struct {
+	se.Service
+	sync.Mutex
+	failures      map[string]int
+	flushFailures int
+	fed           []string
+}
+
+func (e *flakyEngine) Feed(msg *pb.MessageFeed) error {
+	e.Lock()
+	defer e.Unlock()
+
+	var id, kind string
+	switch m := msg.Message.(type) {
+	case *pb.MessageFeed_TextMapping:
+		id, kind = m.TextMapping.ObjectId, "text:"+m.TextMapping.Text
+	case *pb.MessageFeed_Delete:
+		id, kind = m.Delete.Id, "delete"
+	}
+
+	if e.failures[id] > 0 {
+		e.failures[id]--
+		return errors.ServiceUnavailable("index is not available")
+	}
+	e.fed = append(e.fed, id+"/"+kind)
+	return nil
+}
+
+func (e *flakyEngine) Flush() error {
+	e.Lock()
+	defer e.Unlock()
+
+	if e.flushFailures > 0 {
+		e.flushFailures--
+		return errors.ServiceUnavailable("index is not available")
+	}
+	return nil
+}
+
+func (e *flakyEngine) messages() []string {
+	e.Lock()
+	defer e.Unlock()
+	return append([]string{}, e.fed...)
+}
+
+func TestSqlCollection_Indexing(t *testing.T) {
+	Convey("Index changes are written with the objects and applied in order with retries", t, func() {
+		conn := newTestConn()
+
+		engine := &flakyEngine{failures: map[string]int{"o1": 2}}
+		collection := &pb.Collection{Id: "notes", TextIndexes: []*pb.TextIndex{{Path: "$.text", Alias: "text"}}}
+		col, err := newSQLCollection(collection, conn, bome.SQLite3, "test_notes", engine, IndexingOptions{
+			MaxAttempts: 3,
+			RetryDelay:  time.Millisecond,
+		})
+		So(err, ShouldBeNil)
+		Reset(func() {
+			_ = col.Close()
+		})
+
+		ctx := context.Background()
+		save := func(ctx context.Context, id string, text string) error {
+			return col.Save(ctx, &pb.Object{Header: &pb.Header{Id: id}, Data: `{"text": "` + text + `"}`})
+		}
+
+		So(save(ctx, "o1", "first"), ShouldBeNil)
+		So(save(ctx, "o2", "other"), ShouldBeNil)
+		So(col.Delete(ctx, "o1"), ShouldBeNil)
+		So(save(ContextWithIndexVisibility(ctx), "o1", "second"), ShouldBeNil)
+		So(col.Delete(ContextWithIndexVisibility(ctx), "o2"), ShouldBeNil)
+
+		perObject := map[string][]string{}
+		for _, msg := range engine.messages() {
+			parts := strings.SplitN(msg, "/", 2)
+			perObject[parts[0]] = append(perObject[parts[0]], parts[1])
+		}
+		So(perObject["o1"], ShouldResemble, []string{"text:first", "delete", "text:second"})
+		So(perObject["o2"], ShouldResemble, []string{"text:other", "delete"})
+
+		metrics, err := col.indexer.metrics()
+		So(err, ShouldBeNil)
+		So(metrics.Pending, ShouldEqual, 0)
+		So(metrics.Applied, ShouldEqual, 5)
+		So(metrics.Retries, ShouldEqual, 2)
+		So(metrics.Dropped, ShouldEqual, 0)
+
+		Convey("Changes that keep failing are dropped after the maximum number of attempts", func() {
+			engine.Lock()
+			engine.failures["o3"] = 10
+			engine.Unlock()
+
+			err := save(ContextWithIndexVisibility(ctx), "o3", "lost")
+			So(IsNotIndexed(err), ShouldBeTrue)
+			So(errors.HTTPStatus(err.(*NotIndexedError).Err), ShouldEqual, errors.HTTPStatus(errors.Internal("")))
+
+			_, err = col.Get(ctx, "o3", GetObjectOptions{})
+			So(err, ShouldBeNil)
+
+			metrics, err := col.indexer.metrics()
+			So(err, ShouldBeNil)
+			So(metrics.Pending, ShouldEqual, 0)
+			So(metrics.Dropped, ShouldEqual, 1)
+		})
+
+		Convey("Waiting for index visibility ends with the context", func() {
+			engine.Lock()
+			engine.failures["o4"] = 10
+			engine.Unlock()
+			col.indexer.opts.RetryDelay = time.Hour
+
+			waitCtx, cancel := context.WithTimeout(ContextWithIndexVisibility(ctx), 50*time.Millisecond)
+			defer cancel()
+
+			err := save(waitCtx, "o4", "slow")
+			So(IsNotIndexed(err), ShouldBeTrue)
+			So(errors.HTTPStatus(err.(*NotIndexedError).Err), ShouldEqual, errors.HTTPStatus(errors.ServiceUnavailable("")))
+
+			metrics, err := col.indexer.metrics()
+			So(err, ShouldBeNil)
+			So(metrics.Pending, ShouldEqual, 1)
+			So(metrics.Lag, ShouldBeGreaterThan, 0)
+		})
+
+		Convey("Applied changes are kept in the outbox until the engine stores them", func() {
+			engine.Lock()
+			engine.flushFailures = 1
+			engine.Unlock()
+
+			So(save(ContextWithIndexVisibility(ctx), "o5", "flushed"), ShouldBeNil)
+
+			var fed int
+			for _, msg := range engine.messages() {
+				if msg == "o5/text:flushed" {
+					fed++
+				}
+			}
+			So(fed, ShouldEqual, 2)
+
+			metrics, err := col.indexer.metrics()
+			So(err, ShouldBeNil)
+			So(metrics.Pending, ShouldEqual, 0)
+		})
+
+		Convey("Workers sharing an outbox take turns through its lease", func() {
+			otherEngine := &flakyEngine{}
+			other, err := newIndexer(conn, bome.SQLite3, "test_notes_index_outbox", otherEngine, IndexingOptions{RetryDelay: time.Millisecond})
+			So(err, ShouldBeNil)
+			defer func() {
+				_ = other.Close()
+			}()
+
+			So(save(ContextWithIndexVisibility(ctx), "o6", "held"), ShouldBeNil)
+			So(other.wait(ctx, "o6"), ShouldBeNil)
+			So(otherEngine.messages(), ShouldBeEmpty)
+
+			So(col.indexer.Close(), ShouldBeNil)
+			So(save(ctx, "o7", "taken"), ShouldBeNil)
+			So(other.wait(ctx, "o7"), ShouldBeNil)
+			So(otherEngine.messages(), ShouldResemble, []string{"o7/text:taken"})
+		})
+	})
+}
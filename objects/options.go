@@ -10,21 +10,82 @@ type ListCollectionOptions struct{}
 
 type DeleteCollectionOptions struct{}
 
+type SaveSynonymSetOptions struct{}
+
+type DeleteSynonymSetOptions struct{}
+
+// ListSynonymSetsOptions filters listed synonym sets. Collection keeps the sets that apply to a collection: the sets
+// of the collection and the sets that are only scoped to an analyzer. Analyzer keeps the sets scoped to an analyzer
+type ListSynonymSetsOptions struct {
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Analyzer   string `protobuf:"bytes,2,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+}
+
+// CheckIndexOptions tunes index consistency checks
+type CheckIndexOptions struct {
+	// Repair queues the deletion of the mappings of missing objects and the indexing of the objects without mappings
+	Repair bool `protobuf:"varint,1,opt,name=repair,proto3" json:"repair,omitempty"`
+
+	// BatchSize is the number of ids checked, and repaired in a single transaction, at once
+	BatchSize int64 `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+type ObjectTokensOptions struct{}
+
+// TokenDocumentsOptions selects the postings of a token to list
+type TokenDocumentsOptions struct {
+	// Field restricts the postings to the ones of a text index alias. Empty selects all the fields
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+
+	// After lists the postings of the objects whose id is greater than it
+	After string `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+
+	// Count is the number of objects whose postings are listed
+	Count int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+// AnalyzeOptions selects the analyzer texts are analyzed with. Empty selects the standard analyzer
+type AnalyzeOptions struct {
+	Analyzer string `protobuf:"bytes,1,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+}
+
+type ExplainSearchOptions struct{}
+
 type GetHeaderOptions struct{}
 
-type DeleteObjectOptions struct{}
+type DeleteObjectOptions struct {
+	// WaitIndexed makes the deletion return once the object is removed from search results
+	WaitIndexed bool `protobuf:"varint,1,opt,name=wait_indexed,json=waitIndexed,proto3" json:"wait_indexed,omitempty"`
+}
 
 type CollectionOptions struct {
 	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	FullObject bool   `protobuf:"varint,2,opt,name=full_object,json=fullObject,proto3" json:"full_object,omitempty"`
 }
 
+const (
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+)
+
 type ListOptions struct {
-	At     string `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
-	Offset int64  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	At            string `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	Offset        int64  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	SortBy        string `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	UpdatedBy     string `protobuf:"bytes,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAfter  int64  `protobuf:"varint,6,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	UpdatedBefore int64  `protobuf:"varint,7,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+	CreatedBy     string `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAfter  int64  `protobuf:"varint,9,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore int64  `protobuf:"varint,10,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	MinSize       int64  `protobuf:"varint,11,opt,name=min_size,json=minSize,proto3" json:"min_size,omitempty"`
+	MaxSize       int64  `protobuf:"varint,12,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
 }
 
-type PutOptions struct{}
+type PutOptions struct {
+	// WaitIndexed makes the put return once the object is searchable
+	WaitIndexed bool `protobuf:"varint,1,opt,name=wait_indexed,json=waitIndexed,proto3" json:"wait_indexed,omitempty"`
+}
 
 type GetObjectOptions struct {
 	Info bool   `protobuf:"varint,1,opt,name=info,proto3" json:"info,omitempty"`
@@ -37,4 +98,24 @@ type MoveOptions struct {
 	NewSecurity *pb.PathAccessRules
 }
 
-type SearchObjectsOptions struct{}
+type SearchObjectsOptions struct {
+	// Highlight adds to each found object the fragments of its indexed text fields that match the query
+	Highlight bool `protobuf:"varint,1,opt,name=highlight,proto3" json:"highlight,omitempty"`
+
+	// FragmentSize is the number of characters of highlighted fragments
+	FragmentSize int64 `protobuf:"varint,2,opt,name=fragment_size,json=fragmentSize,proto3" json:"fragment_size,omitempty"`
+
+	// Facets are counted over all the found objects and returned with the first one
+	Facets []*pb.Facet `protobuf:"bytes,3,rep,name=facets,proto3" json:"facets,omitempty"`
+
+	// SortByDistance sorts the found objects by the distance of their geo index point to it, nearest first
+	SortByDistance *pb.GeoPoint `protobuf:"bytes,4,opt,name=sort_by_distance,json=sortByDistance,proto3" json:"sort_by_distance,omitempty"`
+}
+
+type SuggestOptions struct {
+	// Size is the number of suggested terms
+	Size int64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+
+	// MaxDistance is the number of edits tolerated between the prefix and the beginning of the suggested terms
+	MaxDistance int64 `protobuf:"varint,2,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+}
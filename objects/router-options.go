@@ -4,6 +4,8 @@ type routesOptions struct {
 	skipPolicies   bool
 	skipParams     bool
 	skipEncryption bool
+	skipEvents     bool
+	skipRules      bool
 }
 
 type RouteOption func(*routesOptions)
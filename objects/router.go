@@ -37,6 +37,18 @@ func getHandler(opts ...RouteOption) (handler Handler) {
 
 	handler = &ExecHandler{}
 
+	if !routes.skipEvents {
+		handler = &EventsHandler{BaseHandler: BaseHandler{
+			next: handler,
+		}}
+	}
+
+	if !routes.skipRules {
+		handler = &RulesHandler{BaseHandler: BaseHandler{
+			next: handler,
+		}}
+	}
+
 	if !routes.skipPolicies {
 		handler = &ACLHandler{BaseHandler: BaseHandler{
 			next: handler,
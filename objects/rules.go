@@ -0,0 +1,193 @@
+package objects
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/PaesslerAG/gval"
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/omecodes/errors"
+	"github.com/omecodes/libome/logs"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"strings"
+)
+
+// rulesLanguage is the expression language of write rules. It is gval full language extended with JSON paths
+var rulesLanguage = gval.Full(jsonpath.Language())
+
+// WriteRulesResult is the outcome of write rules evaluation on an object
+type WriteRulesResult struct {
+	Accepted bool   `json:"accepted"`
+	Rule     string `json:"rule,omitempty"`
+	Message  string `json:"message,omitempty"`
+	Data     string `json:"data,omitempty"`
+}
+
+// ValidateWriteRules checks that all rules are well formed and that their expressions compile
+func ValidateWriteRules(rules []*pb.WriteRule) error {
+	for ind, rule := range rules {
+		if rule == nil {
+			return errors.BadRequest("write rule is empty", errors.Details{Key: "index", Value: ind})
+		}
+
+		if rule.Condition != "" {
+			if _, err := rulesLanguage.NewEvaluable(rule.Condition); err != nil {
+				return errors.BadRequest("write rule condition syntax error", errors.Details{Key: "rule", Value: rule.Name}, errors.Details{Key: "error", Value: err.Error()})
+			}
+		}
+
+		switch rule.Action {
+		case pb.WriteRuleAction_Unspecified:
+			return errors.BadRequest("write rule action is not specified", errors.Details{Key: "rule", Value: rule.Name})
+
+		case pb.WriteRuleAction_Reject:
+			if rule.Condition == "" {
+				return errors.BadRequest("reject rule requires a condition", errors.Details{Key: "rule", Value: rule.Name})
+			}
+
+		case pb.WriteRuleAction_SetDefault, pb.WriteRuleAction_Compute:
+			if _, err := splitRulePath(rule.Path); err != nil {
+				return errors.BadRequest("write rule path is not supported", errors.Details{Key: "rule", Value: rule.Name}, errors.Details{Key: "path", Value: rule.Path})
+			}
+
+			if _, err := rulesLanguage.NewEvaluable(rule.Expression); err != nil {
+				return errors.BadRequest("write rule expression syntax error", errors.Details{Key: "rule", Value: rule.Name}, errors.Details{Key: "error", Value: err.Error()})
+			}
+
+		default:
+			return errors.BadRequest("unknown write rule action", errors.Details{Key: "rule", Value: rule.Name})
+		}
+	}
+	return nil
+}
+
+// EvaluateWriteRules evaluates rules in order against the JSON encoded data. Each rule sees the changes made by previous ones.
+// Evaluation stops at the first reject rule whose condition is satisfied
+func EvaluateWriteRules(ctx context.Context, rules []*pb.WriteRule, data string) (*WriteRulesResult, error) {
+	result := &WriteRulesResult{Data: data}
+	if len(rules) == 0 {
+		result.Accepted = true
+		return result, nil
+	}
+
+	var doc interface{}
+	err := json.Unmarshal([]byte(data), &doc)
+	if err != nil {
+		return nil, errors.BadRequest("object data is not a valid JSON", errors.Details{Key: "error", Value: err.Error()})
+	}
+
+	changed := false
+	for _, rule := range rules {
+		if rule.Condition != "" {
+			condition, err := rulesLanguage.NewEvaluable(rule.Condition)
+			if err != nil {
+				return nil, errors.BadRequest("write rule condition syntax error", errors.Details{Key: "rule", Value: rule.Name}, errors.Details{Key: "error", Value: err.Error()})
+			}
+
+			ok, err := condition.EvalBool(ctx, doc)
+			if err != nil {
+				logs.Error("write rule condition evaluation failed", logs.Details("rule", rule.Name), logs.Err(err))
+				return nil, errors.BadRequest("write rule condition evaluation failed", errors.Details{Key: "rule", Value: rule.Name}, errors.Details{Key: "error", Value: err.Error()})
+			}
+
+			if !ok {
+				continue
+			}
+		}
+
+		switch rule.Action {
+		case pb.WriteRuleAction_Reject:
+			result.Rule = rule.Name
+			result.Message = rule.Message
+			if result.Message == "" {
+				result.Message = "rejected by rule " + rule.Name
+			}
+			result.Data = ""
+			return result, nil
+
+		case pb.WriteRuleAction_SetDefault:
+			if value, _ := jsonpath.Get(at(rule.Path), doc); value != nil {
+				continue
+			}
+			fallthrough
+
+		case pb.WriteRuleAction_Compute:
+			expression, err := rulesLanguage.NewEvaluable(rule.Expression)
+			if err != nil {
+				return nil, errors.BadRequest("write rule expression syntax error", errors.Details{Key: "rule", Value: rule.Name}, errors.Details{Key: "error", Value: err.Error()})
+			}
+
+			value, err := expression(ctx, doc)
+			if err != nil {
+				logs.Error("write rule expression evaluation failed", logs.Details("rule", rule.Name), logs.Err(err))
+				return nil, errors.BadRequest("write rule expression evaluation failed", errors.Details{Key: "rule", Value: rule.Name}, errors.Details{Key: "error", Value: err.Error()})
+			}
+
+			doc, err = setAtPath(doc, rule.Path, value)
+			if err != nil {
+				return nil, errors.BadRequest("write rule could not set value", errors.Details{Key: "rule", Value: rule.Name}, errors.Details{Key: "error", Value: err.Error()})
+			}
+			changed = true
+		}
+	}
+
+	result.Accepted = true
+	if changed {
+		encoded, err := json.Marshal(doc)
+		if err != nil {
+			return nil, errors.Internal("could not encode object data", errors.Details{Key: "error", Value: err.Error()})
+		}
+		result.Data = string(encoded)
+	}
+	return result, nil
+}
+
+// splitRulePath splits simple dotted JSON paths like $.a.b into keys. Wildcards, filters and indexes are not supported
+func splitRulePath(path string) ([]string, error) {
+	p := strings.TrimPrefix(at(path), "$.")
+	if p == "" || strings.ContainsAny(p, "[]*?@()") {
+		return nil, errors.BadRequest("unsupported path")
+	}
+
+	keys := strings.Split(p, ".")
+	for _, key := range keys {
+		if key == "" {
+			return nil, errors.BadRequest("unsupported path")
+		}
+	}
+	return keys, nil
+}
+
+// setAtPath sets value in doc at path. Missing intermediate objects are created
+func setAtPath(doc interface{}, path string, value interface{}) (interface{}, error) {
+	keys, err := splitRulePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if doc == nil {
+		doc = map[string]interface{}{}
+	}
+
+	current, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, errors.BadRequest("object data is not a JSON object")
+	}
+
+	for _, key := range keys[:len(keys)-1] {
+		next, found := current[key]
+		if !found || next == nil {
+			m := map[string]interface{}{}
+			current[key] = m
+			current = m
+			continue
+		}
+
+		current, ok = next.(map[string]interface{})
+		if !ok {
+			return nil, errors.BadRequest("path crosses a non object value", errors.Details{Key: "key", Value: key})
+		}
+	}
+
+	current[keys[len(keys)-1]] = value
+	return doc, nil
+}
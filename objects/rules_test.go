@@ -0,0 +1,77 @@
+package objects
+
+import (
+	"context"
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+var playerRules = []*pb.WriteRule{
+	{
+		Name:      "adult-players",
+		Action:    pb.WriteRuleAction_Reject,
+		Condition: "$.age < 18",
+		Message:   "players must be adults",
+	},
+	{
+		Name:       "default-club",
+		Action:     pb.WriteRuleAction_SetDefault,
+		Path:       "$.club",
+		Expression: `"free agent"`,
+	},
+	{
+		Name:       "full-name",
+		Action:     pb.WriteRuleAction_Compute,
+		Path:       "$.info.full_name",
+		Expression: `$.first_name + " " + $.last_name`,
+	},
+}
+
+func TestValidateWriteRules(t *testing.T) {
+	Convey("Well formed rules are valid", t, func() {
+		So(ValidateWriteRules(playerRules), ShouldBeNil)
+	})
+
+	Convey("Rules with syntax errors or unsupported paths are rejected", t, func() {
+		So(ValidateWriteRules([]*pb.WriteRule{{Name: "r", Condition: "$.age < 18"}}), ShouldNotBeNil)
+		So(ValidateWriteRules([]*pb.WriteRule{{Name: "r", Action: pb.WriteRuleAction_Reject}}), ShouldNotBeNil)
+		So(ValidateWriteRules([]*pb.WriteRule{{Name: "r", Action: pb.WriteRuleAction_Reject, Condition: "$.age <"}}), ShouldNotBeNil)
+		So(ValidateWriteRules([]*pb.WriteRule{{Name: "r", Action: pb.WriteRuleAction_Compute, Path: "$.list[0]", Expression: "1"}}), ShouldNotBeNil)
+	})
+}
+
+func TestEvaluateWriteRules(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Objects matching a reject rule are not accepted", t, func() {
+		result, err := EvaluateWriteRules(ctx, playerRules, `{"first_name": "Kylian", "last_name": "M", "age": 16}`)
+		So(err, ShouldBeNil)
+		So(result.Accepted, ShouldBeFalse)
+		So(result.Rule, ShouldEqual, "adult-players")
+		So(result.Message, ShouldEqual, "players must be adults")
+	})
+
+	Convey("Defaults and computed fields are set", t, func() {
+		result, err := EvaluateWriteRules(ctx, playerRules, `{"first_name": "Paulo", "last_name": "Dybala", "age": 27}`)
+		So(err, ShouldBeNil)
+		So(result.Accepted, ShouldBeTrue)
+		So(result.Data, ShouldEqual, `{"age":27,"club":"free agent","first_name":"Paulo","info":{"full_name":"Paulo Dybala"},"last_name":"Dybala"}`)
+	})
+
+	Convey("Defaults do not override existing values", t, func() {
+		result, err := EvaluateWriteRules(ctx, playerRules[:2], `{"age": 33, "club": "juventus"}`)
+		So(err, ShouldBeNil)
+		So(result.Accepted, ShouldBeTrue)
+		So(result.Data, ShouldEqual, `{"age": 33, "club": "juventus"}`)
+	})
+
+	Convey("Patches are applied before rules evaluation", t, func() {
+		patched, err := applyPatch(`{"first_name": "Paulo", "age": 27}`, &pb.Patch{At: "$.age", Data: "17"})
+		So(err, ShouldBeNil)
+
+		result, err := EvaluateWriteRules(ctx, playerRules, patched)
+		So(err, ShouldBeNil)
+		So(result.Accepted, ShouldBeFalse)
+	})
+}
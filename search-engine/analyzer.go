@@ -1,73 +1,236 @@
 package se
 
 import (
-	"fmt"
-	"golang.org/x/text/runes"
-	"golang.org/x/text/transform"
+	"github.com/omecodes/errors"
 	"golang.org/x/text/unicode/norm"
+	"sort"
 	"strings"
+	"sync"
 	"unicode"
 )
 
-type Analyzer func(in string) (out string)
+const (
+	// StandardAnalyzer lower cases words, removes english and french stop words then the accents
+	StandardAnalyzer = "standard"
 
-type ChainAnalyzer func(analyzer Analyzer) Analyzer
+	// EnglishAnalyzer removes english stop words and stems words with the english stemmer
+	EnglishAnalyzer = "english"
 
-func removePunctuation(analyzer Analyzer) Analyzer {
-	return func(in string) string {
-		var result strings.Builder
-		for i := 0; i < len(in); i++ {
-			b := in[i]
-			if ('a' <= b && b <= 'z') ||
-				('A' <= b && b <= 'Z') ||
-				b == ' ' {
-				result.WriteByte(b)
+	// FrenchAnalyzer removes french stop words and stems words with the french stemmer before removing accents
+	FrenchAnalyzer = "french"
+
+	// KeywordAnalyzer indexes the whole lower cased text as a single token
+	KeywordAnalyzer = "keyword"
+
+	// DefaultAnalyzer is used by text indexes that do not name an analyzer
+	DefaultAnalyzer = StandardAnalyzer
+)
+
+// TokenFilter transforms a list of tokens. Filters may change, remove or add tokens
+type TokenFilter func(tokens []string) []string
+
+// Analyzer turns a text into the list of terms it is indexed or searched with
+type Analyzer struct {
+	name      string
+	tokenizer Tokenizer
+	filters   []TokenFilter
+}
+
+// Name returns the name the analyzer is registered with
+func (a *Analyzer) Name() string {
+	return a.name
+}
+
+// Analyze splits text with the analyzer tokenizer then applies the filters, in order, to the tokens. Empty tokens are dropped
+func (a *Analyzer) Analyze(text string) []string {
+	return a.filter(a.tokenizer(text))
+}
+
+// filter applies the analyzer filters, in order, to tokens and drops the empty results
+func (a *Analyzer) filter(tokens []string) []string {
+	for _, filter := range a.filters {
+		tokens = filter(tokens)
+	}
+
+	terms := tokens[:0]
+	for _, token := range tokens {
+		if token != "" {
+			terms = append(terms, token)
+		}
+	}
+	return terms
+}
+
+var (
+	analyzersMutex = &sync.RWMutex{}
+	analyzers      = map[string]*Analyzer{}
+)
+
+// RegisterAnalyzer registers an analyzer under name. Text indexes refer to analyzers by name so that the same
+// analysis is applied to texts at index time and to text query values at search time
+func RegisterAnalyzer(name string, tokenizer Tokenizer, filters ...TokenFilter) error {
+	if name == "" || tokenizer == nil {
+		return errors.BadRequest("an analyzer requires a name and a tokenizer")
+	}
+
+	analyzersMutex.Lock()
+	defer analyzersMutex.Unlock()
+
+	if _, found := analyzers[name]; found {
+		return errors.Conflict("analyzer already registered", errors.Details{Key: "name", Value: name})
+	}
+	analyzers[name] = &Analyzer{
+		name:      name,
+		tokenizer: tokenizer,
+		filters:   filters,
+	}
+	return nil
+}
+
+// GetAnalyzer returns the analyzer registered under name. An empty name refers to the default analyzer
+func GetAnalyzer(name string) (*Analyzer, error) {
+	if name == "" {
+		name = DefaultAnalyzer
+	}
+
+	analyzersMutex.RLock()
+	defer analyzersMutex.RUnlock()
+
+	analyzer, found := analyzers[name]
+	if !found {
+		return nil, errors.NotFound("analyzer not found", errors.Details{Key: "name", Value: name})
+	}
+	return analyzer, nil
+}
+
+// AnalyzerNames returns the sorted names of the registered analyzers
+func AnalyzerNames() []string {
+	analyzersMutex.RLock()
+	defer analyzersMutex.RUnlock()
+
+	var names []string
+	for name := range analyzers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func init() {
+	builtins := []struct {
+		name      string
+		tokenizer Tokenizer
+		filters   []TokenFilter
+	}{
+		{StandardAnalyzer, WordTokenizer, []TokenFilter{LowercaseFilter, StopWordsFilter(append(append([]string{}, englishStopWords...), frenchStopWords...)), AccentsFilter}},
+		{EnglishAnalyzer, WordTokenizer, []TokenFilter{LowercaseFilter, StopWordsFilter(englishStopWords), StemmerFilter(StemEnglish), AccentsFilter}},
+		{FrenchAnalyzer, WordTokenizer, []TokenFilter{LowercaseFilter, StopWordsFilter(frenchStopWords), StemmerFilter(StemFrench), AccentsFilter}},
+		{KeywordAnalyzer, KeywordTokenizer, []TokenFilter{LowercaseFilter}},
+	}
+
+	for _, builtin := range builtins {
+		if err := RegisterAnalyzer(builtin.name, builtin.tokenizer, builtin.filters...); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// LowercaseFilter lower cases tokens
+func LowercaseFilter(tokens []string) []string {
+	for ind, token := range tokens {
+		tokens[ind] = strings.ToLower(token)
+	}
+	return tokens
+}
+
+// AccentsFilter removes diacritical marks from tokens
+func AccentsFilter(tokens []string) []string {
+	for ind, token := range tokens {
+		tokens[ind] = foldAccents(token)
+	}
+	return tokens
+}
+
+// StopWordsFilter returns a filter that removes the given words
+func StopWordsFilter(words []string) TokenFilter {
+	set := map[string]bool{}
+	for _, word := range words {
+		set[word] = true
+	}
+
+	return func(tokens []string) []string {
+		kept := tokens[:0]
+		for _, token := range tokens {
+			if !set[token] {
+				kept = append(kept, token)
 			}
 		}
-		return analyzer(result.String())
+		return kept
 	}
 }
 
-func removeAccents(analyzer Analyzer) Analyzer {
-	return func(in string) string {
-		t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
-		out, _, err := transform.String(t, in)
-		if err != nil && out == "" {
-			return in
+// StemmerFilter returns a filter that replaces tokens by their stem
+func StemmerFilter(stem func(word string) string) TokenFilter {
+	return func(tokens []string) []string {
+		for ind, token := range tokens {
+			tokens[ind] = stem(token)
 		}
-		return analyzer(out)
+		return tokens
 	}
 }
 
-func removeStopWords(analyzer Analyzer) Analyzer {
-	return func(in string) string {
-		var out = in
-		for _, word := range stopWords {
-			out = strings.Replace(out, fmt.Sprintf(" %s ", word), "", -1)
+// foldAccents removes the diacritical marks of latin, greek and cyrillic letters. In other scripts, non-spacing
+// marks are part of the spelling of words and are kept
+func foldAccents(in string) string {
+	var out strings.Builder
+	foldable := false
+
+	for _, r := range norm.NFD.String(in) {
+		if unicode.Is(unicode.Mn, r) {
+			if foldable {
+				continue
+			}
+		} else {
+			foldable = unicode.In(r, unicode.Latin, unicode.Greek, unicode.Cyrillic)
 		}
-		return analyzer(out)
+		out.WriteRune(r)
 	}
+	return norm.NFC.String(out.String())
 }
 
-func defaultTextAnalyzer() Analyzer {
-	a := strings.ToLower
-	a = removeStopWords(a)
-	a = removePunctuation(a)
-	a = removeAccents(a)
-	return a
+// Normalizer transforms a string property value before it is indexed or compared
+type Normalizer func(in string) (out string)
+
+// removePunctuation removes every character that is neither a letter, a mark, a number nor a space
+func removePunctuation(normalizer Normalizer) Normalizer {
+	return func(in string) string {
+		return normalizer(strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsNumber(r) || r == ' ' {
+				return r
+			}
+			return -1
+		}, in))
+	}
 }
 
-func propsMappingTextAnalyzer() Analyzer {
-	a := strings.ToLower
-	a = removePunctuation(a)
-	a = removeAccents(a)
-	return a
+func removeAccents(normalizer Normalizer) Normalizer {
+	return func(in string) string {
+		return normalizer(foldAccents(in))
+	}
+}
 
+// foldCompatibilityForms replaces compatibility characters, like full width letters or ligatures, by their canonical equivalent
+func foldCompatibilityForms(normalizer Normalizer) Normalizer {
+	return func(in string) string {
+		return normalizer(norm.NFKC.String(in))
+	}
 }
 
-func getQueryTextAnalyzer() Analyzer {
-	a := strings.ToLower
-	a = removePunctuation(a)
-	a = removeAccents(a)
-	return a
+// propsMappingNormalizer folds compatibility forms, then removes accents and punctuation before lower casing
+func propsMappingNormalizer() Normalizer {
+	n := strings.ToLower
+	n = removePunctuation(n)
+	n = removeAccents(n)
+	n = foldCompatibilityForms(n)
+	return n
 }
@@ -0,0 +1,119 @@
+package se
+
+import (
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestStemmers(t *testing.T) {
+	Convey("English words are reduced to their Porter2 stem", t, func() {
+		for word, stem := range map[string]string{
+			"running": "run", "generously": "generous", "happiness": "happi", "connection": "connect",
+			"relational": "relat", "cats": "cat", "agreed": "agre", "caresses": "caress", "ponies": "poni",
+			"consignment": "consign", "knightly": "knight", "skies": "ski", "news": "news", "succeeded": "succeed",
+			"communication": "communic", "controlling": "control", "hoping": "hope", "rationalization": "ration",
+			"electricity": "electr", "effective": "effect", "cry": "cri", "by": "by",
+		} {
+			So(StemEnglish(word), ShouldEqual, stem)
+		}
+	})
+
+	Convey("French words are reduced to their Snowball stem", t, func() {
+		for word, stem := range map[string]string{
+			"continuellement": "continuel", "majestueusement": "majestu", "chevaux": "cheval", "continuité": "continu",
+			"abandonnée": "abandon", "abandonner": "abandon", "nationales": "national", "joueurs": "joueur",
+			"finissons": "fin", "mangeons": "mangeon", "bibliothèque": "bibliothequ", "parlaient": "parl",
+			"heureusement": "heureux", "beaux": "beau", "amis": "amis", "énormément": "énorm",
+		} {
+			So(StemFrench(word), ShouldEqual, stem)
+		}
+	})
+}
+
+func TestAnalyzers(t *testing.T) {
+	analyze := func(name, text string) []string {
+		analyzer, err := GetAnalyzer(name)
+		So(err, ShouldBeNil)
+		return analyzer.Analyze(text)
+	}
+
+	Convey("Built-in analyzers split words, remove stop words and stem", t, func() {
+		So(analyze("", "The Players of Juventus"), ShouldResemble, []string{"players", "juventus"})
+		So(analyze(EnglishAnalyzer, "The Players are running"), ShouldResemble, []string{"player", "run"})
+		So(analyze(FrenchAnalyzer, "L'équipe a abandonné les joueurs"), ShouldResemble, []string{"equip", "abandon", "joueur"})
+		So(analyze(KeywordAnalyzer, "  Juventus Turin "), ShouldResemble, []string{"juventus turin"})
+	})
+
+	Convey("Analyzers are registered by name", t, func() {
+		upper := func(tokens []string) []string {
+			for ind, token := range tokens {
+				tokens[ind] = strings.ToUpper(token)
+			}
+			return tokens
+		}
+		So(RegisterAnalyzer("test-upper", WordTokenizer, upper), ShouldBeNil)
+		// the registry is global: the analyzer is removed for the next runs of the test
+		defer func() {
+			analyzersMutex.Lock()
+			defer analyzersMutex.Unlock()
+			delete(analyzers, "test-upper")
+		}()
+		So(analyze("test-upper", "juventus turin"), ShouldResemble, []string{"JUVENTUS", "TURIN"})
+		So(AnalyzerNames(), ShouldContain, "test-upper")
+
+		err := RegisterAnalyzer(EnglishAnalyzer, WordTokenizer)
+		So(errors.IsConflict(err), ShouldBeTrue)
+
+		_, err = GetAnalyzer("klingon")
+		So(errors.IsNotFound(err), ShouldBeTrue)
+	})
+}
+
+func TestEngine_SearchAnalyzers(t *testing.T) {
+	Convey("Text query values are analyzed like the texts of the index they target", t, func() {
+		engine := NewEngine(newTestSQLStore())
+
+		for _, m := range []*pb.TextMapping{
+			{ObjectId: "e1", Name: "summary", Analyzer: EnglishAnalyzer, Text: "The players were running in the rain"},
+			{ObjectId: "f1", Name: "resume", Analyzer: FrenchAnalyzer, Text: "Les joueurs ont abandonné le match"},
+			{ObjectId: "d1", Name: "notes", Text: "players running"},
+		} {
+			So(engine.CreateTextMapping(m), ShouldBeNil)
+		}
+
+		opts := SearchOptions{Analyzers: map[string]string{"summary": EnglishAnalyzer, "resume": FrenchAnalyzer}}
+		search := func(text string) []string {
+			q, err := ParseQuery(text)
+			So(err, ShouldBeNil)
+
+			hits, err := engine.Search(q, opts)
+			So(err, ShouldBeNil)
+
+			ids := hitIDs(hits)
+			sort.Strings(ids)
+			return ids
+		}
+
+		So(search(`$text = "runs"`), ShouldResemble, []string{"e1"})
+		So(search(`$text = "running"`), ShouldResemble, []string{"d1", "e1"})
+		So(search(`$text = "abandonner"`), ShouldResemble, []string{"f1"})
+		So(search(`$text = "joueur"`), ShouldResemble, []string{"f1"})
+		So(search(`$text = "the"`), ShouldBeNil)
+
+		Convey("Unknown analyzers are rejected", func() {
+			q, err := ParseQuery(`$text = "runs"`)
+			So(err, ShouldBeNil)
+
+			_, err = engine.Search(q, SearchOptions{Analyzers: map[string]string{"summary": "klingon"}})
+			So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+
+			err = engine.CreateTextMapping(&pb.TextMapping{ObjectId: "k1", Name: "summary", Analyzer: "klingon", Text: "runs"})
+			So(errors.IsNotFound(err), ShouldBeTrue)
+		})
+	})
+}
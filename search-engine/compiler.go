@@ -0,0 +1,874 @@
+package se
+
+import (
+	"fmt"
+	"github.com/omecodes/bome"
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// mysqlElementsTable iterates over the values of the property at the bound JSON path: the elements of arrays or the
+// value itself. Missing properties have no values
+const mysqlElementsTable = "json_table(json_merge_preserve(json_array(), json_extract(value, ?)), '$[*]' columns (v json path '$')) as e"
+
+const (
+	// DefaultMaxQueryDepth is the maximum nesting of and/or groups accepted when SearchOptions.MaxDepth is not set
+	DefaultMaxQueryDepth = 16
+
+	// DefaultMaxQueryTerms is the maximum number of conditions accepted when SearchOptions.MaxTerms is not set
+	DefaultMaxQueryTerms = 128
+
+	// MaxSequenceTerms is the maximum number of words of phrase and proximity conditions
+	MaxSequenceTerms = 16
+
+	// MaxNearDistance is the maximum number of words accepted between the words of proximity conditions
+	MaxNearDistance = 1000
+
+	// MaxListValues is the maximum number of values of in, any_of and all_of conditions
+	MaxListValues = 100
+)
+
+// likeEscapeChar is used to escape wildcards in LIKE patterns. It is neither a wildcard nor a string escape character in any supported dialect
+const likeEscapeChar = "!"
+
+// SearchOptions describes how a search query is evaluated
+type SearchOptions struct {
+	// Fields lists the property aliases declared by the collection's properties index. Conditions on other fields are rejected
+	Fields []string
+
+	// MaxDepth is the maximum nesting of and/or groups
+	MaxDepth int
+
+	// MaxTerms is the maximum number of conditions
+	MaxTerms int
+
+	// Boosts maps text index aliases to the factor applied to the relevance of their terms. Missing or zero boosts count as 1
+	Boosts map[string]float64
+
+	// Analyzers maps text index aliases to the name of the analyzer their texts were indexed with. Text query values
+	// are analyzed with the same analyzers. Other aliases use the default analyzer
+	Analyzers map[string]string
+
+	// Synonyms are the synonym sets that apply to the searched collection. Text conditions also look for the
+	// synonyms of their terms, sets scoped to an analyzer only apply to the aliases using it. Phrase and proximity
+	// conditions are not expanded
+	Synonyms []*pb.SynonymSet
+
+	// Origin sorts the hits by the distance of the nearest point indexed for them to it, nearest first. Hits
+	// without points come last
+	Origin *pb.GeoPoint
+}
+
+// CompiledQuery is a SQL query over the index tables and the values bound to its placeholders
+type CompiledQuery struct {
+	SQL    string
+	Params []interface{}
+
+	// ids selects the distinct ids of the matching objects. It is bound to Params
+	ids      string
+	matchers []tokenMatcher
+}
+
+// CompileQuery translates query into a parameterized SQL query for the given dialect. User supplied values are
+// never written in the SQL text: they are all bound as parameters.
+// Fuzzy conditions are resolved against the indexed tokens: they can only be compiled by an index store
+func CompileQuery(dialect string, query *pb.SearchQuery, opts SearchOptions) (*CompiledQuery, error) {
+	return compileQuery(dialect, query, opts, nil)
+}
+
+func compileQuery(dialect string, query *pb.SearchQuery, opts SearchOptions, expandFuzzy fuzzyExpander) (*CompiledQuery, error) {
+	if dialect != bome.SQLite3 && dialect != bome.MySQL {
+		return nil, errors.Unsupported("sql dialect not supported", errors.Details{Key: "type", Value: "dialect"}, errors.Details{Key: "name", Value: dialect})
+	}
+
+	c := &sqlCompiler{
+		queryLimits: newQueryLimits(opts),
+		dialect:     dialect,
+		fields:      map[string]bool{},
+		expandFuzzy: expandFuzzy,
+	}
+	for _, field := range opts.Fields {
+		c.fields[field] = true
+	}
+
+	if _, ok := query.GetQuery().(*pb.SearchQuery_Text); ok {
+		groups, err := analyzerGroups(opts.Analyzers, opts.Synonyms)
+		if err != nil {
+			return nil, err
+		}
+		c.groups = groups
+	}
+
+	var (
+		err  error
+		expr string
+	)
+
+	compiled := &CompiledQuery{}
+	switch q := query.GetQuery().(type) {
+	case *pb.SearchQuery_Text:
+		expr, err = c.compileText(q.Text, 1)
+		compiled.SQL = "select m.token, m.id, m.field, m.tf, coalesce(d.length, 0) from (select token, id, field, tf from " + wordsTableName + " where " + expr + ") as m left join " + docsTableName + " as d on d.id = m.id and d.field = m.field"
+		compiled.ids = "select distinct id from " + wordsTableName + " where " + expr
+		compiled.matchers = c.matchers
+
+	case *pb.SearchQuery_Number:
+		expr, err = c.compileNumber(q.Number, 1)
+		compiled.SQL = "select id from " + numbersTableName + " where " + expr
+		compiled.ids = "select distinct id from " + numbersTableName + " where " + expr
+
+	case *pb.SearchQuery_Fields:
+		expr, err = c.compileFields(q.Fields, 1)
+		compiled.SQL = "select object from " + propsTableName + " where " + expr
+		compiled.ids = compiled.SQL
+
+	case *pb.SearchQuery_Geo:
+		expr, err = c.compileGeo(q.Geo, 1)
+		compiled.SQL = "select id from " + geoTableName + " where " + expr
+		compiled.ids = "select distinct id from " + geoTableName + " where " + expr
+
+	default:
+		return nil, errors.BadRequest("empty search query")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	compiled.Params = c.params
+	return compiled, nil
+}
+
+// queryLimits enforces the maximum nesting and number of conditions of queries
+type queryLimits struct {
+	maxDepth int
+	maxTerms int
+	terms    int
+}
+
+func newQueryLimits(opts SearchOptions) queryLimits {
+	l := queryLimits{maxDepth: opts.MaxDepth, maxTerms: opts.MaxTerms}
+	if l.maxDepth <= 0 {
+		l.maxDepth = DefaultMaxQueryDepth
+	}
+	if l.maxTerms <= 0 {
+		l.maxTerms = DefaultMaxQueryTerms
+	}
+	return l
+}
+
+func (l *queryLimits) enterGroup(depth int, size int) error {
+	if depth > l.maxDepth {
+		return errors.BadRequest("search query is too deep", errors.Details{Key: "max-depth", Value: l.maxDepth})
+	}
+	if size == 0 {
+		return errors.BadRequest("search query contains an empty group")
+	}
+	return nil
+}
+
+// count accounts for a new condition
+func (l *queryLimits) count() error {
+	l.terms++
+	if l.terms > l.maxTerms {
+		return errors.BadRequest("search query has too many conditions", errors.Details{Key: "max-terms", Value: l.maxTerms})
+	}
+	return nil
+}
+
+type sqlCompiler struct {
+	queryLimits
+	dialect string
+	fields  map[string]bool
+
+	groups      []*analyzerGroup
+	expandFuzzy fuzzyExpander
+
+	params   []interface{}
+	matchers []tokenMatcher
+}
+
+// analyzerGroup gathers the text index aliases sharing an analyzer. The default analyzer group excludes the
+// aliases of the other groups instead, so that it also applies to texts indexed under undeclared aliases
+type analyzerGroup struct {
+	analyzer *Analyzer
+	fields   []string
+	exclude  bool
+	synonyms synonymDict
+}
+
+func analyzerGroups(aliasAnalyzers map[string]string, synonymSets []*pb.SynonymSet) ([]*analyzerGroup, error) {
+	defaultAnalyzer, err := GetAnalyzer(DefaultAnalyzer)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultGroup := &analyzerGroup{analyzer: defaultAnalyzer, exclude: true}
+	byName := map[string]*analyzerGroup{}
+	var names []string
+
+	for alias, name := range aliasAnalyzers {
+		if name == "" || name == DefaultAnalyzer {
+			continue
+		}
+
+		group, found := byName[name]
+		if !found {
+			analyzer, err := GetAnalyzer(name)
+			if err != nil {
+				return nil, errors.BadRequest("text index references an unknown analyzer", errors.Details{Key: "alias", Value: alias}, errors.Details{Key: "analyzer", Value: name})
+			}
+			group = &analyzerGroup{analyzer: analyzer}
+			byName[name] = group
+			names = append(names, name)
+		}
+		group.fields = append(group.fields, alias)
+		defaultGroup.fields = append(defaultGroup.fields, alias)
+	}
+
+	sort.Strings(names)
+	groups := []*analyzerGroup{defaultGroup}
+	for _, name := range names {
+		sort.Strings(byName[name].fields)
+		groups = append(groups, byName[name])
+	}
+	sort.Strings(defaultGroup.fields)
+
+	for _, group := range groups {
+		group.synonyms = newSynonymDict(group.analyzer, synonymSets)
+	}
+	return groups, nil
+}
+
+func (c *sqlCompiler) condition(expr string, params ...interface{}) (string, error) {
+	if err := c.count(); err != nil {
+		return "", err
+	}
+	c.params = append(c.params, params...)
+	return "(" + expr + ")", nil
+}
+
+func (c *sqlCompiler) join(operator string, items []string) string {
+	return "(" + strings.Join(items, " "+operator+" ") + ")"
+}
+
+// negate returns the negation of expr. Conditions on missing values evaluate to null, they are considered false
+// so that their negation matches
+func (c *sqlCompiler) negate(expr string) string {
+	return "(not coalesce(" + expr + ", 0))"
+}
+
+func (c *sqlCompiler) compileText(query *pb.StrQuery, depth int) (string, error) {
+	switch v := query.GetBool().(type) {
+	case *pb.StrQuery_Or:
+		if err := c.enterGroup(depth, len(v.Or.Queries)); err != nil {
+			return "", err
+		}
+
+		items := make([]string, len(v.Or.Queries))
+		for ind, q := range v.Or.Queries {
+			expr, err := c.compileText(q, depth+1)
+			if err != nil {
+				return "", err
+			}
+			items[ind] = expr
+		}
+		return c.join("or", items), nil
+
+	case *pb.StrQuery_Not:
+		if err := c.enterGroup(depth, 1); err != nil {
+			return "", err
+		}
+
+		// words are indexed one row per token: negation excludes every object having a matching token.
+		// Matchers of the negated query would only select tokens of excluded objects, they are dropped
+		matchersCount := len(c.matchers)
+		expr, err := c.compileText(v.Not.Expressions, depth+1)
+		if err != nil {
+			return "", err
+		}
+		c.matchers = c.matchers[:matchersCount]
+		return "(id not in (select id from " + wordsTableName + " where " + expr + "))", nil
+
+	case *pb.StrQuery_Contains:
+		return c.textCondition(v.Contains.Value, func(term string) (string, []interface{}, tokenMatcher, error) {
+			return "token like ? escape '" + likeEscapeChar + "'", []interface{}{"%" + escapeLike(term) + "%"}, containsMatcher(term), nil
+		})
+
+	case *pb.StrQuery_StartsWith:
+		return c.textCondition(v.StartsWith.Value, func(term string) (string, []interface{}, tokenMatcher, error) {
+			return "token like ? escape '" + likeEscapeChar + "'", []interface{}{escapeLike(term) + "%"}, startsWithMatcher(term), nil
+		})
+
+	case *pb.StrQuery_EndsWith:
+		return c.textCondition(v.EndsWith.Value, func(term string) (string, []interface{}, tokenMatcher, error) {
+			return "token like ? escape '" + likeEscapeChar + "'", []interface{}{"%" + escapeLike(term)}, endsWithMatcher(term), nil
+		})
+
+	case *pb.StrQuery_Eq:
+		return c.textCondition(v.Eq.Value, func(term string) (string, []interface{}, tokenMatcher, error) {
+			return "token = ?", []interface{}{term}, equalsMatcher(term), nil
+		})
+
+	case *pb.StrQuery_Phrase:
+		return c.analyzedCondition(v.Phrase.Value, func(terms []string, _ synonymDict) (string, []tokenMatcher, error) {
+			return c.sequenceCondition(terms, true, 0)
+		})
+
+	case *pb.StrQuery_Near:
+		if v.Near.Distance > MaxNearDistance {
+			return "", errors.BadRequest("proximity distance is too high", errors.Details{Key: "max-distance", Value: MaxNearDistance})
+		}
+		return c.analyzedCondition(v.Near.Value, func(terms []string, _ synonymDict) (string, []tokenMatcher, error) {
+			return c.sequenceCondition(terms, false, int(v.Near.Distance))
+		})
+
+	case *pb.StrQuery_Fuzzy:
+		if v.Fuzzy.MaxDistance > MaxFuzzyDistance {
+			return "", errors.BadRequest("fuzzy condition distance is too high", errors.Details{Key: "max-distance", Value: MaxFuzzyDistance})
+		}
+		if c.expandFuzzy == nil {
+			return "", errors.Unsupported("fuzzy conditions require an index store to be compiled")
+		}
+		return c.textCondition(v.Fuzzy.Value, func(term string) (string, []interface{}, tokenMatcher, error) {
+			return c.fuzzyCondition(term, int(v.Fuzzy.MaxDistance))
+		})
+	}
+
+	return "", errors.BadRequest("unsupported text condition")
+}
+
+// termCondition returns the SQL condition selecting the tokens that match term, its parameters and the matcher
+// telling how tokens match it. An empty condition means that no token can match term
+type termCondition func(term string) (expr string, params []interface{}, matcher tokenMatcher, err error)
+
+// textCondition compiles a condition on the distinct terms the analyzers extract from value and on their synonyms.
+// A token matches when it satisfies the condition of one of the terms. Synonyms of several words match as phrases
+func (c *sqlCompiler) textCondition(value string, condition termCondition) (string, error) {
+	return c.analyzedCondition(value, func(analyzed []string, synonyms synonymDict) (string, []tokenMatcher, error) {
+		terms, phrases := synonyms.expand(analyzed)
+
+		var (
+			conditions []string
+			matchers   []tokenMatcher
+		)
+		for _, term := range terms {
+			expr, params, matcher, err := condition(term)
+			if err != nil {
+				return "", nil, err
+			}
+			if expr == "" {
+				continue
+			}
+
+			expr, err = c.condition(expr, params...)
+			if err != nil {
+				return "", nil, err
+			}
+			conditions = append(conditions, expr)
+			matchers = append(matchers, matcher)
+		}
+
+		for _, phrase := range phrases {
+			expr, phraseMatchers, err := c.sequenceCondition(phrase, true, 0)
+			if err != nil {
+				return "", nil, err
+			}
+			conditions = append(conditions, expr)
+			matchers = append(matchers, phraseMatchers...)
+		}
+
+		if len(conditions) == 0 {
+			return "", nil, nil
+		}
+		return c.join("or", conditions), matchers, nil
+	})
+}
+
+// analyzedCondition compiles, for each analyzer group, the condition build returns for the terms the group analyzer
+// extracts from value, restricted to the fields of the group. An empty condition means that no token of the group
+// can match. A value that matches in no group matches nothing
+func (c *sqlCompiler) analyzedCondition(value string, build func(terms []string, synonyms synonymDict) (string, []tokenMatcher, error)) (string, error) {
+	var items []string
+
+	for _, group := range c.groups {
+		terms := group.analyzer.Analyze(value)
+		if len(terms) == 0 {
+			continue
+		}
+
+		expr, matchers, err := build(terms, group.synonyms)
+		if err != nil {
+			return "", err
+		}
+		if expr == "" {
+			continue
+		}
+
+		if len(group.fields) > 0 {
+			operator := "in"
+			if group.exclude {
+				operator = "not in"
+			}
+			expr = "(" + expr + " and field " + operator + " (" + placeholders(len(group.fields)) + "))"
+			for _, field := range group.fields {
+				c.params = append(c.params, field)
+			}
+		}
+		items = append(items, expr)
+
+		fields := map[string]bool{}
+		for _, field := range group.fields {
+			fields[field] = true
+		}
+		c.matchers = append(c.matchers, fieldsMatcher(fields, group.exclude, matchers))
+	}
+
+	if len(items) == 0 {
+		return "(1 = 0)", nil
+	}
+	return c.join("or", items), nil
+}
+
+// sequenceCondition selects the tokens of terms in the texts where all terms occur, either as a phrase when ordered
+// is set, or with at most distance other words between the first and the last of them otherwise.
+// Each term is bound to a distinct occurrence looked up in the positions table
+func (c *sqlCompiler) sequenceCondition(terms []string, ordered bool, distance int) (string, []tokenMatcher, error) {
+	if len(terms) > MaxSequenceTerms {
+		return "", nil, errors.BadRequest("phrase has too many words", errors.Details{Key: "max-words", Value: MaxSequenceTerms})
+	}
+
+	var (
+		params   []interface{}
+		matchers []tokenMatcher
+		seen     = map[string]bool{}
+	)
+	for _, term := range terms {
+		if !seen[term] {
+			seen[term] = true
+			params = append(params, term)
+			matchers = append(matchers, equalsMatcher(term))
+		}
+	}
+	expr := "token in (" + placeholders(len(params)) + ")"
+
+	var joins, conditions []string
+	for i, term := range terms {
+		alias := fmt.Sprintf("p%d", i)
+		if i > 0 {
+			joins = append(joins, "join "+positionsTableName+" as "+alias+" on "+alias+".id = p0.id and "+alias+".field = p0.field")
+		}
+		conditions = append(conditions, alias+".token = ?")
+		params = append(params, term)
+	}
+
+	for i := 1; i < len(terms); i++ {
+		for j := 0; j < i; j++ {
+			pi, pj := fmt.Sprintf("p%d", i), fmt.Sprintf("p%d", j)
+			if ordered {
+				if j == i-1 {
+					conditions = append(conditions, pi+".pos = "+pj+".pos + 1")
+				}
+				continue
+			}
+			conditions = append(conditions, pi+".pos <> "+pj+".pos", "abs("+pi+".pos - "+pj+".pos) <= ?")
+			params = append(params, distance+len(terms)-1)
+		}
+	}
+
+	expr += " and exists (select 1 from " + positionsTableName + " as p0 " + strings.Join(joins, " ") +
+		" where p0.id = " + wordsTableName + ".id and p0.field = " + wordsTableName + ".field and " + strings.Join(conditions, " and ") + ")"
+
+	expr, err := c.condition(expr, params...)
+	if err != nil {
+		return "", nil, err
+	}
+	return expr, matchers, nil
+}
+
+// fuzzyCondition selects the indexed tokens that are at most maxDistance edits away from term, at most
+// MaxFuzzyExpansions of them. A zero maxDistance is chosen from the length of term
+func (c *sqlCompiler) fuzzyCondition(term string, maxDistance int) (string, []interface{}, tokenMatcher, error) {
+	if maxDistance == 0 {
+		maxDistance = autoFuzzyDistance(term)
+	}
+
+	tokens, err := c.expandFuzzy(term, maxDistance)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	if len(tokens) == 0 {
+		return "", nil, nil, nil
+	}
+
+	// each expanded token counts as a condition, the first one is counted with the condition itself
+	for range tokens[1:] {
+		if err := c.count(); err != nil {
+			return "", nil, nil, err
+		}
+	}
+
+	variants := map[string]int{}
+	params := make([]interface{}, len(tokens))
+	for ind, token := range tokens {
+		variants[token] = editDistance(term, token)
+		params[ind] = token
+	}
+	return "token in (" + placeholders(len(tokens)) + ")", params, fuzzyMatcher(term, variants), nil
+}
+
+// placeholders returns a comma separated list of count parameter placeholders
+func placeholders(count int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", count), ", ")
+}
+
+func (c *sqlCompiler) compileNumber(query *pb.NumQuery, depth int) (string, error) {
+	var (
+		operator string
+		value    float64
+		group    string
+		queries  []*pb.NumQuery
+	)
+
+	switch v := query.GetBool().(type) {
+	case *pb.NumQuery_And:
+		group, queries = "and", v.And.Queries
+	case *pb.NumQuery_Or:
+		group, queries = "or", v.Or.Queries
+	case *pb.NumQuery_Not:
+		if err := c.enterGroup(depth, 1); err != nil {
+			return "", err
+		}
+
+		expr, err := c.compileNumber(v.Not.Expressions, depth+1)
+		if err != nil {
+			return "", err
+		}
+		return c.negate(expr), nil
+	case *pb.NumQuery_Eq:
+		operator, value = "=", v.Eq.Value
+	case *pb.NumQuery_Gt:
+		operator, value = ">", v.Gt.Value
+	case *pb.NumQuery_Gte:
+		operator, value = ">=", v.Gte.Value
+	case *pb.NumQuery_Lt:
+		operator, value = "<", v.Lt.Value
+	case *pb.NumQuery_Lte:
+		operator, value = "<=", v.Lte.Value
+	default:
+		return "", errors.BadRequest("unsupported number condition")
+	}
+
+	if operator != "" {
+		return c.condition("num "+operator+" ?", value)
+	}
+
+	if err := c.enterGroup(depth, len(queries)); err != nil {
+		return "", err
+	}
+
+	items := make([]string, len(queries))
+	for ind, q := range queries {
+		expr, err := c.compileNumber(q, depth+1)
+		if err != nil {
+			return "", err
+		}
+		items[ind] = expr
+	}
+	return c.join(group, items), nil
+}
+
+func (c *sqlCompiler) compileGeo(query *pb.GeoQuery, depth int) (string, error) {
+	var (
+		group   string
+		queries []*pb.GeoQuery
+	)
+
+	switch v := query.GetBool().(type) {
+	case *pb.GeoQuery_And:
+		group, queries = "and", v.And.Queries
+	case *pb.GeoQuery_Or:
+		group, queries = "or", v.Or.Queries
+	case *pb.GeoQuery_Not:
+		if err := c.enterGroup(depth, 1); err != nil {
+			return "", err
+		}
+
+		expr, err := c.compileGeo(v.Not.Expressions, depth+1)
+		if err != nil {
+			return "", err
+		}
+		return c.negate(expr), nil
+
+	case *pb.GeoQuery_WithinRadius:
+		radius, err := newRadiusCondition(v.WithinRadius)
+		if err != nil {
+			return "", err
+		}
+
+		// the cells of the primary key only narrow the candidates down, the dot product selects the points
+		var (
+			ranges []string
+			params []interface{}
+		)
+		for _, cell := range radius.cells {
+			ranges = append(ranges, "cell >= ? and cell < ?")
+			params = append(params, cell, cell+"~")
+		}
+		expr := "x * ? + y * ? + z * ? >= ?"
+		params = append(params, radius.x, radius.y, radius.z, radius.minDot)
+		if len(ranges) > 0 {
+			expr = "(" + strings.Join(ranges, " or ") + ") and " + expr
+		}
+		return c.condition(expr, params...)
+
+	case *pb.GeoQuery_WithinBox:
+		box, err := newBoxCondition(v.WithinBox)
+		if err != nil {
+			return "", err
+		}
+
+		if box.crossesAntimeridian() {
+			return c.condition("lat >= ? and lat <= ? and (lon >= ? or lon <= ?)", box.south, box.north, box.west, box.east)
+		}
+		return c.condition("lat >= ? and lat <= ? and lon >= ? and lon <= ?", box.south, box.north, box.west, box.east)
+
+	default:
+		return "", errors.BadRequest("unsupported geo condition")
+	}
+
+	if err := c.enterGroup(depth, len(queries)); err != nil {
+		return "", err
+	}
+
+	items := make([]string, len(queries))
+	for ind, q := range queries {
+		expr, err := c.compileGeo(q, depth+1)
+		if err != nil {
+			return "", err
+		}
+		items[ind] = expr
+	}
+	return c.join(group, items), nil
+}
+
+func (c *sqlCompiler) compileFields(query *pb.FieldQuery, depth int) (string, error) {
+	normalize := propsMappingNormalizer()
+
+	var queries []*pb.FieldQuery
+	var operator string
+
+	switch v := query.GetBool().(type) {
+	case *pb.FieldQuery_And:
+		operator, queries = "and", v.And.Queries
+	case *pb.FieldQuery_Or:
+		operator, queries = "or", v.Or.Queries
+	case *pb.FieldQuery_Not:
+		if err := c.enterGroup(depth, 1); err != nil {
+			return "", err
+		}
+
+		expr, err := c.compileFields(v.Not.Expressions, depth+1)
+		if err != nil {
+			return "", err
+		}
+		return c.negate(expr), nil
+
+	case *pb.FieldQuery_Contains:
+		return c.elementCondition(v.Contains.Field, c.textElement()+" like ? escape '"+likeEscapeChar+"'", "%"+escapeLike(normalize(v.Contains.Value))+"%")
+	case *pb.FieldQuery_StartsWith:
+		return c.elementCondition(v.StartsWith.Field, c.textElement()+" like ? escape '"+likeEscapeChar+"'", escapeLike(normalize(v.StartsWith.Value))+"%")
+	case *pb.FieldQuery_EndsWith:
+		return c.elementCondition(v.EndsWith.Field, c.textElement()+" like ? escape '"+likeEscapeChar+"'", "%"+escapeLike(normalize(v.EndsWith.Value)))
+	case *pb.FieldQuery_StrEqual:
+		return c.elementCondition(v.StrEqual.Field, c.textElement()+" = ?", normalize(v.StrEqual.Value))
+
+	case *pb.FieldQuery_Lt:
+		return c.elementCondition(v.Lt.Field, c.element()+" < ?", v.Lt.Value)
+	case *pb.FieldQuery_Lte:
+		return c.elementCondition(v.Lte.Field, c.element()+" <= ?", v.Lte.Value)
+	case *pb.FieldQuery_Gt:
+		return c.elementCondition(v.Gt.Field, c.element()+" > ?", v.Gt.Value)
+	case *pb.FieldQuery_Gte:
+		return c.elementCondition(v.Gte.Field, c.element()+" >= ?", v.Gte.Value)
+	case *pb.FieldQuery_NumbEq:
+		return c.elementCondition(v.NumbEq.Field, c.element()+" = ?", v.NumbEq.Value)
+
+	case *pb.FieldQuery_BoolEq:
+		cmp, value := c.boolElement(v.BoolEq.Value)
+		return c.elementCondition(v.BoolEq.Field, cmp, value)
+	case *pb.FieldQuery_IsNull:
+		if c.dialect == bome.MySQL {
+			return c.elementCondition(v.IsNull.Field, "json_type(e.v) = ?", "NULL")
+		}
+		return c.elementCondition(v.IsNull.Field, "e.type = ?", "null")
+
+	// sqlite json_type returns null for missing members. Mysql json_extract returns json null values instead of sql nulls
+	case *pb.FieldQuery_Exists:
+		if c.dialect == bome.MySQL {
+			return c.propertyCondition(v.Exists.Field, "json_extract(value, ?) is not null")
+		}
+		return c.propertyCondition(v.Exists.Field, "json_type(value, ?) is not null")
+
+	case *pb.FieldQuery_In:
+		return c.listCondition(v.In.Field, v.In.Values, listIn)
+	case *pb.FieldQuery_AnyOf:
+		return c.listCondition(v.AnyOf.Field, v.AnyOf.Values, listAnyOf)
+	case *pb.FieldQuery_AllOf:
+		return c.listCondition(v.AllOf.Field, v.AllOf.Values, listAllOf)
+
+	default:
+		return "", errors.BadRequest("unsupported field condition")
+	}
+
+	if err := c.enterGroup(depth, len(queries)); err != nil {
+		return "", err
+	}
+
+	items := make([]string, len(queries))
+	for ind, q := range queries {
+		expr, err := c.compileFields(q, depth+1)
+		if err != nil {
+			return "", err
+		}
+		items[ind] = expr
+	}
+	return c.join(operator, items), nil
+}
+
+// propertyCondition compiles the condition expr on an indexed property. The first placeholder of expr is bound to
+// the JSON path of the property, the other ones to values
+func (c *sqlCompiler) propertyCondition(field string, expr string, values ...interface{}) (string, error) {
+	if err := c.property(field); err != nil {
+		return "", err
+	}
+
+	c.params = append(c.params, jsonPath(field))
+	return c.condition(expr, values...)
+}
+
+func (c *sqlCompiler) property(field string) error {
+	if !c.fields[field] {
+		return errors.BadRequest("search query references a field that is not indexed", errors.Details{Key: "field", Value: field})
+	}
+	return nil
+}
+
+// elementCondition compiles a condition that matches when the comparison cmp holds for one of the values of an
+// indexed property: the elements of arrays or the value itself. The placeholders of cmp are bound to values
+func (c *sqlCompiler) elementCondition(field string, cmp string, values ...interface{}) (string, error) {
+	if err := c.property(field); err != nil {
+		return "", err
+	}
+
+	expr, params := c.someElement(field, cmp, values...)
+	return c.condition(expr, params...)
+}
+
+// someElement returns an expression telling whether cmp holds for one of the values of a property, and its params.
+// Sqlite json_each iterates over the elements of arrays and over scalars as if they were single element arrays. The
+// props value is qualified since json_each also has a value column. Mysql json_table only iterates over arrays,
+// scalars are merged into one
+func (c *sqlCompiler) someElement(field string, cmp string, values ...interface{}) (string, []interface{}) {
+	from := "json_each(" + propsTableName + ".value, ?) as e"
+	if c.dialect == bome.MySQL {
+		from = mysqlElementsTable
+	}
+
+	expr := "exists (select 1 from " + from
+	if cmp != "" {
+		expr += " where " + cmp
+	}
+	return expr + ")", append([]interface{}{jsonPath(field)}, values...)
+}
+
+// element is the value of a property element compared to numbers
+func (c *sqlCompiler) element() string {
+	if c.dialect == bome.MySQL {
+		return "e.v"
+	}
+	return "e.value"
+}
+
+// textElement is the value of a property element compared to strings
+func (c *sqlCompiler) textElement() string {
+	if c.dialect == bome.MySQL {
+		return "json_unquote(e.v)"
+	}
+	return "e.value"
+}
+
+// boolElement returns the comparison of a property element with value. Sqlite json_each tells true from false in
+// the element type
+func (c *sqlCompiler) boolElement(value bool) (string, interface{}) {
+	if c.dialect == bome.MySQL {
+		return "e.v = cast(? as json)", strconv.FormatBool(value)
+	}
+	return "e.type = ?", strconv.FormatBool(value)
+}
+
+type listMatch int
+
+const (
+	listIn listMatch = iota
+	listAnyOf
+	listAllOf
+)
+
+// listCondition compiles an in, any_of or all_of condition on the values of an indexed property
+func (c *sqlCompiler) listCondition(field string, values []*pb.Value, match listMatch) (string, error) {
+	if err := c.property(field); err != nil {
+		return "", err
+	}
+
+	if err := checkListValues(field, values); err != nil {
+		return "", err
+	}
+
+	normalize := propsMappingNormalizer()
+	comparisons := make([]string, len(values))
+	params := make([]interface{}, len(values))
+	for ind, value := range values {
+		switch v := value.Value.(type) {
+		case *pb.Value_Text:
+			comparisons[ind], params[ind] = c.textElement()+" = ?", normalize(v.Text)
+		case *pb.Value_Number:
+			comparisons[ind], params[ind] = c.element()+" = ?", v.Number
+		case *pb.Value_Boolean:
+			comparisons[ind], params[ind] = c.boolElement(v.Boolean)
+		}
+	}
+
+	switch match {
+	case listIn:
+		// there is a value and none of them differs from all the list values
+		some, someParams := c.someElement(field, "")
+		other, otherParams := c.someElement(field, c.negate(c.join("or", comparisons)), params...)
+		return c.condition(some+" and not "+other, append(someParams, otherParams...)...)
+
+	case listAnyOf:
+		expr, exprParams := c.someElement(field, c.join("or", comparisons), params...)
+		return c.condition(expr, exprParams...)
+
+	default:
+		var all []interface{}
+		for ind, comparison := range comparisons {
+			expr, exprParams := c.someElement(field, comparison, params[ind])
+			comparisons[ind] = expr
+			all = append(all, exprParams...)
+		}
+		return c.condition(c.join("and", comparisons), all...)
+	}
+}
+
+// jsonPath returns the JSON path selecting the top level member named field
+func jsonPath(field string) string {
+	return `$."` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(field) + `"`
+}
+
+// escapeLike escapes the LIKE wildcards in value, so that they are matched literally
+func escapeLike(value string) string {
+	return strings.NewReplacer(likeEscapeChar, likeEscapeChar+likeEscapeChar, "%", likeEscapeChar+"%", "_", likeEscapeChar+"_").Replace(value)
+}
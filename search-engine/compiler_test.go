@@ -0,0 +1,354 @@
+package se
+
+import (
+	"database/sql"
+	"github.com/omecodes/bome"
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var declaredFields = []string{"name", "age", "club"}
+
+func newTestSQLStore() Store {
+	conn, err := sql.Open(bome.SQLite3, ":memory:")
+	So(err, ShouldBeNil)
+
+	store, err := NewSQLIndexStore(conn, bome.SQLite3, "test_index")
+	So(err, ShouldBeNil)
+	return store
+}
+
+func searchIDs(store Store, query *pb.SearchQuery) ([]string, error) {
+	c, err := store.Search(query, SearchOptions{Fields: declaredFields})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = c.Close()
+	}()
+
+	var ids []string
+	for {
+		hit, err := c.Next()
+		if err == io.EOF {
+			sort.Strings(ids)
+			return ids, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, hit.Id)
+	}
+}
+
+func fieldsQuery(q *pb.FieldQuery) *pb.SearchQuery {
+	return &pb.SearchQuery{Query: &pb.SearchQuery_Fields{Fields: q}}
+}
+
+func TestCompileQuery(t *testing.T) {
+	Convey("Values are bound as parameters", t, func() {
+		q, err := ParseQuery(`name = "x' or '1'='1" and age > 3`)
+		So(err, ShouldBeNil)
+
+		for _, dialect := range []string{bome.SQLite3, bome.MySQL} {
+			compiled, err := CompileQuery(dialect, q, SearchOptions{Fields: declaredFields})
+			So(err, ShouldBeNil)
+			So(compiled.SQL, ShouldNotContainSubstring, "1'='1")
+			So(strings.Count(compiled.SQL, "?"), ShouldEqual, len(compiled.Params))
+			So(compiled.Params, ShouldResemble, []interface{}{`$."name"`, "x or 11", `$."age"`, float64(3)})
+		}
+	})
+
+	Convey("Conditions on fields that are not indexed are rejected", t, func() {
+		q, err := ParseQuery(`name = "a" or salary > 10`)
+		So(err, ShouldBeNil)
+
+		_, err = CompileQuery(bome.SQLite3, q, SearchOptions{Fields: declaredFields})
+		So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+	})
+
+	Convey("Query depth and number of terms are limited", t, func() {
+		q, err := ParseQuery(`age = 1 or (age = 2 and (age = 3 or age = 4))`)
+		So(err, ShouldBeNil)
+
+		_, err = CompileQuery(bome.SQLite3, q, SearchOptions{Fields: declaredFields, MaxDepth: 3})
+		So(err, ShouldBeNil)
+
+		_, err = CompileQuery(bome.SQLite3, q, SearchOptions{Fields: declaredFields, MaxDepth: 2})
+		So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+
+		_, err = CompileQuery(bome.SQLite3, q, SearchOptions{Fields: declaredFields, MaxTerms: 3})
+		So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+	})
+
+	Convey("Empty groups and unsupported dialects are rejected", t, func() {
+		_, err := CompileQuery(bome.SQLite3, fieldsQuery(&pb.FieldQuery{Bool: &pb.FieldQuery_Or{Or: &pb.Or{}}}), SearchOptions{})
+		So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+
+		_, err = CompileQuery("postgres", fieldsQuery(&pb.FieldQuery{}), SearchOptions{})
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestSQLStore_Search(t *testing.T) {
+	Convey("Compiled queries are evaluated against the index tables", t, func() {
+		store := newTestSQLStore()
+		So(store.SavePropertiesMapping("p1", `{"name": "paulo", "age": 27, "club": "juventus"}`), ShouldBeNil)
+		So(store.SavePropertiesMapping("p2", `{"name": "cristiano", "age": 36, "club": "man_utd"}`), ShouldBeNil)
+		So(store.SavePropertiesMapping("p3", `{"name": "kylian", "age": 22, "club": "psg"}`), ShouldBeNil)
+		So(store.SaveNumberMapping(27, "p1"), ShouldBeNil)
+		So(store.SaveNumberMapping(36, "p2"), ShouldBeNil)
+		So(store.SaveTextMapping("p1", "club", []string{"juventus"}), ShouldBeNil)
+		So(store.SaveTextMapping("p3", "club", []string{"psg"}), ShouldBeNil)
+
+		cases := map[string][]string{
+			`age > 25`:                                  {"p1", "p2"},
+			`club endswith "us" or name = "kylian"`:     {"p1", "p3"},
+			`name startswith "cri" and age >= 36`:       {"p2"},
+			`club contains "ven"`:                       {"p1"},
+			`name = "x' or '1'='1"`:                     nil,
+			`$number < 30`:                              {"p1"},
+			`$text = "psg" or $text endswith "ntus"`:    {"p1", "p3"},
+			`club != "psg"`:                             {"p1", "p2"},
+			`not (age > 30 or club contains "ven")`:     {"p3"},
+			`not $number = 27`:                          {"p2"},
+			`not $text = "psg"`:                         {"p1"},
+			`$text = "psg" or not $text contains "ntu"`: {"p3"},
+		}
+
+		for text, expected := range cases {
+			q, err := ParseQuery(text)
+			So(err, ShouldBeNil)
+
+			ids, err := searchIDs(store, q)
+			So(err, ShouldBeNil)
+			So(ids, ShouldResemble, expected)
+		}
+	})
+}
+
+// queryFuzzer generates random search queries mixing declared and unknown fields, SQL metacharacters and deep nesting
+type queryFuzzer struct {
+	rand *rand.Rand
+}
+
+var fuzzStrings = []string{"", "a", "'", `"`, `\`, "%", "_", "!", "--", "/*", ";", "' or 1=1 --", `") or ("1"="1`, "été", "\x00", "$.name", "?"}
+
+func (f *queryFuzzer) value() string {
+	var sb strings.Builder
+	for i := f.rand.Intn(4); i >= 0; i-- {
+		sb.WriteString(fuzzStrings[f.rand.Intn(len(fuzzStrings))])
+	}
+	return sb.String()
+}
+
+func (f *queryFuzzer) field() string {
+	if f.rand.Intn(8) == 0 {
+		return f.value()
+	}
+	return declaredFields[f.rand.Intn(len(declaredFields))]
+}
+
+func (f *queryFuzzer) number() float64 {
+	return float64(f.rand.Int63()-f.rand.Int63()) / float64(1+f.rand.Intn(1000))
+}
+
+func (f *queryFuzzer) values() []*pb.Value {
+	values := make([]*pb.Value, f.rand.Intn(4))
+	for i := range values {
+		switch f.rand.Intn(3) {
+		case 0:
+			values[i] = &pb.Value{Value: &pb.Value_Text{Text: f.value()}}
+		case 1:
+			values[i] = &pb.Value{Value: &pb.Value_Number{Number: f.number()}}
+		default:
+			values[i] = &pb.Value{Value: &pb.Value_Boolean{Boolean: f.rand.Intn(2) == 0}}
+		}
+	}
+	return values
+}
+
+func (f *queryFuzzer) fieldQuery(depth int) *pb.FieldQuery {
+	switch n := f.rand.Intn(18); {
+	case n == 11 && depth < 20:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_Not{Not: &pb.Not{Expressions: f.fieldQuery(depth + 1)}}}
+	case n == 12:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_BoolEq{BoolEq: &pb.BoolEq{Field: f.field(), Value: f.rand.Intn(2) == 0}}}
+	case n == 13:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_IsNull{IsNull: &pb.IsNull{Field: f.field()}}}
+	case n == 14:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_Exists{Exists: &pb.Exists{Field: f.field()}}}
+	case n == 15:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_In{In: &pb.In{Field: f.field(), Values: f.values()}}}
+	case n == 16:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_AnyOf{AnyOf: &pb.AnyOf{Field: f.field(), Values: f.values()}}}
+	case n == 17:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_AllOf{AllOf: &pb.AllOf{Field: f.field(), Values: f.values()}}}
+	case n < 2 && depth < 20:
+		queries := make([]*pb.FieldQuery, f.rand.Intn(4))
+		for i := range queries {
+			queries[i] = f.fieldQuery(depth + 1)
+		}
+		if n == 0 {
+			return &pb.FieldQuery{Bool: &pb.FieldQuery_And{And: &pb.And{Queries: queries}}}
+		}
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_Or{Or: &pb.Or{Queries: queries}}}
+	case n == 2:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_Contains{Contains: &pb.Contains{Field: f.field(), Value: f.value()}}}
+	case n == 3:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_StartsWith{StartsWith: &pb.StartsWith{Field: f.field(), Value: f.value()}}}
+	case n == 4:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_EndsWith{EndsWith: &pb.EndsWith{Field: f.field(), Value: f.value()}}}
+	case n == 5:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_StrEqual{StrEqual: &pb.StrEqual{Field: f.field(), Value: f.value()}}}
+	case n == 6:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_Lt{Lt: &pb.Lt{Field: f.field(), Value: f.number()}}}
+	case n == 7:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_Lte{Lte: &pb.Lte{Field: f.field(), Value: f.number()}}}
+	case n == 8:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_Gt{Gt: &pb.Gt{Field: f.field(), Value: f.number()}}}
+	case n == 9:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_Gte{Gte: &pb.Gte{Field: f.field(), Value: f.number()}}}
+	default:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_NumbEq{NumbEq: &pb.NumbEq{Field: f.field(), Value: f.number()}}}
+	}
+}
+
+func (f *queryFuzzer) textQuery(depth int) *pb.StrQuery {
+	switch n := f.rand.Intn(6); {
+	case n == 5 && depth < 20:
+		return &pb.StrQuery{Bool: &pb.StrQuery_Not{Not: &pb.StrNot{Expressions: f.textQuery(depth + 1)}}}
+	case n == 0 && depth < 20:
+		queries := make([]*pb.StrQuery, f.rand.Intn(4))
+		for i := range queries {
+			queries[i] = f.textQuery(depth + 1)
+		}
+		return &pb.StrQuery{Bool: &pb.StrQuery_Or{Or: &pb.StrOr{Queries: queries}}}
+	case n == 1:
+		return &pb.StrQuery{Bool: &pb.StrQuery_Contains{Contains: &pb.Contains{Value: f.value()}}}
+	case n == 2:
+		return &pb.StrQuery{Bool: &pb.StrQuery_StartsWith{StartsWith: &pb.StartsWith{Value: f.value()}}}
+	case n == 3:
+		return &pb.StrQuery{Bool: &pb.StrQuery_EndsWith{EndsWith: &pb.EndsWith{Value: f.value()}}}
+	default:
+		return &pb.StrQuery{Bool: &pb.StrQuery_Eq{Eq: &pb.StrEqual{Value: f.value()}}}
+	}
+}
+
+func (f *queryFuzzer) numberQuery(depth int) *pb.NumQuery {
+	switch n := f.rand.Intn(8); {
+	case n == 7 && depth < 20:
+		return &pb.NumQuery{Bool: &pb.NumQuery_Not{Not: &pb.NumNot{Expressions: f.numberQuery(depth + 1)}}}
+	case n < 2 && depth < 20:
+		queries := make([]*pb.NumQuery, f.rand.Intn(4))
+		for i := range queries {
+			queries[i] = f.numberQuery(depth + 1)
+		}
+		if n == 0 {
+			return &pb.NumQuery{Bool: &pb.NumQuery_And{And: &pb.NumAnd{Queries: queries}}}
+		}
+		return &pb.NumQuery{Bool: &pb.NumQuery_Or{Or: &pb.NumOr{Queries: queries}}}
+	case n == 2:
+		return &pb.NumQuery{Bool: &pb.NumQuery_Gt{Gt: &pb.Gt{Value: f.number()}}}
+	case n == 3:
+		return &pb.NumQuery{Bool: &pb.NumQuery_Gte{Gte: &pb.Gte{Value: f.number()}}}
+	case n == 4:
+		return &pb.NumQuery{Bool: &pb.NumQuery_Lt{Lt: &pb.Lt{Value: f.number()}}}
+	case n == 5:
+		return &pb.NumQuery{Bool: &pb.NumQuery_Lte{Lte: &pb.Lte{Value: f.number()}}}
+	default:
+		return &pb.NumQuery{Bool: &pb.NumQuery_Eq{Eq: &pb.NumbEq{Value: f.number()}}}
+	}
+}
+
+func (f *queryFuzzer) point() *pb.GeoPoint {
+	if f.rand.Intn(20) == 0 {
+		return &pb.GeoPoint{Lat: f.number(), Lon: f.number()}
+	}
+	return &pb.GeoPoint{Lat: f.rand.Float64()*180 - 90, Lon: f.rand.Float64()*360 - 180}
+}
+
+func (f *queryFuzzer) geoQuery(depth int) *pb.GeoQuery {
+	switch n := f.rand.Intn(6); {
+	case n == 5 && depth < 20:
+		return &pb.GeoQuery{Bool: &pb.GeoQuery_Not{Not: &pb.GeoNot{Expressions: f.geoQuery(depth + 1)}}}
+	case n < 2 && depth < 20:
+		queries := make([]*pb.GeoQuery, f.rand.Intn(4))
+		for i := range queries {
+			queries[i] = f.geoQuery(depth + 1)
+		}
+		if n == 0 {
+			return &pb.GeoQuery{Bool: &pb.GeoQuery_And{And: &pb.GeoAnd{Queries: queries}}}
+		}
+		return &pb.GeoQuery{Bool: &pb.GeoQuery_Or{Or: &pb.GeoOr{Queries: queries}}}
+	case n == 2:
+		southWest, northEast := f.point(), f.point()
+		if f.rand.Intn(4) != 0 && southWest.Lat > northEast.Lat {
+			southWest.Lat, northEast.Lat = northEast.Lat, southWest.Lat
+		}
+		return &pb.GeoQuery{Bool: &pb.GeoQuery_WithinBox{WithinBox: &pb.WithinBox{SouthWest: southWest, NorthEast: northEast}}}
+	default:
+		meters := math.Pow(10, f.rand.Float64()*8)
+		if f.rand.Intn(20) == 0 {
+			meters = f.number()
+		}
+		return &pb.GeoQuery{Bool: &pb.GeoQuery_WithinRadius{WithinRadius: &pb.WithinRadius{Center: f.point(), Meters: meters}}}
+	}
+}
+
+func (f *queryFuzzer) query() *pb.SearchQuery {
+	switch f.rand.Intn(4) {
+	case 3:
+		return &pb.SearchQuery{Query: &pb.SearchQuery_Geo{Geo: f.geoQuery(1)}}
+	case 0:
+		return &pb.SearchQuery{Query: &pb.SearchQuery_Text{Text: f.textQuery(1)}}
+	case 1:
+		return &pb.SearchQuery{Query: &pb.SearchQuery_Number{Number: f.numberQuery(1)}}
+	default:
+		return fieldsQuery(f.fieldQuery(1))
+	}
+}
+
+func TestCompileQuery_Fuzz(t *testing.T) {
+	Convey("Random queries either compile to parameterized SQL or are rejected as bad requests", t, func() {
+		store := newTestSQLStore()
+		So(store.SavePropertiesMapping("p1", `{"name": "paulo", "age": 27, "club": "juventus"}`), ShouldBeNil)
+
+		fuzzer := &queryFuzzer{rand: rand.New(rand.NewSource(42))}
+		accepted := 0
+
+		for i := 0; i < 2000; i++ {
+			query := fuzzer.query()
+
+			for _, dialect := range []string{bome.SQLite3, bome.MySQL} {
+				compiled, err := CompileQuery(dialect, query, SearchOptions{Fields: declaredFields, MaxDepth: 6, MaxTerms: 24})
+				if err != nil {
+					So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+					continue
+				}
+
+				// the only literals allowed in the SQL text are the LIKE escape character and the mysql json_table paths
+				sqlText := strings.Replace(compiled.SQL, "escape '"+likeEscapeChar+"'", "", -1)
+				sqlText = strings.NewReplacer("'$[*]'", "", "'$'", "").Replace(sqlText)
+				So(sqlText, ShouldNotContainSubstring, "'")
+				So(sqlText, ShouldNotContainSubstring, `"`)
+				So(strings.Count(sqlText, "?"), ShouldEqual, len(compiled.Params))
+			}
+
+			if _, err := CompileQuery(bome.SQLite3, query, SearchOptions{Fields: declaredFields, MaxDepth: 6, MaxTerms: 24}); err == nil {
+				accepted++
+				_, err = searchIDs(store, query)
+				So(err, ShouldBeNil)
+			}
+		}
+		So(accepted, ShouldBeGreaterThan, 100)
+	})
+}
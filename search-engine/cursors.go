@@ -2,6 +2,7 @@ package se
 
 import (
 	"github.com/omecodes/bome"
+	pb "github.com/omecodes/store/gen/go/proto"
 	"io"
 	"strings"
 )
@@ -11,16 +12,16 @@ type aggregatedStrIdsCursor struct {
 	currentList []string
 }
 
-func (c *aggregatedStrIdsCursor) Next() (string, error) {
+func (c *aggregatedStrIdsCursor) Next() (*pb.SearchHit, error) {
 	for {
 		if len(c.currentList) == 0 {
 			if !c.cursor.HasNext() {
-				return "", io.EOF
+				return nil, io.EOF
 			}
 
 			o, err := c.cursor.Next()
 			if err != nil {
-				return "", err
+				return nil, err
 			}
 
 			c.currentList = strings.Split(o.(string), "<>")
@@ -32,7 +33,7 @@ func (c *aggregatedStrIdsCursor) Next() (string, error) {
 		}
 
 		c.currentList = c.currentList[1:]
-		return next, nil
+		return &pb.SearchHit{Id: next}, nil
 	}
 }
 
@@ -44,55 +45,35 @@ type dbStringCursorWrapper struct {
 	cursor bome.Cursor
 }
 
-func (c *dbStringCursorWrapper) Next() (string, error) {
+func (c *dbStringCursorWrapper) Next() (*pb.SearchHit, error) {
 	if c.cursor.HasNext() {
 		o, err := c.cursor.Next()
 		if err == nil {
-			return o.(string), nil
+			return &pb.SearchHit{Id: o.(string)}, nil
 		}
-		return "", err
+		return nil, err
 	}
-	return "", io.EOF
+	return nil, io.EOF
 }
 
 func (c *dbStringCursorWrapper) Close() error {
 	return c.cursor.Close()
 }
 
-type dbMapEntryCursorWrapper struct {
-	cursor bome.Cursor
-}
-
-func (c *dbMapEntryCursorWrapper) Next() (string, error) {
-	if c.cursor.HasNext() {
-		o, err := c.cursor.Next()
-		if err == nil {
-			entry := o.(*bome.MapEntry)
-			return entry.Value, nil
-		}
-		return "", err
-	}
-	return "", io.EOF
-}
-
-func (c *dbMapEntryCursorWrapper) Close() error {
-	return c.cursor.Close()
-}
-
-type idListCursor struct {
-	ids []string
-	pos int
+type hitListCursor struct {
+	hits []*pb.SearchHit
+	pos  int
 }
 
-func (c *idListCursor) Next() (string, error) {
-	if c.pos < len(c.ids) {
-		id := c.ids[c.pos]
+func (c *hitListCursor) Next() (*pb.SearchHit, error) {
+	if c.pos < len(c.hits) {
+		hit := c.hits[c.pos]
 		c.pos++
-		return id, nil
+		return hit, nil
 	}
-	return "", io.EOF
+	return nil, io.EOF
 }
 
-func (c *idListCursor) Close() error {
+func (c *hitListCursor) Close() error {
 	return nil
 }
@@ -10,18 +10,61 @@ import (
 	"strings"
 )
 
+// Service indexes objects and searches them. Engines implement it, as well as the clients of remote search engines
+type Service interface {
+	// Feed applies an index change
+	Feed(msg *pb.MessageFeed) error
+
+	// Flush returns once the changes fed before it are stored durably
+	Flush() error
+
+	// Search returns the hits of objects matching query, ordered by decreasing relevance, or by increasing distance
+	// to the origin of opts when it is set
+	Search(query *pb.SearchQuery, opts SearchOptions) ([]*pb.SearchHit, error)
+
+	// Facets counts the values of the facets fields over all the objects matching query
+	Facets(query *pb.SearchQuery, opts SearchOptions, facets []*pb.Facet) ([]*pb.FacetResult, error)
+
+	// Suggest returns the terms indexed for field that complete prefix, the ones indexed for the most objects first
+	Suggest(field string, prefix string, opts SuggestOptions) ([]*pb.Suggestion, error)
+
+	// MappedIDs returns, in increasing order, at most count ids greater than after of the objects that have mappings
+	MappedIDs(after string, count int) ([]string, error)
+
+	// UnmappedIDs returns the ids among ids of the objects that have no mapping
+	UnmappedIDs(ids []string) ([]string, error)
+
+	// ObjectTokens returns the tokens indexed for the text fields of the object id
+	ObjectTokens(id string) ([]*pb.FieldTokens, error)
+
+	// TokenDocuments returns the postings of token for a page of the objects it is indexed for, ordered by id then field
+	TokenDocuments(token string, opts TokenDocumentsOptions) ([]*pb.TokenPosting, error)
+
+	// Analyze returns the tokens the analyzer registered under analyzer extracts from text, in text order
+	Analyze(analyzer string, text string) ([]string, error)
+
+	// Explain evaluates query and describes how it was compiled and how the hits were scored
+	Explain(query *pb.SearchQuery, opts SearchOptions) (*pb.SearchExplanation, error)
+}
+
+// MaxMappedIDs is the maximum number of ids listed or looked up at once by MappedIDs and UnmappedIDs
+const MaxMappedIDs = 1000
+
+// MappingScanBatchSize is the number of ids an index check, or its repair, looks up in the mapping or objects tables
+// by a single query
+const MappingScanBatchSize = 500
+
 func NewEngine(store Store) *Engine {
 	return &Engine{
-		store:     store,
-		tokenizer: &textTokenizer{},
+		store: store,
 	}
 }
 
 type Engine struct {
-	tokenizer TextTokenizer
-	store     Store
+	store Store
 }
 
+// Feed applies the index change msg holds. Its collection is ignored: an engine indexes a single collection
 func (e *Engine) Feed(msg *pb.MessageFeed) error {
 	switch m := msg.Message.(type) {
 	case *pb.MessageFeed_TextMapping:
@@ -30,30 +73,39 @@ func (e *Engine) Feed(msg *pb.MessageFeed) error {
 	case *pb.MessageFeed_NumMapping:
 		return e.CreateNumberMapping(m.NumMapping)
 
+	case *pb.MessageFeed_PropertiesMapping:
+		return e.CreatePropertiesMapping(m.PropertiesMapping)
+
+	case *pb.MessageFeed_GeoMapping:
+		return e.CreateGeoMapping(m.GeoMapping)
+
 	case *pb.MessageFeed_Delete:
+		return e.DeleteObjectMappings(m.Delete.Id)
 	}
 
-	return nil
+	return errors.BadRequest("unsupported feed message")
 }
 
+// CreateTextMapping indexes the terms the mapping analyzer extracts from the mapping text, with their positions.
+// When a prefix mapping size is set, the beginning of the analyzed text is indexed as an additional term that has
+// no position
 func (e *Engine) CreateTextMapping(mapping *pb.TextMapping) error {
-	textAnalyzer := defaultTextAnalyzer()
-	analyzedText := textAnalyzer(mapping.Text)
-
-	stream := e.tokenizer.TokenizeText(analyzedText, mapping.PrefixMappingSize)
+	analyzer, err := GetAnalyzer(mapping.Analyzer)
+	if err != nil {
+		return err
+	}
 
-	var err error
-	for {
-		token := stream.Next()
-		if token == "" {
-			return nil
-		}
+	tokens := analyzer.Analyze(mapping.Text)
 
-		err = e.store.SaveWordMapping(token, mapping.ObjectId)
-		if err != nil {
-			return err
+	var terms []string
+	if mapping.PrefixMappingSize > 0 && len(tokens) > 1 {
+		prefix := []rune(strings.Join(tokens, " "))
+		if len(prefix) > int(mapping.PrefixMappingSize) {
+			prefix = prefix[:mapping.PrefixMappingSize]
 		}
+		terms = append(terms, string(prefix))
 	}
+	return e.store.SaveTextMapping(mapping.ObjectId, mapping.Name, tokens, terms...)
 }
 
 func (e *Engine) CreatePropertiesMapping(mapping *pb.PropertiesMapping) error {
@@ -63,10 +115,17 @@ func (e *Engine) CreatePropertiesMapping(mapping *pb.PropertiesMapping) error {
 		return errors.BadRequest(err.Error())
 	}
 
+	normalize := propsMappingNormalizer()
 	for key, value := range props {
-		if str, ok := value.(string); ok {
-			textAnalyzer := propsMappingTextAnalyzer()
-			props[key] = textAnalyzer(str)
+		switch v := value.(type) {
+		case string:
+			props[key] = normalize(v)
+		case []interface{}:
+			for ind, element := range v {
+				if str, ok := element.(string); ok {
+					v[ind] = normalize(str)
+				}
+			}
 		}
 	}
 
@@ -82,12 +141,28 @@ func (e *Engine) CreateNumberMapping(m *pb.NumberMapping) error {
 	return e.store.SaveNumberMapping(m.Number, m.ObjectId)
 }
 
+// CreateGeoMapping indexes the point of the mapping for its object, in addition to the ones already indexed
+func (e *Engine) CreateGeoMapping(m *pb.GeoMapping) error {
+	if err := ValidateGeoPoint(m.Point); err != nil {
+		return err
+	}
+	return e.store.SaveGeoMapping(m.ObjectId, m.Point)
+}
+
 func (e *Engine) DeleteObjectMappings(id string) error {
 	return e.store.DeleteObjectMappings(id)
 }
 
-func (e *Engine) Search(query *pb.SearchQuery) ([]string, error) {
-	c, err := e.store.Search(query)
+// Facets counts the values of the facets fields over all the objects matching query. String values are counted in
+// their normalized form, the one conditions on properties compare to
+func (e *Engine) Facets(query *pb.SearchQuery, opts SearchOptions, facets []*pb.Facet) ([]*pb.FacetResult, error) {
+	return e.store.Facets(query, opts, facets)
+}
+
+// Search returns the hits of objects matching query, ordered by decreasing relevance, or by increasing distance to
+// the origin of opts when it is set
+func (e *Engine) Search(query *pb.SearchQuery, opts SearchOptions) ([]*pb.SearchHit, error) {
+	c, err := e.store.Search(query, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -98,10 +173,11 @@ func (e *Engine) Search(query *pb.SearchQuery) ([]string, error) {
 		}
 	}()
 
-	records := &scoreRecords{}
+	var hits []*pb.SearchHit
+	found := map[string]*pb.SearchHit{}
 
 	for {
-		value, err := c.Next()
+		hit, err := c.Next()
 		if err == io.EOF {
 			break
 		}
@@ -110,9 +186,134 @@ func (e *Engine) Search(query *pb.SearchQuery) ([]string, error) {
 			return nil, err
 		}
 
-		ids := strings.Split(value, " ")
-		records.append(ids...)
+		if previous, ok := found[hit.Id]; ok {
+			if hit.Score > previous.Score {
+				previous.Score = hit.Score
+			}
+			continue
+		}
+		found[hit.Id] = hit
+		hits = append(hits, hit)
+	}
+
+	sortHits(hits)
+
+	if opts.Origin != nil {
+		if err = ValidateGeoPoint(opts.Origin); err != nil {
+			return nil, err
+		}
+
+		ids := make([]string, len(hits))
+		for ind, hit := range hits {
+			ids[ind] = hit.Id
+		}
+		points, err := e.store.GeoPoints(ids)
+		if err != nil {
+			return nil, err
+		}
+		sortHitsByDistance(hits, points, opts.Origin)
+	}
+	return hits, nil
+}
+
+// MappedIDs lists the ids of the indexed objects by batches. Consistency checks compare them to the stored objects
+func (e *Engine) MappedIDs(after string, count int) ([]string, error) {
+	if count <= 0 || count > MaxMappedIDs {
+		return nil, errors.BadRequest("count of listed ids is out of range", errors.Details{Key: "max", Value: MaxMappedIDs})
 	}
+	return e.store.MappedIDs(after, count)
+}
 
-	return records.sorted(), nil
+// Flush makes the changes fed before it durable, committing the stores that write them in the background
+func (e *Engine) Flush() error {
+	return e.store.Flush()
+}
+
+// PendingReindex tells whether the objects must be indexed again, the store having been migrated from a layout whose
+// mappings could not be converted
+func (e *Engine) PendingReindex() bool {
+	m, ok := e.store.(Migrator)
+	return ok && m.PendingReindex()
+}
+
+// Reindexed tells the store the objects were indexed again
+func (e *Engine) Reindexed() error {
+	if m, ok := e.store.(Migrator); ok {
+		return m.Reindexed()
+	}
+	return nil
+}
+
+func (e *Engine) UnmappedIDs(ids []string) ([]string, error) {
+	if len(ids) > MaxMappedIDs {
+		return nil, errors.BadRequest("too many ids", errors.Details{Key: "max", Value: MaxMappedIDs})
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return e.store.UnmappedIDs(ids)
+}
+
+func (e *Engine) ObjectTokens(id string) ([]*pb.FieldTokens, error) {
+	if id == "" {
+		return nil, errors.BadRequest("requires an object id")
+	}
+	return e.store.ObjectTokens(id)
+}
+
+// TokenDocuments looks token up as it is indexed: it is not analyzed
+func (e *Engine) TokenDocuments(token string, opts TokenDocumentsOptions) ([]*pb.TokenPosting, error) {
+	if token == "" {
+		return nil, errors.BadRequest("requires a token")
+	}
+
+	opts, err := validateTokenDocumentsOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	return e.store.TokenDocuments(token, opts.Field, opts.After, opts.Count)
+}
+
+// Analyze returns the tokens texts indexed with the analyzer are indexed with, and text query values analyzed with
+// it are searched with. An empty analyzer refers to the default analyzer
+func (e *Engine) Analyze(analyzer string, text string) ([]string, error) {
+	a, err := GetAnalyzer(analyzer)
+	if err != nil {
+		return nil, err
+	}
+	return a.Analyze(text), nil
+}
+
+// Explain evaluates query like Search, then lists the terms its text conditions look for. Hits are not sorted by
+// distance: their scores are explained
+func (e *Engine) Explain(query *pb.SearchQuery, opts SearchOptions) (*pb.SearchExplanation, error) {
+	explanation, err := e.store.Explain(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation.Conditions, err = explainConditions(query, opts)
+	if err != nil {
+		return nil, err
+	}
+	return explanation, nil
+}
+
+// Suggest returns the terms indexed for field that complete prefix, the ones indexed for the most objects first.
+// The prefix is analyzed with the analyzer of opts: a prefix whose words are all dropped by the analyzer completes
+// nothing
+func (e *Engine) Suggest(field string, prefix string, opts SuggestOptions) ([]*pb.Suggestion, error) {
+	opts, err := validateSuggestOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	analyzed, err := analyzePrefix(prefix, opts.Analyzer)
+	if err != nil {
+		return nil, err
+	}
+	if analyzed == "" && strings.TrimSpace(prefix) != "" {
+		return nil, nil
+	}
+	return e.store.Suggest(field, analyzed, opts.Size, opts.MaxDistance)
 }
@@ -0,0 +1,135 @@
+package se
+
+import (
+	"fmt"
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultFacetSize is the number of values counted by facets that do not set a size
+	DefaultFacetSize = 10
+
+	// MaxFacetSize is the maximum number of values counted by a facet
+	MaxFacetSize = 100
+
+	// MaxFacetRanges is the maximum number of ranges of a facet
+	MaxFacetRanges = 20
+
+	// MaxFacets is the maximum number of facets requested with a search
+	MaxFacets = 10
+)
+
+// validateFacets checks that facets count the values of indexed fields
+func validateFacets(facets []*pb.Facet, opts SearchOptions) error {
+	if len(facets) > MaxFacets {
+		return errors.BadRequest("too many facets", errors.Details{Key: "max", Value: MaxFacets})
+	}
+
+	fields := map[string]bool{NumberField: true}
+	for _, field := range opts.Fields {
+		fields[field] = true
+	}
+
+	names := map[string]bool{}
+	for _, facet := range facets {
+		if !fields[facet.Field] {
+			return errors.BadRequest("facet field is not indexed", errors.Details{Key: "field", Value: facet.Field})
+		}
+
+		name := facetName(facet)
+		if names[name] {
+			return errors.BadRequest("facet names must be unique", errors.Details{Key: "name", Value: name})
+		}
+		names[name] = true
+
+		if facet.Size > MaxFacetSize {
+			return errors.BadRequest("facet size is too high", errors.Details{Key: "max", Value: MaxFacetSize})
+		}
+		if len(facet.Ranges) > MaxFacetRanges {
+			return errors.BadRequest("facet has too many ranges", errors.Details{Key: "max", Value: MaxFacetRanges})
+		}
+
+		for _, r := range facet.Ranges {
+			if r.From != nil && r.To != nil && r.From.Value >= r.To.Value {
+				return errors.BadRequest("facet range is empty", errors.Details{Key: "range", Value: rangeKey(r)})
+			}
+		}
+	}
+	return nil
+}
+
+// facetName returns the name of facet results: the facet name, or its field when it has no name
+func facetName(facet *pb.Facet) string {
+	if facet.Name != "" {
+		return facet.Name
+	}
+	return facet.Field
+}
+
+// rangeKey returns the key of the bucket of r: its key, or the textual form of its bounds "from..to", either side
+// being empty when the range is open
+func rangeKey(r *pb.NumRange) string {
+	if r.Key != "" {
+		return r.Key
+	}
+
+	var from, to string
+	if r.From != nil {
+		from = formatNumber(r.From.Value)
+	}
+	if r.To != nil {
+		to = formatNumber(r.To.Value)
+	}
+	return from + ".." + to
+}
+
+// ParseFacet parses the textual form of a facet. "field" counts the most frequent values of field, "field:20" counts
+// the 20 most frequent ones and "field:..10,10..50,50.." counts the values in each range, a range including its lower
+// bound and excluding its upper bound. Bounds are numbers or ISO-8601 timestamps
+func ParseFacet(text string) (*pb.Facet, error) {
+	facet := &pb.Facet{Field: text}
+
+	ind := strings.LastIndex(text, ":")
+	if ind < 0 {
+		return facet, nil
+	}
+	facet.Field = text[:ind]
+	spec := text[ind+1:]
+
+	if !strings.Contains(spec, "..") {
+		size, err := strconv.ParseUint(spec, 10, 32)
+		if err != nil {
+			return nil, errors.BadRequest(fmt.Sprintf("invalid facet size %q", spec))
+		}
+		facet.Size = uint32(size)
+		return facet, nil
+	}
+
+	for _, item := range strings.Split(spec, ",") {
+		bounds := strings.Split(item, "..")
+		if len(bounds) != 2 || bounds[0] == "" && bounds[1] == "" {
+			return nil, errors.BadRequest(fmt.Sprintf("invalid facet range %q", item))
+		}
+
+		r := &pb.NumRange{Key: item}
+		for ind, bound := range bounds {
+			if bound == "" {
+				continue
+			}
+			value, ok := parseNumber(bound)
+			if !ok {
+				return nil, errors.BadRequest(fmt.Sprintf("invalid facet range bound %q", bound))
+			}
+			if ind == 0 {
+				r.From = &pb.NumBound{Value: value}
+			} else {
+				r.To = &pb.NumBound{Value: value}
+			}
+		}
+		facet.Ranges = append(facet.Ranges, r)
+	}
+	return facet, nil
+}
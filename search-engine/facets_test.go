@@ -0,0 +1,122 @@
+package se
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestParseFacet(t *testing.T) {
+	Convey("Facets are parsed from their textual form", t, func() {
+		for text, facet := range map[string]*pb.Facet{
+			"brand":    {Field: "brand"},
+			"brand:20": {Field: "brand", Size: 20},
+			"price:..10,10..50,50..": {Field: "price", Ranges: []*pb.NumRange{
+				{Key: "..10", To: &pb.NumBound{Value: 10}},
+				{Key: "10..50", From: &pb.NumBound{Value: 10}, To: &pb.NumBound{Value: 50}},
+				{Key: "50..", From: &pb.NumBound{Value: 50}},
+			}},
+			"$number:-5..5": {Field: NumberField, Ranges: []*pb.NumRange{
+				{Key: "-5..5", From: &pb.NumBound{Value: -5}, To: &pb.NumBound{Value: 5}},
+			}},
+			"rating:..2.5": {Field: "rating", Ranges: []*pb.NumRange{
+				{Key: "..2.5", To: &pb.NumBound{Value: 2.5}},
+			}},
+			"released:2021-01-01..2022-01-01": {Field: "released", Ranges: []*pb.NumRange{
+				{Key: "2021-01-01..2022-01-01", From: &pb.NumBound{Value: 1609459200000}, To: &pb.NumBound{Value: 1640995200000}},
+			}},
+		} {
+			parsed, err := ParseFacet(text)
+			So(err, ShouldBeNil)
+			So(proto.Equal(parsed, facet), ShouldBeTrue)
+		}
+
+		for _, text := range []string{"brand:x", "brand:-1", "price:..", "price:1..2..3", "price:a..2"} {
+			_, err := ParseFacet(text)
+			So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+		}
+	})
+}
+
+func TestEngine_Facets(t *testing.T) {
+	Convey("Facets count values over all the objects matching the query", t, func() {
+		engine := NewEngine(newTestSQLStore())
+		opts := SearchOptions{Fields: []string{"brand", "price"}}
+
+		for id, props := range map[string]string{
+			"p1": `{"brand": "Acme", "price": 5}`,
+			"p2": `{"brand": "Acme", "price": 25}`,
+			"p3": `{"brand": "Globex", "price": 60.5}`,
+			"p4": `{"brand": "Initech", "price": "unknown"}`,
+			"p5": `{"brand": null, "price": 10}`,
+		} {
+			So(engine.CreatePropertiesMapping(&pb.PropertiesMapping{ObjectId: id, Json: props}), ShouldBeNil)
+		}
+		for id, number := range map[string]float64{"p1": 1, "p2": 1, "p3": 3, "p4": 4} {
+			So(engine.CreateNumberMapping(&pb.NumberMapping{ObjectId: id, Number: number}), ShouldBeNil)
+		}
+
+		facets := func(query string, facets ...string) map[string][]string {
+			q, err := ParseQuery(query)
+			So(err, ShouldBeNil)
+
+			var requests []*pb.Facet
+			for _, text := range facets {
+				facet, err := ParseFacet(text)
+				So(err, ShouldBeNil)
+				requests = append(requests, facet)
+			}
+
+			results, err := engine.Facets(q, opts, requests)
+			So(err, ShouldBeNil)
+
+			counts := map[string][]string{}
+			for _, result := range results {
+				counts[result.Name] = []string{}
+				for _, bucket := range result.Buckets {
+					counts[result.Name] = append(counts[result.Name], bucket.Key+":"+strconv.FormatInt(bucket.Count, 10))
+				}
+			}
+			return counts
+		}
+
+		So(facets(`price >= 0 or price = "unknown"`, "brand", "price:..10,10..50,50.."), ShouldResemble, map[string][]string{
+			"brand": {"acme:2", "globex:1", "initech:1"},
+			"price": {"..10:1", "10..50:2", "50..:1"},
+		})
+		So(facets(`brand = "acme" or price > 50`, "brand:1", "price:0..100"), ShouldResemble, map[string][]string{
+			"brand": {"acme:2"},
+			"price": {"0..100:3"},
+		})
+		So(facets(`$number < 4`, "$number"), ShouldResemble, map[string][]string{
+			"$number": {"1:2", "3:1"},
+		})
+		So(facets(`$number < 4`, "$number:..2,2.."), ShouldResemble, map[string][]string{
+			"$number": {"..2:2", "2..:1"},
+		})
+		So(facets(`$number > 10`, "$number:..2,2.."), ShouldResemble, map[string][]string{
+			"$number": {"..2:0", "2..:0"},
+		})
+
+		Convey("Facets are validated", func() {
+			q, err := ParseQuery(`brand = "acme"`)
+			So(err, ShouldBeNil)
+
+			for _, facet := range []*pb.Facet{
+				{Field: "color"},
+				{Field: "brand", Size: MaxFacetSize + 1},
+				{Field: "price", Ranges: []*pb.NumRange{{From: &pb.NumBound{Value: 5}, To: &pb.NumBound{Value: 5}}}},
+			} {
+				_, err = engine.Facets(q, opts, []*pb.Facet{facet})
+				So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+			}
+
+			_, err = engine.Facets(q, opts, []*pb.Facet{{Field: "brand"}, {Field: "brand", Size: 2}})
+			So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+		})
+	})
+}
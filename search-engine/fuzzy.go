@@ -0,0 +1,141 @@
+package se
+
+import "sort"
+
+// MaxFuzzyDistance is the maximum number of edits accepted between a fuzzy query term and the tokens it matches
+const MaxFuzzyDistance = 2
+
+// MaxFuzzyExpansions is the maximum number of indexed tokens a fuzzy condition matches. Each of them counts as a
+// condition of the query
+const MaxFuzzyExpansions = 32
+
+// fuzzyExpander returns the indexed tokens that are at most maxDistance edits away from term
+type fuzzyExpander func(term string, maxDistance int) ([]string, error)
+
+// autoFuzzyDistance returns the maximum edit distance used for term when a fuzzy condition does not set one.
+// Short terms tolerate fewer edits, otherwise they would match most of the short tokens
+func autoFuzzyDistance(term string) int {
+	switch n := len([]rune(term)); {
+	case n < 3:
+		return 0
+	case n < 6:
+		return 1
+	default:
+		return MaxFuzzyDistance
+	}
+}
+
+// fuzzyVariant is an indexed token close to a fuzzy term, and the number of objects it is indexed for
+type fuzzyVariant struct {
+	token    string
+	distance int
+	docs     int64
+}
+
+// closestVariants returns the tokens of at most MaxFuzzyExpansions variants: the closest ones, then the ones indexed
+// for the most objects. Variants indexed for no object are dropped
+func closestVariants(variants []*fuzzyVariant) []string {
+	sort.Slice(variants, func(i, j int) bool {
+		if variants[i].distance != variants[j].distance {
+			return variants[i].distance < variants[j].distance
+		}
+		if variants[i].docs != variants[j].docs {
+			return variants[i].docs > variants[j].docs
+		}
+		return variants[i].token < variants[j].token
+	})
+
+	var tokens []string
+	for _, v := range variants {
+		if len(tokens) == MaxFuzzyExpansions {
+			break
+		}
+		if v.docs > 0 {
+			tokens = append(tokens, v.token)
+		}
+	}
+	return tokens
+}
+
+// fuzzyWeight returns the factor applied to the relevance of tokens matched with distance edits, so that
+// approximate matches rank below exact ones
+func fuzzyWeight(distance int) float64 {
+	return 1 / float64(1+distance)
+}
+
+// trigrams returns the distinct trigrams of token padded with two leading and one trailing spaces, so that the
+// beginning of tokens weighs more than their end
+func trigrams(token string) []string {
+	padded := []rune("  " + token + " ")
+
+	var grams []string
+	seen := map[string]bool{}
+	for i := 0; i+3 <= len(padded); i++ {
+		gram := string(padded[i : i+3])
+		if !seen[gram] {
+			seen[gram] = true
+			grams = append(grams, gram)
+		}
+	}
+	return grams
+}
+
+// minSharedTrigrams returns how many distinct trigrams a token must share with term to possibly be within
+// maxDistance edits of it. An edit changes at most three trigrams, a transposition at most four. A result lower
+// than one means that trigrams cannot discard any token
+func minSharedTrigrams(term string, maxDistance int) int {
+	return len(trigrams(term)) - 4*maxDistance
+}
+
+// editDistance returns the optimal string alignment distance between a and b: the number of insertions, deletions,
+// substitutions and transpositions of adjacent characters needed to turn a into b
+func editDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+
+	rows := make([][]int, len(s)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(t)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+
+	for i := 1; i <= len(s); i++ {
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+
+			d := minInt(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				d = minInt(d, rows[i-2][j-2]+1)
+			}
+			rows[i][j] = d
+		}
+	}
+	return rows[len(s)][len(t)]
+}
+
+func minInt(first int, others ...int) int {
+	min := first
+	for _, v := range others {
+		if v < min {
+			min = v
+		}
+	}
+	return min
+}
+
+// fuzzyMatcher matches the variants of term. Variants share the document frequency of term and their relevance
+// decreases with their distance to it
+func fuzzyMatcher(term string, variants map[string]int) tokenMatcher {
+	return func(_, token string) (tokenMatch, bool) {
+		distance, found := variants[token]
+		if !found {
+			return tokenMatch{}, false
+		}
+		return tokenMatch{term: term, weight: fuzzyWeight(distance)}, true
+	}
+}
@@ -0,0 +1,172 @@
+package se
+
+import (
+	"github.com/omecodes/bome"
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"math/rand"
+	"net/http"
+	"testing"
+)
+
+func TestEditDistance(t *testing.T) {
+	Convey("Edit distance counts insertions, deletions, substitutions and transpositions", t, func() {
+		for pair, distance := range map[[2]string]int{
+			{"iphone", "iphone"}:   0,
+			{"iphone", "iphones"}:  1,
+			{"iphone", "iphne"}:    1,
+			{"iphone", "iphane"}:   1,
+			{"iphone", "iphnoe"}:   1,
+			{"iphone", "ihpnoe"}:   2,
+			{"kitten", "sitting"}:  3,
+			{"", "abc"}:            3,
+			{"café", "cafe"}:       1,
+			{"москва", "моксва"}:   1,
+			{"ca", "abc"}:          3,
+			{"galaxy", "galxay"}:   1,
+			{"samsung", "iphones"}: 7,
+		} {
+			So(editDistance(pair[0], pair[1]), ShouldEqual, distance)
+			So(editDistance(pair[1], pair[0]), ShouldEqual, distance)
+		}
+	})
+
+	Convey("Tokens within the distance always share enough trigrams to pass the pre-filter", t, func() {
+		r := rand.New(rand.NewSource(7))
+		alphabet := []rune("abcdeé")
+
+		edit := func(word []rune) []rune {
+			w := append([]rune{}, word...)
+			switch pos := r.Intn(len(w) + 1); r.Intn(4) {
+			case 0:
+				w = append(w[:pos], append([]rune{alphabet[r.Intn(len(alphabet))]}, w[pos:]...)...)
+			case 1:
+				if pos < len(w) {
+					w = append(w[:pos], w[pos+1:]...)
+				}
+			case 2:
+				if pos < len(w) {
+					w[pos] = alphabet[r.Intn(len(alphabet))]
+				}
+			case 3:
+				if pos+1 < len(w) {
+					w[pos], w[pos+1] = w[pos+1], w[pos]
+				}
+			}
+			return w
+		}
+
+		shared := func(a, b string) int {
+			grams := map[string]bool{}
+			for _, gram := range trigrams(b) {
+				grams[gram] = true
+			}
+			count := 0
+			for _, gram := range trigrams(a) {
+				if grams[gram] {
+					count++
+				}
+			}
+			return count
+		}
+
+		for i := 0; i < 2000; i++ {
+			word := make([]rune, 1+r.Intn(10))
+			for ind := range word {
+				word[ind] = alphabet[r.Intn(len(alphabet))]
+			}
+
+			variant := word
+			for edits := r.Intn(MaxFuzzyDistance) + 1; edits > 0; edits-- {
+				variant = edit(variant)
+			}
+
+			term, token := string(word), string(variant)
+			distance := editDistance(term, token)
+			if distance <= MaxFuzzyDistance {
+				So(shared(term, token), ShouldBeGreaterThanOrEqualTo, minSharedTrigrams(term, distance))
+			}
+		}
+	})
+}
+
+func TestEngine_SearchFuzzy(t *testing.T) {
+	Convey("Fuzzy conditions match misspelled words and rank them below exact matches", t, func() {
+		engine := NewEngine(newTestSQLStore())
+
+		for _, m := range []*pb.TextMapping{
+			{ObjectId: "p1", Name: "title", Text: "iPhone case"},
+			{ObjectId: "p2", Name: "title", Text: "iphnoe charger"},
+			{ObjectId: "p3", Name: "title", Text: "Samsung Galaxy"},
+			{ObjectId: "p4", Name: "title", Text: "phone stand"},
+		} {
+			So(engine.CreateTextMapping(m), ShouldBeNil)
+		}
+
+		search := func(text string) []string {
+			q, err := ParseQuery(text)
+			So(err, ShouldBeNil)
+
+			hits, err := engine.Search(q, SearchOptions{})
+			So(err, ShouldBeNil)
+			return hitIDs(hits)
+		}
+
+		So(search(`$text ~ "iphone"`), ShouldResemble, []string{"p1", "p2", "p4"})
+		So(search(`$text ~ "iphnoe"`), ShouldResemble, []string{"p2", "p1", "p4"})
+		So(search(`$text ~1 "iphnoe"`), ShouldResemble, []string{"p2", "p1"})
+		So(search(`$text ~ "galxy"`), ShouldResemble, []string{"p3"})
+		So(search(`$text ~ "cse"`), ShouldResemble, []string{"p1"})
+		So(search(`$text ~ "ca"`), ShouldBeNil)
+		So(search(`$text ~ "motorola"`), ShouldBeNil)
+		So(search(`$text ~ "samsnug" or $text = "stand"`), ShouldResemble, []string{"p4", "p3"})
+		So(search(`not $text ~ "iphone"`), ShouldResemble, []string{"p3"})
+
+		Convey("Deleted tokens are no longer matched", func() {
+			So(engine.DeleteObjectMappings("p2"), ShouldBeNil)
+			So(search(`$text ~1 "iphnoe"`), ShouldResemble, []string{"p1"})
+		})
+	})
+
+	Convey("Fuzzy conditions expand to a bounded number of tokens counted as conditions", t, func() {
+		disk := newTestDiskStore(t.TempDir(), DiskStoreOptions{})
+		defer func() {
+			So(disk.Close(), ShouldBeNil)
+		}()
+
+		for _, store := range []Store{newTestSQLStore(), disk} {
+			engine := NewEngine(store)
+
+			// "abcd" is indexed for three objects, then 36 tokens one or two edits away for one object each
+			for _, id := range []string{"x1", "x2", "x3"} {
+				So(engine.CreateTextMapping(&pb.TextMapping{ObjectId: id, Name: "code", Text: "abcd"}), ShouldBeNil)
+			}
+			for i := 0; i < 36; i++ {
+				token := "ab" + string(rune('e'+i/6)) + string(rune('e'+i%6))
+				So(engine.CreateTextMapping(&pb.TextMapping{ObjectId: "v" + token, Name: "code", Text: token}), ShouldBeNil)
+			}
+
+			q, err := ParseQuery(`$text ~2 "abcd"`)
+			So(err, ShouldBeNil)
+
+			hits, err := engine.Search(q, SearchOptions{MaxTerms: MaxFuzzyExpansions})
+			So(err, ShouldBeNil)
+			So(len(hits), ShouldEqual, MaxFuzzyExpansions+2)
+			So(hitIDs(hits)[:3], ShouldResemble, []string{"x1", "x2", "x3"})
+
+			_, err = engine.Search(q, SearchOptions{MaxTerms: MaxFuzzyExpansions - 1})
+			So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+		}
+	})
+
+	Convey("Fuzzy conditions are validated", t, func() {
+		q := &pb.SearchQuery{Query: &pb.SearchQuery_Text{Text: &pb.StrQuery{Bool: &pb.StrQuery_Fuzzy{Fuzzy: &pb.Fuzzy{Value: "iphone", MaxDistance: 3}}}}}
+		_, err := NewEngine(newTestSQLStore()).Search(q, SearchOptions{})
+		So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+
+		q.GetText().GetFuzzy().MaxDistance = 1
+		_, err = CompileQuery(bome.SQLite3, q, SearchOptions{})
+		So(errors.IsUnSupported(err), ShouldBeTrue)
+	})
+}
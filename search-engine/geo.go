@@ -0,0 +1,254 @@
+package se
+
+import (
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"math"
+	"sort"
+)
+
+const (
+	// EarthRadius is the mean radius of the earth in meters, distances are measured on a sphere of this radius
+	EarthRadius = 6371008.8
+
+	// GeoField is the pseudo field of the geo index in textual queries
+	GeoField = "$geo"
+
+	// geohashPrecision is the number of characters of the geohash cells indexed points are stored with
+	geohashPrecision = 12
+
+	// maxCoverCells is the maximum number of geohash cells a radius condition looks for points in. The cells are
+	// chosen as small as possible
+	maxCoverCells = 16
+)
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// ValidateGeoPoint checks that p is a position on earth
+func ValidateGeoPoint(p *pb.GeoPoint) error {
+	if p == nil {
+		return errors.BadRequest("missing point")
+	}
+	if math.IsNaN(p.Lat) || p.Lat < -90 || p.Lat > 90 || math.IsNaN(p.Lon) || p.Lon < -180 || p.Lon > 180 {
+		return errors.BadRequest("latitudes must be between -90 and 90, longitudes between -180 and 180", errors.Details{Key: "lat", Value: p.Lat}, errors.Details{Key: "lon", Value: p.Lon})
+	}
+	return nil
+}
+
+// geoVector returns the position of the point on the unit sphere. The points within a radius of a center are the
+// ones whose vector has a dot product with the vector of the center greater than the cosine of the radius angle:
+// SQL stores compare them without trigonometric functions
+func geoVector(lat, lon float64) (x, y, z float64) {
+	phi, lambda := lat*math.Pi/180, lon*math.Pi/180
+	return math.Cos(phi) * math.Cos(lambda), math.Cos(phi) * math.Sin(lambda), math.Sin(phi)
+}
+
+// dot returns the dot product of two vectors. Products are converted so that they are not fused, the same way
+// SQL stores compute them
+func dot(x1, y1, z1, x2, y2, z2 float64) float64 {
+	return float64(x1*x2) + float64(y1*y2) + float64(z1*z2)
+}
+
+// distance returns the number of meters between two points along the surface of the earth
+func distance(a, b *pb.GeoPoint) float64 {
+	phi1, phi2 := a.Lat*math.Pi/180, b.Lat*math.Pi/180
+	dPhi, dLambda := phi2-phi1, (b.Lon-a.Lon)*math.Pi/180
+	h := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	return 2 * EarthRadius * math.Asin(math.Sqrt(math.Min(h, 1)))
+}
+
+// geohash returns the geohash cell of precision characters the point is in
+func geohash(lat, lon float64, precision int) string {
+	latBits, lonBits := geohashBits(precision)
+	return geohashCell(geohashIndex(lat, -90, 180, latBits), geohashIndex(lon, -180, 360, lonBits), precision)
+}
+
+// geohashBits returns the number of bits of the latitude and longitude indexes of the cells of precision characters
+func geohashBits(precision int) (latBits, lonBits uint) {
+	latBits = uint(5 * precision / 2)
+	return latBits, uint(5*precision) - latBits
+}
+
+// geohashIndex returns the index of the interval value is in, among the 2^bits intervals of span from min
+func geohashIndex(value, min, span float64, bits uint) uint64 {
+	size := uint64(1) << bits
+	f := math.Floor((value - min) / span * float64(size))
+	if f < 0 {
+		return 0
+	}
+	if f >= float64(size) {
+		return size - 1
+	}
+	return uint64(f)
+}
+
+// geohashCell returns the cell of the latitude and longitude indexes. Geohashes interleave their bits, from a
+// longitude bit, and write them in base 32
+func geohashCell(latIndex, lonIndex uint64, precision int) string {
+	latBits, lonBits := geohashBits(precision)
+
+	var hash uint64
+	for i := 0; i < 5*precision; i++ {
+		if i%2 == 0 {
+			lonBits--
+			hash = hash<<1 | (lonIndex>>lonBits)&1
+		} else {
+			latBits--
+			hash = hash<<1 | (latIndex>>latBits)&1
+		}
+	}
+
+	cell := make([]byte, precision)
+	for i := precision - 1; i >= 0; i-- {
+		cell[i] = geohashAlphabet[hash&31]
+		hash >>= 5
+	}
+	return string(cell)
+}
+
+// geoBox is a latitude range and one or two longitude ranges, two when it crosses the antimeridian
+type geoBox struct {
+	south, north float64
+	lons         [][2]float64
+}
+
+// radiusBox returns the box around the points at most meters away from center. It is padded so that rounding
+// errors never leave out points of the circle
+func radiusBox(center *pb.GeoPoint, meters float64) *geoBox {
+	const padding = 1e-6
+
+	angle := meters / EarthRadius * 180 / math.Pi
+	box := &geoBox{south: center.Lat - angle - padding, north: center.Lat + angle + padding}
+	if box.south <= -90 || box.north >= 90 {
+		box.south, box.north = math.Max(box.south, -90), math.Min(box.north, 90)
+		box.lons = [][2]float64{{-180, 180}}
+		return box
+	}
+
+	delta := math.Asin(math.Min(math.Sin(meters/EarthRadius)/math.Cos(center.Lat*math.Pi/180), 1))*180/math.Pi + padding
+	west, east := center.Lon-delta, center.Lon+delta
+	switch {
+	case west < -180:
+		box.lons = [][2]float64{{west + 360, 180}, {-180, east}}
+	case east > 180:
+		box.lons = [][2]float64{{west, 180}, {-180, east - 360}}
+	default:
+		box.lons = [][2]float64{{west, east}}
+	}
+	return box
+}
+
+// coverCells returns the smallest geohash cells covering box, at most maxCoverCells of them. It returns no cells
+// when the box needs more cells of a single character: the whole earth is searched
+func coverCells(box *geoBox) []string {
+	var cells []string
+	for precision := 1; precision <= geohashPrecision; precision++ {
+		latBits, lonBits := geohashBits(precision)
+		south, north := geohashIndex(box.south, -90, 180, latBits), geohashIndex(box.north, -90, 180, latBits)
+
+		count := uint64(0)
+		for _, lons := range box.lons {
+			west, east := geohashIndex(lons[0], -180, 360, lonBits), geohashIndex(lons[1], -180, 360, lonBits)
+			count += (north - south + 1) * (east - west + 1)
+		}
+		if count > maxCoverCells {
+			break
+		}
+
+		cells = cells[:0]
+		for _, lons := range box.lons {
+			west, east := geohashIndex(lons[0], -180, 360, lonBits), geohashIndex(lons[1], -180, 360, lonBits)
+			for lat := south; lat <= north; lat++ {
+				for lon := west; lon <= east; lon++ {
+					cells = append(cells, geohashCell(lat, lon, precision))
+				}
+			}
+		}
+	}
+	sort.Strings(cells)
+	return cells
+}
+
+// radiusCondition matches the points at most meters away from center
+type radiusCondition struct {
+	x, y, z float64
+	minDot  float64
+	cells   []string
+}
+
+func newRadiusCondition(query *pb.WithinRadius) (*radiusCondition, error) {
+	if err := ValidateGeoPoint(query.Center); err != nil {
+		return nil, err
+	}
+	if math.IsNaN(query.Meters) || math.IsInf(query.Meters, 0) || query.Meters <= 0 {
+		return nil, errors.BadRequest("radius must be a positive number of meters", errors.Details{Key: "meters", Value: query.Meters})
+	}
+
+	c := &radiusCondition{minDot: math.Cos(query.Meters / EarthRadius)}
+	c.x, c.y, c.z = geoVector(query.Center.Lat, query.Center.Lon)
+	if query.Meters/EarthRadius >= math.Pi {
+		// the whole earth, including the antipode whose rounded dot product can be lower than -1
+		c.minDot = -2
+		return c, nil
+	}
+	c.cells = coverCells(radiusBox(query.Center, query.Meters))
+	return c, nil
+}
+
+func (c *radiusCondition) match(lat, lon float64) bool {
+	x, y, z := geoVector(lat, lon)
+	return dot(x, y, z, c.x, c.y, c.z) >= c.minDot
+}
+
+// boxCondition matches the points of a box
+type boxCondition struct {
+	south, west, north, east float64
+}
+
+func newBoxCondition(query *pb.WithinBox) (*boxCondition, error) {
+	if err := ValidateGeoPoint(query.SouthWest); err != nil {
+		return nil, err
+	}
+	if err := ValidateGeoPoint(query.NorthEast); err != nil {
+		return nil, err
+	}
+	if query.SouthWest.Lat > query.NorthEast.Lat {
+		return nil, errors.BadRequest("box south latitude is greater than its north latitude")
+	}
+	return &boxCondition{south: query.SouthWest.Lat, west: query.SouthWest.Lon, north: query.NorthEast.Lat, east: query.NorthEast.Lon}, nil
+}
+
+// crossesAntimeridian tells whether the box spans from its west longitude to 180 and from -180 to its east one
+func (c *boxCondition) crossesAntimeridian() bool {
+	return c.west > c.east
+}
+
+func (c *boxCondition) match(lat, lon float64) bool {
+	if lat < c.south || lat > c.north {
+		return false
+	}
+	if c.crossesAntimeridian() {
+		return lon >= c.west || lon <= c.east
+	}
+	return lon >= c.west && lon <= c.east
+}
+
+// sortHitsByDistance sets the distance of the hits to the nearest of their points to origin, and sorts them nearest
+// first. Hits without points come last, in their previous order
+func sortHitsByDistance(hits []*pb.SearchHit, points map[string][]*pb.GeoPoint, origin *pb.GeoPoint) {
+	for _, hit := range hits {
+		hit.Distance = -1
+		for _, p := range points[hit.Id] {
+			if d := distance(origin, p); hit.Distance < 0 || d < hit.Distance {
+				hit.Distance = d
+			}
+		}
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		if (hits[i].Distance < 0) != (hits[j].Distance < 0) {
+			return hits[j].Distance < 0
+		}
+		return hits[i].Distance < hits[j].Distance
+	})
+}
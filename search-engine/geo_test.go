@@ -0,0 +1,61 @@
+package se
+
+import (
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestGeohash(t *testing.T) {
+	Convey("Points are hashed in the cells of the standard geohash grid", t, func() {
+		So(geohash(57.64911, 10.40744, 11), ShouldEqual, "u4pruydqqvj")
+		So(geohash(-25.382708, -49.265506, 8), ShouldEqual, "6gkzwgjz")
+		So(geohash(-90, -180, geohashPrecision), ShouldEqual, "000000000000")
+		So(geohash(90, 180, geohashPrecision), ShouldEqual, "zzzzzzzzzzzz")
+	})
+}
+
+func TestCoverCells(t *testing.T) {
+	Convey("The cells covering a radius contain all the points within the radius", t, func() {
+		r := rand.New(rand.NewSource(3))
+		for i := 0; i < 2000; i++ {
+			center := &pb.GeoPoint{Lat: r.Float64()*180 - 90, Lon: r.Float64()*360 - 180}
+			meters := math.Pow(10, 1+r.Float64()*6)
+
+			condition, err := newRadiusCondition(&pb.WithinRadius{Center: center, Meters: meters})
+			So(err, ShouldBeNil)
+			So(len(condition.cells), ShouldBeLessThanOrEqualTo, maxCoverCells)
+			if condition.cells == nil {
+				continue
+			}
+
+			// points on the circle, slightly inside it
+			for j := 0; j < 8; j++ {
+				bearing := r.Float64() * 2 * math.Pi
+				angle := meters * 0.999 / EarthRadius
+				phi, lambda := center.Lat*math.Pi/180, center.Lon*math.Pi/180
+				lat := math.Asin(math.Sin(phi)*math.Cos(angle) + math.Cos(phi)*math.Sin(angle)*math.Cos(bearing))
+				lon := lambda + math.Atan2(math.Sin(bearing)*math.Sin(angle)*math.Cos(phi), math.Cos(angle)-math.Sin(phi)*math.Sin(lat))
+				lat, lon = lat*180/math.Pi, math.Remainder(lon*180/math.Pi, 360)
+
+				So(condition.match(lat, lon), ShouldBeTrue)
+
+				hash, covered := geohash(lat, lon, geohashPrecision), false
+				for _, cell := range condition.cells {
+					covered = covered || strings.HasPrefix(hash, cell)
+				}
+				So(covered, ShouldBeTrue)
+			}
+		}
+	})
+
+	Convey("Radiuses covering the whole earth are not restricted to cells", t, func() {
+		condition, err := newRadiusCondition(&pb.WithinRadius{Center: &pb.GeoPoint{Lat: 10, Lon: 20}, Meters: 2.1e7})
+		So(err, ShouldBeNil)
+		So(condition.cells, ShouldBeNil)
+		So(condition.match(-10, -160), ShouldBeTrue)
+	})
+}
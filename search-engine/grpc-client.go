@@ -0,0 +1,412 @@
+package se
+
+import (
+	"context"
+	"github.com/golang/protobuf/proto"
+	"github.com/omecodes/errors"
+	"github.com/omecodes/libome/logs"
+	"github.com/omecodes/service"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"google.golang.org/grpc/status"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultFeedQueueSize is the number of index changes a client holds while they wait to be sent
+	DefaultFeedQueueSize = 1024
+
+	// FeedAttempts is the number of times a client sends an index change before dropping it
+	FeedAttempts = 3
+
+	// FlushTimeout is the maximum time Flush waits for the search engine to confirm the changes fed before it
+	FlushTimeout = 30 * time.Second
+
+	// feedRetryDelay is the delay between two attempts to connect to the search engine
+	feedRetryDelay = time.Second
+)
+
+// ClientProvider provides clients of a search engine service
+type ClientProvider interface {
+	GetClient(ctx context.Context) (pb.SearchEngineClient, error)
+}
+
+// ServiceClientProvider connects to the search engine services of type ServiceType registered in the registry of
+// the context service box
+type ServiceClientProvider struct {
+	ServiceType uint32
+}
+
+func (p *ServiceClientProvider) GetClient(ctx context.Context) (pb.SearchEngineClient, error) {
+	conn, err := service.Connect(ctx, p.ServiceType)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewSearchEngineClient(conn), nil
+}
+
+// NewClient creates a client that indexes and searches the objects of collection with the search engine services
+// provider connects to. Index changes are queued and sent in the background, in the order they are fed, so they
+// become searchable shortly after Feed returns. Flush tells when the engine stored them
+func NewClient(ctx context.Context, collection string, provider ClientProvider) *Client {
+	c := &Client{
+		ctx:        ctx,
+		collection: collection,
+		provider:   provider,
+		queue:      make(chan *feedRequest, DefaultFeedQueueSize),
+		done:       make(chan struct{}),
+	}
+	go c.run()
+	return c
+}
+
+// Client is a search engine service client bound to a collection
+type Client struct {
+	sync.RWMutex
+	ctx        context.Context
+	collection string
+	provider   ClientProvider
+	queue      chan *feedRequest
+	done       chan struct{}
+	closed     bool
+}
+
+// feedRequest is an entry of the client queue: an index change, or a flush when flushed is set
+type feedRequest struct {
+	msg     *pb.MessageFeed
+	flushed chan error
+}
+
+// Feed queues msg to be sent to the search engine. It fails when the queue is full
+func (c *Client) Feed(msg *pb.MessageFeed) error {
+	msg = proto.Clone(msg).(*pb.MessageFeed)
+	msg.Collection = c.collection
+
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.closed {
+		return errors.ServiceUnavailable("search engine client is closed")
+	}
+
+	select {
+	case c.queue <- &feedRequest{msg: msg}:
+		return nil
+	default:
+		return errors.ServiceUnavailable("search engine feed queue is full", errors.Details{Key: "collection", Value: c.collection})
+	}
+}
+
+// Flush returns once the search engine confirmed it stored the changes fed before it. It fails when one of them
+// could not be sent, or when the engine does not confirm them within FlushTimeout
+func (c *Client) Flush() error {
+	flushed := make(chan error, 1)
+	timeout := time.NewTimer(FlushTimeout)
+	defer timeout.Stop()
+
+	c.RLock()
+	if c.closed {
+		c.RUnlock()
+		return errors.ServiceUnavailable("search engine client is closed")
+	}
+	select {
+	case c.queue <- &feedRequest{flushed: flushed}:
+		c.RUnlock()
+	case <-timeout.C:
+		c.RUnlock()
+		return errors.ServiceUnavailable("search engine feed queue is full", errors.Details{Key: "collection", Value: c.collection})
+	}
+
+	select {
+	case err := <-flushed:
+		return err
+	case <-timeout.C:
+		return errors.ServiceUnavailable("search engine did not confirm index changes", errors.Details{Key: "collection", Value: c.collection})
+	}
+}
+
+// Close stops accepting index changes and waits for the queued ones to be sent
+func (c *Client) Close() error {
+	c.Lock()
+	if !c.closed {
+		c.closed = true
+		close(c.queue)
+	}
+	c.Unlock()
+
+	<-c.done
+	return nil
+}
+
+// run sends the queued index changes over a feed stream, opening a new stream when the current one fails. Changes
+// sent on a stream that fails afterwards are not sent again: the next flush reports them lost. A flush closes the
+// stream, the engine confirms the changes it received by answering
+func (c *Client) run() {
+	defer close(c.done)
+
+	var (
+		stream pb.SearchEngine_FeedClient
+		lost   bool
+	)
+	for req := range c.queue {
+		if req.flushed != nil {
+			req.flushed <- c.flush(stream, lost)
+			stream, lost = nil, false
+			continue
+		}
+
+		for attempt := 1; ; attempt++ {
+			if stream == nil {
+				stream = c.openFeedStream()
+				if stream == nil {
+					lost = true
+					break
+				}
+			}
+
+			err := stream.Send(req.msg)
+			if err == nil {
+				break
+			}
+
+			if _, cer := stream.CloseAndRecv(); cer != nil {
+				err = cer
+			}
+			stream = nil
+			lost = true
+			logs.Error("search engine feed stream failed", logs.Details("collection", c.collection), logs.Err(err))
+
+			if attempt == FeedAttempts {
+				logs.Error("dropped index change", logs.Details("collection", c.collection))
+				break
+			}
+		}
+	}
+
+	if stream != nil {
+		if _, err := stream.CloseAndRecv(); err != nil {
+			logs.Error("search engine feed stream closing", logs.Details("collection", c.collection), logs.Err(err))
+		}
+	}
+}
+
+// flush closes stream and returns an error when the engine did not confirm the changes sent on it, or when changes
+// were lost since the previous flush
+func (c *Client) flush(stream pb.SearchEngine_FeedClient, lost bool) error {
+	if stream != nil {
+		if _, err := stream.CloseAndRecv(); err != nil {
+			logs.Error("search engine feed stream closing", logs.Details("collection", c.collection), logs.Err(err))
+			return err
+		}
+	}
+	if lost {
+		return errors.ServiceUnavailable("index changes could not be sent to the search engine", errors.Details{Key: "collection", Value: c.collection})
+	}
+	return nil
+}
+
+// openFeedStream connects to the search engine until it succeeds or the client context is done, in which case it
+// returns nil
+func (c *Client) openFeedStream() pb.SearchEngine_FeedClient {
+	for {
+		client, err := c.provider.GetClient(c.ctx)
+		if err == nil {
+			var stream pb.SearchEngine_FeedClient
+			stream, err = client.Feed(c.ctx)
+			if err == nil {
+				return stream
+			}
+		}
+		logs.Error("could not open search engine feed stream", logs.Details("collection", c.collection), logs.Err(err))
+
+		select {
+		case <-c.ctx.Done():
+			return nil
+		case <-time.After(feedRetryDelay):
+		}
+	}
+}
+
+// Search returns the hits of the objects of the client collection that match query, ordered by decreasing relevance
+func (c *Client) Search(query *pb.SearchQuery, opts SearchOptions) ([]*pb.SearchHit, error) {
+	var hits []*pb.SearchHit
+	err := c.search(c.request(query, opts), func(result *pb.SearchResult) {
+		hits = append(hits, result.Hits...)
+	})
+	return hits, err
+}
+
+// Facets counts the values of the facets fields over all the objects of the client collection that match query
+func (c *Client) Facets(query *pb.SearchQuery, opts SearchOptions, facets []*pb.Facet) ([]*pb.FacetResult, error) {
+	request := c.request(query, opts)
+	request.Facets = facets
+
+	var results []*pb.FacetResult
+	err := c.search(request, func(result *pb.SearchResult) {
+		results = append(results, result.Facets...)
+	})
+	return results, err
+}
+
+// Suggest returns the terms indexed for field in the client collection that complete prefix, the ones indexed for
+// the most objects first
+func (c *Client) Suggest(field string, prefix string, opts SuggestOptions) ([]*pb.Suggestion, error) {
+	client, err := c.provider.GetClient(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := client.Suggest(c.ctx, &pb.SuggestRequest{
+		Collection:  c.collection,
+		Field:       field,
+		Prefix:      prefix,
+		Size:        uint32(opts.Size),
+		MaxDistance: uint32(opts.MaxDistance),
+		Analyzer:    opts.Analyzer,
+	})
+	if err != nil {
+		return nil, clientError(err)
+	}
+	return rsp.Suggestions, nil
+}
+
+// MappedIDs returns, in increasing order, at most count ids greater than after of the objects of the client
+// collection that have mappings
+func (c *Client) MappedIDs(after string, count int) ([]string, error) {
+	client, err := c.provider.GetClient(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := client.MappedIds(c.ctx, &pb.MappedIdsRequest{Collection: c.collection, After: after, Count: uint32(count)})
+	if err != nil {
+		return nil, clientError(err)
+	}
+	return rsp.Ids, nil
+}
+
+// UnmappedIDs returns the ids among ids of the objects of the client collection that have no mapping
+func (c *Client) UnmappedIDs(ids []string) ([]string, error) {
+	client, err := c.provider.GetClient(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := client.UnmappedIds(c.ctx, &pb.UnmappedIdsRequest{Collection: c.collection, Ids: ids})
+	if err != nil {
+		return nil, clientError(err)
+	}
+	return rsp.Ids, nil
+}
+
+// ObjectTokens returns the tokens indexed for the text fields of the object id of the client collection
+func (c *Client) ObjectTokens(id string) ([]*pb.FieldTokens, error) {
+	client, err := c.provider.GetClient(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := client.ObjectTokens(c.ctx, &pb.ObjectTokensRequest{Collection: c.collection, Id: id})
+	if err != nil {
+		return nil, clientError(err)
+	}
+	return rsp.Fields, nil
+}
+
+// TokenDocuments returns the postings of token for a page of the objects of the client collection it is indexed for
+func (c *Client) TokenDocuments(token string, opts TokenDocumentsOptions) ([]*pb.TokenPosting, error) {
+	client, err := c.provider.GetClient(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := client.TokenDocuments(c.ctx, &pb.TokenDocumentsRequest{
+		Collection: c.collection,
+		Token:      token,
+		Field:      opts.Field,
+		After:      opts.After,
+		Count:      uint32(opts.Count),
+	})
+	if err != nil {
+		return nil, clientError(err)
+	}
+	return rsp.Postings, nil
+}
+
+// Analyze returns the tokens the analyzer registered in the search engine under analyzer extracts from text
+func (c *Client) Analyze(analyzer string, text string) ([]string, error) {
+	client, err := c.provider.GetClient(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := client.Analyze(c.ctx, &pb.AnalyzeRequest{Collection: c.collection, Analyzer: analyzer, Text: text})
+	if err != nil {
+		return nil, clientError(err)
+	}
+	return rsp.Tokens, nil
+}
+
+// Explain evaluates query over the client collection and describes how it was compiled and how the hits were scored
+func (c *Client) Explain(query *pb.SearchQuery, opts SearchOptions) (*pb.SearchExplanation, error) {
+	client, err := c.provider.GetClient(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation, err := client.Explain(c.ctx, c.request(query, opts))
+	if err != nil {
+		return nil, clientError(err)
+	}
+	return explanation, nil
+}
+
+func (c *Client) request(query *pb.SearchQuery, opts SearchOptions) *pb.ResearchRequest {
+	return &pb.ResearchRequest{
+		Query:      query,
+		Collection: c.collection,
+		Fields:     opts.Fields,
+		Boosts:     opts.Boosts,
+		Analyzers:  opts.Analyzers,
+		MaxDepth:   uint32(opts.MaxDepth),
+		MaxTerms:   uint32(opts.MaxTerms),
+		Synonyms:   opts.Synonyms,
+		Origin:     opts.Origin,
+	}
+}
+
+// search sends request and passes each result the search engine streams back to handle
+func (c *Client) search(request *pb.ResearchRequest, handle func(result *pb.SearchResult)) error {
+	client, err := c.provider.GetClient(c.ctx)
+	if err != nil {
+		return err
+	}
+
+	stream, err := client.Search(c.ctx, request)
+	if err != nil {
+		return clientError(err)
+	}
+
+	for {
+		result, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return clientError(err)
+		}
+		handle(result)
+	}
+}
+
+// clientError restores the error a search engine service returned from the gRPC status err carries
+func clientError(err error) error {
+	if st, ok := status.FromError(err); ok {
+		if e, ok := errors.Parse(st.Message()); ok {
+			return e
+		}
+	}
+	return err
+}
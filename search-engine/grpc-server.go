@@ -0,0 +1,261 @@
+package se
+
+import (
+	"context"
+	"database/sql"
+	"github.com/iancoleman/strcase"
+	"github.com/omecodes/errors"
+	"github.com/omecodes/libome/logs"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"io"
+	"sync"
+)
+
+// SearchResultBatchSize is the maximum number of hits sent in a single search result message
+const SearchResultBatchSize = 100
+
+// Engines provides the engines that index the objects of collections
+type Engines interface {
+	Get(collection string) (*Engine, error)
+}
+
+// NewSQLEngines creates engines that index each collection in its own tables of db. Tables are named after the
+// collection like the objects collections name their index tables, so that both can share a database
+func NewSQLEngines(db *sql.DB, dialect string, tablePrefix string) Engines {
+	return &sqlEngines{
+		db:          db,
+		dialect:     dialect,
+		tablePrefix: tablePrefix,
+		engines:     map[string]*Engine{},
+	}
+}
+
+type sqlEngines struct {
+	sync.Mutex
+	db          *sql.DB
+	dialect     string
+	tablePrefix string
+	engines     map[string]*Engine
+}
+
+func (s *sqlEngines) Get(collection string) (*Engine, error) {
+	if collection == "" {
+		return nil, errors.BadRequest("collection is required")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	engine, found := s.engines[collection]
+	if found {
+		return engine, nil
+	}
+
+	store, err := NewSQLIndexStore(s.db, s.dialect, s.tablePrefix+"_"+strcase.ToSnake(collection)+"_index")
+	if err != nil {
+		logs.Error("could not create collection index store", logs.Details("collection", collection), logs.Err(err))
+		return nil, errors.Internal("failed to load collection index")
+	}
+
+	engine = NewEngine(store)
+	s.engines[collection] = engine
+	return engine, nil
+}
+
+// NewGRPCServerHandler creates a search engine gRPC server that feeds and searches the engines of the collections
+// messages target
+func NewGRPCServerHandler(engines Engines) pb.SearchEngineServer {
+	return &gRPCServerHandler{engines: engines}
+}
+
+type gRPCServerHandler struct {
+	pb.UnimplementedSearchEngineServer
+	engines Engines
+}
+
+// Feed applies the changes of the stream. The response confirms they are stored: the engines they were applied to
+// are flushed before it is sent
+func (h *gRPCServerHandler) Feed(stream pb.SearchEngine_FeedServer) error {
+	fed := map[*Engine]string{}
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			for engine, collection := range fed {
+				err = engine.Flush()
+				if err != nil {
+					logs.Error("Feed: could not flush index changes", logs.Details("collection", collection), logs.Err(err))
+					return err
+				}
+			}
+			return stream.SendAndClose(&pb.FeedResponse{})
+		}
+
+		if err != nil {
+			return err
+		}
+
+		engine, err := h.engines.Get(msg.Collection)
+		if err != nil {
+			return err
+		}
+
+		err = engine.Feed(msg)
+		if err != nil {
+			logs.Error("Feed: could not apply index change", logs.Details("collection", msg.Collection), logs.Err(err))
+			return err
+		}
+		fed[engine] = msg.Collection
+	}
+}
+
+func (h *gRPCServerHandler) Search(request *pb.ResearchRequest, stream pb.SearchEngine_SearchServer) error {
+	engine, err := h.engines.Get(request.Collection)
+	if err != nil {
+		return err
+	}
+
+	opts := SearchOptions{
+		Fields:    request.Fields,
+		MaxDepth:  int(request.MaxDepth),
+		MaxTerms:  int(request.MaxTerms),
+		Boosts:    request.Boosts,
+		Analyzers: request.Analyzers,
+		Synonyms:  request.Synonyms,
+		Origin:    request.Origin,
+	}
+
+	if len(request.Facets) > 0 {
+		facets, err := engine.Facets(request.Query, opts, request.Facets)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&pb.SearchResult{Facets: facets})
+	}
+
+	hits, err := engine.Search(request.Query, opts)
+	if err != nil {
+		return err
+	}
+
+	for len(hits) > 0 {
+		size := SearchResultBatchSize
+		if len(hits) < size {
+			size = len(hits)
+		}
+
+		result := &pb.SearchResult{Hits: hits[:size]}
+		for _, hit := range result.Hits {
+			result.Ids = append(result.Ids, hit.Id)
+		}
+
+		err = stream.Send(result)
+		if err != nil {
+			return err
+		}
+		hits = hits[size:]
+	}
+	return nil
+}
+
+func (h *gRPCServerHandler) Suggest(_ context.Context, request *pb.SuggestRequest) (*pb.SuggestResponse, error) {
+	engine, err := h.engines.Get(request.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions, err := engine.Suggest(request.Field, request.Prefix, SuggestOptions{
+		Size:        int(request.Size),
+		MaxDistance: int(request.MaxDistance),
+		Analyzer:    request.Analyzer,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SuggestResponse{Suggestions: suggestions}, nil
+}
+
+func (h *gRPCServerHandler) MappedIds(_ context.Context, request *pb.MappedIdsRequest) (*pb.MappedIdsResponse, error) {
+	engine, err := h.engines.Get(request.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := engine.MappedIDs(request.After, int(request.Count))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MappedIdsResponse{Ids: ids}, nil
+}
+
+func (h *gRPCServerHandler) UnmappedIds(_ context.Context, request *pb.UnmappedIdsRequest) (*pb.UnmappedIdsResponse, error) {
+	engine, err := h.engines.Get(request.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := engine.UnmappedIDs(request.Ids)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnmappedIdsResponse{Ids: ids}, nil
+}
+
+func (h *gRPCServerHandler) ObjectTokens(_ context.Context, request *pb.ObjectTokensRequest) (*pb.ObjectTokensResponse, error) {
+	engine, err := h.engines.Get(request.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := engine.ObjectTokens(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ObjectTokensResponse{Fields: fields}, nil
+}
+
+func (h *gRPCServerHandler) TokenDocuments(_ context.Context, request *pb.TokenDocumentsRequest) (*pb.TokenDocumentsResponse, error) {
+	engine, err := h.engines.Get(request.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	postings, err := engine.TokenDocuments(request.Token, TokenDocumentsOptions{
+		Field: request.Field,
+		After: request.After,
+		Count: int(request.Count),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TokenDocumentsResponse{Postings: postings}, nil
+}
+
+func (h *gRPCServerHandler) Analyze(_ context.Context, request *pb.AnalyzeRequest) (*pb.AnalyzeResponse, error) {
+	engine, err := h.engines.Get(request.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := engine.Analyze(request.Analyzer, request.Text)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AnalyzeResponse{Tokens: tokens}, nil
+}
+
+func (h *gRPCServerHandler) Explain(_ context.Context, request *pb.ResearchRequest) (*pb.SearchExplanation, error) {
+	engine, err := h.engines.Get(request.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	return engine.Explain(request.Query, SearchOptions{
+		Fields:    request.Fields,
+		MaxDepth:  int(request.MaxDepth),
+		MaxTerms:  int(request.MaxTerms),
+		Boosts:    request.Boosts,
+		Analyzers: request.Analyzers,
+		Synonyms:  request.Synonyms,
+		Origin:    request.Origin,
+	})
+}
@@ -0,0 +1,153 @@
+package se
+
+import (
+	"context"
+	"database/sql"
+	"github.com/omecodes/bome"
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"net"
+	"net/http"
+	"sort"
+	"testing"
+)
+
+type testClientProvider struct {
+	conn *grpc.ClientConn
+}
+
+func (p *testClientProvider) GetClient(_ context.Context) (pb.SearchEngineClient, error) {
+	return pb.NewSearchEngineClient(p.conn), nil
+}
+
+func TestSearchEngineService(t *testing.T) {
+	Convey("Collections are fed and searched through the search engine service", t, func() {
+		db, err := sql.Open(bome.SQLite3, ":memory:")
+		So(err, ShouldBeNil)
+		db.SetMaxOpenConns(1)
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+
+		server := grpc.NewServer()
+		pb.RegisterSearchEngineServer(server, NewGRPCServerHandler(NewSQLEngines(db, bome.SQLite3, "test")))
+		go func() {
+			_ = server.Serve(listener)
+		}()
+		defer server.Stop()
+
+		conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+		So(err, ShouldBeNil)
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		provider := &testClientProvider{conn: conn}
+		books := NewClient(context.Background(), "books", provider)
+		films := NewClient(context.Background(), "films", provider)
+
+		feed := func(client *Client, msg *pb.MessageFeed) {
+			So(client.Feed(msg), ShouldBeNil)
+		}
+		for id, title := range map[string]string{"b1": "The old man and the sea", "b2": "Twenty thousand leagues under the sea"} {
+			feed(books, &pb.MessageFeed{Message: &pb.MessageFeed_TextMapping{TextMapping: &pb.TextMapping{ObjectId: id, Name: "title", Text: title}}})
+			feed(books, &pb.MessageFeed{Message: &pb.MessageFeed_PropertiesMapping{PropertiesMapping: &pb.PropertiesMapping{ObjectId: id, Json: `{"author": "` + id + `"}`}}})
+		}
+		feed(films, &pb.MessageFeed{Message: &pb.MessageFeed_TextMapping{TextMapping: &pb.TextMapping{ObjectId: "f1", Name: "title", Text: "The sea beast"}}})
+		feed(books, &pb.MessageFeed{Message: &pb.MessageFeed_Delete{Delete: &pb.ObjectDeletedNotification{Id: "b1"}}})
+
+		So(books.Flush(), ShouldBeNil)
+		So(films.Flush(), ShouldBeNil)
+
+		// the engine rejects the change: the flush reports it was not stored
+		feed(books, &pb.MessageFeed{})
+		So(books.Flush(), ShouldNotBeNil)
+		So(books.Flush(), ShouldBeNil)
+
+		So(books.Close(), ShouldBeNil)
+		So(films.Close(), ShouldBeNil)
+		So(books.Feed(&pb.MessageFeed{}), ShouldNotBeNil)
+		So(books.Flush(), ShouldNotBeNil)
+
+		opts := SearchOptions{Fields: []string{"author"}}
+		ids := func(client *Client, text string) []string {
+			q, err := ParseQuery(text)
+			So(err, ShouldBeNil)
+
+			hits, err := client.Search(q, opts)
+			So(err, ShouldBeNil)
+
+			ids := []string{}
+			for _, hit := range hits {
+				ids = append(ids, hit.Id)
+			}
+			sort.Strings(ids)
+			return ids
+		}
+
+		So(ids(books, `$text contains "sea"`), ShouldResemble, []string{"b2"})
+		So(ids(films, `$text contains "sea"`), ShouldResemble, []string{"f1"})
+		So(ids(books, `author = "b2"`), ShouldResemble, []string{"b2"})
+
+		q, err := ParseQuery(`author startswith "b"`)
+		So(err, ShouldBeNil)
+		facets, err := books.Facets(q, opts, []*pb.Facet{{Field: "author"}})
+		So(err, ShouldBeNil)
+		So(facets, ShouldHaveLength, 1)
+		So(facets[0].Buckets, ShouldHaveLength, 1)
+		So(facets[0].Buckets[0].Key, ShouldEqual, "b2")
+
+		suggestions, err := books.Suggest("title", "Thou", SuggestOptions{})
+		So(err, ShouldBeNil)
+		So(suggestions, ShouldHaveLength, 1)
+		So(suggestions[0].Text, ShouldEqual, "thousand")
+		So(suggestions[0].Docs, ShouldEqual, 1)
+
+		mapped, err := books.MappedIDs("", 10)
+		So(err, ShouldBeNil)
+		So(mapped, ShouldResemble, []string{"b2"})
+
+		unmapped, err := films.UnmappedIDs([]string{"f1", "f2"})
+		So(err, ShouldBeNil)
+		So(unmapped, ShouldResemble, []string{"f2"})
+
+		fields, err := films.ObjectTokens("f1")
+		So(err, ShouldBeNil)
+		So(fields, ShouldHaveLength, 1)
+		So(fields[0].Tokens, ShouldHaveLength, 2)
+
+		postings, err := books.TokenDocuments("sea", TokenDocumentsOptions{})
+		So(err, ShouldBeNil)
+		So(postings, ShouldHaveLength, 1)
+		So(postings[0].Id, ShouldEqual, "b2")
+
+		tokens, err := books.Analyze(EnglishAnalyzer, "The leagues")
+		So(err, ShouldBeNil)
+		So(tokens, ShouldResemble, []string{"leagu"})
+
+		q, err = ParseQuery(`$text contains "sea"`)
+		So(err, ShouldBeNil)
+		explanation, err := books.Explain(q, opts)
+		So(err, ShouldBeNil)
+		So(explanation.HitsCount, ShouldEqual, 1)
+		So(explanation.Hits, ShouldHaveLength, 1)
+		So(explanation.Conditions, ShouldHaveLength, 1)
+		So(explanation.Conditions[0].Terms, ShouldResemble, []string{"sea"})
+
+		Convey("Search errors are returned as they were raised", func() {
+			q, err := ParseQuery(`color = "red"`)
+			So(err, ShouldBeNil)
+
+			_, err = books.Search(q, opts)
+			So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+
+			_, err = books.Suggest("title", "sea", SuggestOptions{Size: MaxSuggestSize + 1})
+			So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+
+			_, err = books.MappedIDs("", MaxMappedIDs+1)
+			So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+		})
+	})
+}
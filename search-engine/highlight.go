@@ -0,0 +1,238 @@
+package se
+
+import (
+	"github.com/omecodes/bome"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"golang.org/x/text/unicode/norm"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultFragmentSize is the number of characters of highlighted fragments when no size is requested
+	DefaultFragmentSize = 100
+
+	// MaxFragmentSize is the maximum number of characters of highlighted fragments
+	MaxFragmentSize = 1000
+
+	// MaxFragments is the maximum number of fragments returned for a text
+	MaxFragments = 3
+)
+
+// analyzedWord is a token of a text with the terms the analyzer turned it into. Start and end are rune offsets
+// of the token in the original text
+type analyzedWord struct {
+	start, end int
+	terms      []string
+}
+
+// words returns the tokens the analyzer extracts from text, located in text. Tokenizers work on the NFKC normalized
+// text: tokens are located in it, then mapped back to the characters of text they were normalized from
+func (a *Analyzer) words(text string) []analyzedWord {
+	// the normalized text is built segment by segment: segment i is normalized from text[origin[i]:origin[i+1]]
+	// and starts at offset[i] in the normalized text
+	var (
+		normalized strings.Builder
+		origin     []int
+		offset     []int
+		it         norm.Iter
+	)
+	it.InitString(norm.NFKC, text)
+	for !it.Done() {
+		origin = append(origin, it.Pos())
+		offset = append(offset, normalized.Len())
+		normalized.Write(it.Next())
+	}
+	origin = append(origin, len(text))
+	offset = append(offset, normalized.Len())
+
+	runeOffsets := make([]int, len(text)+1)
+	count := 0
+	for i := range text {
+		runeOffsets[i] = count
+		count++
+	}
+	runeOffsets[len(text)] = count
+
+	n := normalized.String()
+	var (
+		words  []analyzedWord
+		cursor int
+	)
+	for _, token := range a.tokenizer(text) {
+		ind := strings.Index(n[cursor:], token)
+		if ind < 0 || token == "" {
+			continue
+		}
+		start := cursor + ind
+		end := start + len(token)
+		_, size := utf8.DecodeRuneInString(token)
+		cursor = start + size
+
+		terms := a.filter([]string{token})
+		if len(terms) == 0 {
+			continue
+		}
+
+		// the segment that contains the first byte of the token and the one that contains its last byte
+		first := sort.Search(len(offset), func(i int) bool { return offset[i] > start }) - 1
+		last := sort.Search(len(offset), func(i int) bool { return offset[i] >= end }) - 1
+		words = append(words, analyzedWord{
+			start: runeOffsets[origin[first]],
+			end:   runeOffsets[origin[last+1]],
+			terms: terms,
+		})
+	}
+	return words
+}
+
+// Highlighter locates the words of indexed texts that match the text conditions of a search query
+type Highlighter struct {
+	query        *pb.SearchQuery
+	opts         SearchOptions
+	fragmentSize int
+}
+
+// NewHighlighter returns a highlighter for the text conditions of query, compiled with opts. Fragments hold about
+// fragmentSize characters, DefaultFragmentSize when it is not positive. Queries that are not text queries
+// highlight nothing
+func NewHighlighter(query *pb.SearchQuery, opts SearchOptions, fragmentSize int) (*Highlighter, error) {
+	if fragmentSize <= 0 {
+		fragmentSize = DefaultFragmentSize
+	}
+	if fragmentSize > MaxFragmentSize {
+		fragmentSize = MaxFragmentSize
+	}
+
+	// compiling once validates the query before texts are highlighted
+	_, err := compileQuery(bome.SQLite3, query, opts, func(string, int) ([]string, error) {
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Highlighter{query: query, opts: opts, fragmentSize: fragmentSize}, nil
+}
+
+// Highlight returns the fragments of text, indexed under the field alias, that contain words matching the query.
+// It returns nil when no word matches
+func (h *Highlighter) Highlight(field string, text string) (*pb.Highlight, error) {
+	if _, ok := h.query.GetQuery().(*pb.SearchQuery_Text); !ok {
+		return nil, nil
+	}
+
+	analyzer, err := GetAnalyzer(h.opts.Analyzers[field])
+	if err != nil {
+		return nil, err
+	}
+	words := analyzer.words(text)
+
+	// fuzzy conditions match the words of the text that are close enough to their terms
+	compiled, err := compileQuery(bome.SQLite3, h.query, h.opts, func(term string, maxDistance int) ([]string, error) {
+		var tokens []string
+		for _, word := range words {
+			for _, t := range word.terms {
+				if editDistance(term, t) <= maxDistance {
+					tokens = append(tokens, t)
+				}
+			}
+		}
+		return tokens, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var spans []*pb.Span
+	for _, word := range words {
+		for _, term := range word.terms {
+			if _, matched := bestMatch(compiled.matchers, field, term); matched {
+				spans = appendSpan(spans, word.start, word.end)
+				break
+			}
+		}
+	}
+	if len(spans) == 0 {
+		return nil, nil
+	}
+	return &pb.Highlight{Field: field, Fragments: fragments([]rune(text), spans, h.fragmentSize)}, nil
+}
+
+// appendSpan appends the span from start to end to spans, merging it with the last span when they overlap
+func appendSpan(spans []*pb.Span, start, end int) []*pb.Span {
+	if n := len(spans); n > 0 && uint32(start) <= spans[n-1].End {
+		if uint32(end) > spans[n-1].End {
+			spans[n-1].End = uint32(end)
+		}
+		return spans
+	}
+	return append(spans, &pb.Span{Start: uint32(start), End: uint32(end)})
+}
+
+// fragments cuts at most MaxFragments excerpts of about size characters of text around the spans, in order.
+// Excerpts are cut on spaces when possible, spans are never cut
+func fragments(text []rune, spans []*pb.Span, size int) []*pb.Fragment {
+	var result []*pb.Fragment
+
+	for i := 0; i < len(spans) && len(result) < MaxFragments; {
+		first := int(spans[i].Start)
+
+		start := first - (size-int(spans[i].End-spans[i].Start))/2
+		if start < 0 {
+			start = 0
+		}
+		end := start + size
+		if end > len(text) {
+			end = len(text)
+			start = end - size
+			if start < 0 {
+				start = 0
+			}
+		}
+		if start > first {
+			start = first
+		}
+
+		j := i + 1
+		for j < len(spans) && int(spans[j].End) <= end {
+			j++
+		}
+		last := int(spans[j-1].End)
+		if end < last {
+			end = last
+		}
+
+		if start > 0 && !unicode.IsSpace(text[start-1]) {
+			for p := start; p < first; p++ {
+				if unicode.IsSpace(text[p]) {
+					start = p + 1
+					break
+				}
+			}
+		}
+		if end < len(text) {
+			for p := end; p > last; p-- {
+				if unicode.IsSpace(text[p]) {
+					end = p
+					break
+				}
+			}
+		}
+		for start < first && unicode.IsSpace(text[start]) {
+			start++
+		}
+		for end > last && unicode.IsSpace(text[end-1]) {
+			end--
+		}
+
+		fragment := &pb.Fragment{Text: string(text[start:end])}
+		for _, span := range spans[i:j] {
+			fragment.Matches = append(fragment.Matches, &pb.Span{Start: span.Start - uint32(start), End: span.End - uint32(start)})
+		}
+		result = append(result, fragment)
+		i = j
+	}
+	return result
+}
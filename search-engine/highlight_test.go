@@ -0,0 +1,77 @@
+package se
+
+import (
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"strings"
+	"testing"
+)
+
+func TestHighlighter(t *testing.T) {
+	highlight := func(query string, opts SearchOptions, size int, field string, text string) *pb.Highlight {
+		q, err := ParseQuery(query)
+		So(err, ShouldBeNil)
+
+		h, err := NewHighlighter(q, opts, size)
+		So(err, ShouldBeNil)
+
+		hl, err := h.Highlight(field, text)
+		So(err, ShouldBeNil)
+		return hl
+	}
+
+	matched := func(hl *pb.Highlight) []string {
+		var words []string
+		for _, fragment := range hl.GetFragments() {
+			text := []rune(fragment.Text)
+			for _, span := range fragment.Matches {
+				words = append(words, string(text[span.Start:span.End]))
+			}
+		}
+		return words
+	}
+
+	Convey("Matching words are located in the original text", t, func() {
+		hl := highlight(`$text = "cafe"`, SearchOptions{}, 0, "body", "Le Café de la gare, le café d'en face")
+		So(hl.Field, ShouldEqual, "body")
+		So(hl.Fragments, ShouldHaveLength, 1)
+		So(hl.Fragments[0].Text, ShouldEqual, "Le Café de la gare, le café d'en face")
+		So(hl.Fragments[0].Matches, ShouldHaveLength, 2)
+		So(hl.Fragments[0].Matches[0].Start, ShouldEqual, 3)
+		So(hl.Fragments[0].Matches[0].End, ShouldEqual, 7)
+		So(matched(hl), ShouldResemble, []string{"Café", "café"})
+
+		So(matched(highlight(`$text = "tokyo2020"`, SearchOptions{}, 0, "body", "Les jeux ＴＯＫＹＯ２０２０ !")), ShouldResemble, []string{"ＴＯＫＹＯ２０２０"})
+		So(matched(highlight(`$text = "大学"`, SearchOptions{}, 0, "body", "北京大学的图书馆")), ShouldResemble, []string{"大学"})
+		So(matched(highlight(`$text = "图书馆"`, SearchOptions{}, 0, "body", "北京大学的图书馆")), ShouldResemble, []string{"图书馆"})
+		So(matched(highlight(`$text = "cafe"`, SearchOptions{}, 0, "body", "Le café est fermé")), ShouldResemble, []string{"café"})
+	})
+
+	Convey("Words are analyzed with the analyzer of their field", t, func() {
+		opts := SearchOptions{Analyzers: map[string]string{"title": EnglishAnalyzer}}
+		So(matched(highlight(`$text = "run"`, opts, 0, "title", "Running shoes for runners")), ShouldResemble, []string{"Running"})
+		So(highlight(`$text = "run"`, opts, 0, "body", "Running shoes for runners"), ShouldBeNil)
+	})
+
+	Convey("All kinds of text conditions are highlighted, negated ones are not", t, func() {
+		text := "New iPhone case for the New York store"
+		So(matched(highlight(`$text ~ "iphnoe"`, SearchOptions{}, 0, "body", text)), ShouldResemble, []string{"iPhone"})
+		So(matched(highlight(`$text startswith "sto" or $text endswith "ase"`, SearchOptions{}, 0, "body", text)), ShouldResemble, []string{"case", "store"})
+		So(matched(highlight(`$text phrase "new york"`, SearchOptions{}, 0, "body", text)), ShouldResemble, []string{"New", "New", "York"})
+		So(highlight(`not $text = "case"`, SearchOptions{}, 0, "body", text), ShouldBeNil)
+		So(highlight(`name = "case"`, SearchOptions{Fields: []string{"name"}}, 0, "body", text), ShouldBeNil)
+	})
+
+	Convey("Long texts are cut into fragments around the matches", t, func() {
+		text := strings.Repeat("lorem ipsum dolor sit amet ", 10) + "search engine " + strings.Repeat("consectetur adipiscing elit ", 10) + "engine"
+		hl := highlight(`$text = "engine"`, SearchOptions{}, 30, "body", text)
+		So(hl.Fragments, ShouldHaveLength, 2)
+		So(hl.Fragments[0].Text, ShouldEqual, "amet search engine consectetur")
+		So(len([]rune(hl.Fragments[0].Text)), ShouldBeLessThanOrEqualTo, 30)
+		So(hl.Fragments[1].Text, ShouldEqual, "adipiscing elit engine")
+		So(matched(hl), ShouldResemble, []string{"engine", "engine"})
+
+		hl = highlight(`$text = "lorem"`, SearchOptions{}, 20, "body", text)
+		So(hl.Fragments, ShouldHaveLength, MaxFragments)
+	})
+}
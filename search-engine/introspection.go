@@ -0,0 +1,247 @@
+package se
+
+import (
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"sort"
+	"strings"
+)
+
+const (
+	// MaxExplainedHits is the number of best scored hits a search explanation details
+	MaxExplainedHits = 100
+
+	// DefaultTokenDocuments is the number of objects whose postings are listed when the options do not set one
+	DefaultTokenDocuments = 100
+
+	// MaxTokenDocuments is the maximum number of objects whose postings are listed at once
+	MaxTokenDocuments = 1000
+)
+
+// TokenDocumentsOptions selects the postings of a token to list
+type TokenDocumentsOptions struct {
+	// Field restricts the postings to the ones of a text field. Empty selects all the fields
+	Field string
+
+	// After lists the postings of the objects whose id is greater than it
+	After string
+
+	// Count is the number of objects whose postings are listed. Zero selects DefaultTokenDocuments
+	Count int
+}
+
+// validateTokenDocumentsOptions checks opts and returns them with their defaults applied
+func validateTokenDocumentsOptions(opts TokenDocumentsOptions) (TokenDocumentsOptions, error) {
+	if opts.Count < 0 || opts.Count > MaxTokenDocuments {
+		return opts, errors.BadRequest("count of listed objects is out of range", errors.Details{Key: "max", Value: MaxTokenDocuments})
+	}
+	if opts.Count == 0 {
+		opts.Count = DefaultTokenDocuments
+	}
+	return opts, nil
+}
+
+// explainConditions lists the text conditions of query with the terms each analyzer group looks for. Other queries
+// have no analyzed condition
+func explainConditions(query *pb.SearchQuery, opts SearchOptions) ([]*pb.ConditionExplanation, error) {
+	text, ok := query.GetQuery().(*pb.SearchQuery_Text)
+	if !ok {
+		return nil, nil
+	}
+
+	groups, err := analyzerGroups(opts.Analyzers, opts.Synonyms)
+	if err != nil {
+		return nil, err
+	}
+
+	var conditions []*pb.ConditionExplanation
+	var walk func(query *pb.StrQuery, negated bool)
+	walk = func(query *pb.StrQuery, negated bool) {
+		var (
+			name, value string
+			sequence    bool
+		)
+		switch v := query.GetBool().(type) {
+		case *pb.StrQuery_Or:
+			for _, q := range v.Or.Queries {
+				walk(q, negated)
+			}
+			return
+
+		case *pb.StrQuery_Not:
+			walk(v.Not.Expressions, !negated)
+			return
+
+		case *pb.StrQuery_Eq:
+			name, value = "eq", v.Eq.Value
+		case *pb.StrQuery_Contains:
+			name, value = "contains", v.Contains.Value
+		case *pb.StrQuery_StartsWith:
+			name, value = "starts_with", v.StartsWith.Value
+		case *pb.StrQuery_EndsWith:
+			name, value = "ends_with", v.EndsWith.Value
+		case *pb.StrQuery_Fuzzy:
+			name, value = "fuzzy", v.Fuzzy.Value
+		case *pb.StrQuery_Phrase:
+			name, value, sequence = "phrase", v.Phrase.Value, true
+		case *pb.StrQuery_Near:
+			name, value, sequence = "near", v.Near.Value, true
+		default:
+			return
+		}
+
+		for _, group := range groups {
+			terms := group.analyzer.Analyze(value)
+			if len(terms) == 0 {
+				continue
+			}
+
+			condition := &pb.ConditionExplanation{
+				Condition:     name,
+				Value:         value,
+				Analyzer:      group.analyzer.Name(),
+				Fields:        group.fields,
+				ExcludeFields: group.exclude,
+				Negated:       negated,
+				Terms:         terms,
+			}
+			if !sequence {
+				words, phrases := group.synonyms.expand(terms)
+				condition.Terms = words
+				for _, phrase := range phrases {
+					condition.Phrases = append(condition.Phrases, strings.Join(phrase, " "))
+				}
+			}
+			conditions = append(conditions, condition)
+		}
+	}
+	walk(text.Text, false)
+	return conditions, nil
+}
+
+// explainTerms details the scores of the objects of ids from their terms contributions. It returns the explanations
+// of the MaxExplainedHits best scored objects and the number of objects
+func explainTerms(ids []string, terms []*termScore) ([]*pb.HitExplanation, int64) {
+	byID := map[string]*pb.HitExplanation{}
+	hits := make([]*pb.HitExplanation, len(ids))
+	for ind, id := range ids {
+		hits[ind] = &pb.HitExplanation{Id: id}
+		byID[id] = hits[ind]
+	}
+
+	for _, t := range terms {
+		hit := byID[t.id]
+		hit.Score += t.weight
+		hit.Terms = append(hit.Terms, &pb.TermScore{
+			Token:       t.token,
+			Field:       t.field,
+			Term:        t.match.term,
+			Tf:          t.tf,
+			Df:          t.df,
+			Docs:        t.stats.docs,
+			Length:      t.length,
+			AvgLength:   t.stats.avgLength,
+			Idf:         t.idf,
+			Norm:        t.norm,
+			MatchWeight: t.match.weight,
+			Boost:       t.boost,
+			Weight:      t.weight,
+		})
+	}
+
+	for _, hit := range hits {
+		sort.SliceStable(hit.Terms, func(i, j int) bool {
+			return hit.Terms[i].Weight > hit.Terms[j].Weight
+		})
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].Id < hits[j].Id
+	})
+	return truncateExplainedHits(hits), int64(len(ids))
+}
+
+// explainIDs returns the explanations of the MaxExplainedHits first of ids, in increasing order, and the number of
+// ids. Only text queries score their hits
+func explainIDs(ids []string) ([]*pb.HitExplanation, int64) {
+	sort.Strings(ids)
+
+	var hits []*pb.HitExplanation
+	for ind, id := range ids {
+		if ind > 0 && ids[ind-1] == id {
+			continue
+		}
+		hits = append(hits, &pb.HitExplanation{Id: id})
+	}
+	return truncateExplainedHits(hits), int64(len(hits))
+}
+
+func truncateExplainedHits(hits []*pb.HitExplanation) []*pb.HitExplanation {
+	if len(hits) > MaxExplainedHits {
+		return hits[:MaxExplainedHits]
+	}
+	return hits
+}
+
+// fieldTokens returns the tokens of a text field indexed with tokens in text order and terms without position
+func fieldTokens(field string, tokens []string, terms []string) *pb.FieldTokens {
+	ft := &pb.FieldTokens{Field: field, Length: int64(len(tokens) + len(terms))}
+
+	byToken := map[string]*pb.IndexedToken{}
+	get := func(token string) *pb.IndexedToken {
+		t, found := byToken[token]
+		if !found {
+			t = &pb.IndexedToken{Token: token}
+			byToken[token] = t
+			ft.Tokens = append(ft.Tokens, t)
+		}
+		return t
+	}
+
+	for pos, token := range tokens {
+		t := get(token)
+		t.Tf++
+		t.Positions = append(t.Positions, int64(pos))
+	}
+	for _, term := range terms {
+		get(term).Tf++
+	}
+
+	sortIndexedTokens(ft.Tokens)
+	return ft
+}
+
+func sortIndexedTokens(tokens []*pb.IndexedToken) {
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].Token < tokens[j].Token
+	})
+}
+
+func sortFieldTokens(fields []*pb.FieldTokens) {
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Field < fields[j].Field
+	})
+}
+
+// sortTokenPostings orders postings by id then field and keeps the ones of the count first ids
+func sortTokenPostings(postings []*pb.TokenPosting, count int) []*pb.TokenPosting {
+	sort.Slice(postings, func(i, j int) bool {
+		if postings[i].Id != postings[j].Id {
+			return postings[i].Id < postings[j].Id
+		}
+		return postings[i].Field < postings[j].Field
+	})
+
+	ids := 0
+	for ind, p := range postings {
+		if ind == 0 || postings[ind-1].Id != p.Id {
+			ids++
+			if ids > count {
+				return postings[:ind]
+			}
+		}
+	}
+	return postings
+}
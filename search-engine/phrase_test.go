@@ -0,0 +1,83 @@
+package se
+
+import (
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestEngine_SearchPhrase(t *testing.T) {
+	Convey("Phrase and proximity conditions match words by their positions", t, func() {
+		engine := NewEngine(newTestSQLStore())
+
+		for _, m := range []*pb.TextMapping{
+			{ObjectId: "d1", Name: "body", Text: "Flights to New York from Paris"},
+			{ObjectId: "d2", Name: "body", Text: "York looks new, said the New Yorker"},
+			{ObjectId: "d3", Name: "body", Text: "New buildings in the old town of York"},
+			{ObjectId: "d4", Name: "body", Text: "The bank of America", PrefixMappingSize: 4},
+			{ObjectId: "d5", Name: "title", Text: "New"},
+			{ObjectId: "d5", Name: "body", Text: "York"},
+		} {
+			So(engine.CreateTextMapping(m), ShouldBeNil)
+		}
+
+		search := func(text string) []string {
+			q, err := ParseQuery(text)
+			So(err, ShouldBeNil)
+
+			hits, err := engine.Search(q, SearchOptions{})
+			So(err, ShouldBeNil)
+
+			ids := hitIDs(hits)
+			sort.Strings(ids)
+			return ids
+		}
+
+		So(search(`$text phrase "new york"`), ShouldResemble, []string{"d1"})
+		So(search(`$text phrase "NEW-YORK"`), ShouldResemble, []string{"d1"})
+		So(search(`$text phrase "york new"`), ShouldBeNil)
+		So(search(`$text phrase "york"`), ShouldResemble, []string{"d1", "d2", "d3", "d5"})
+		So(search(`$text phrase "bank of america"`), ShouldResemble, []string{"d4"})
+		So(search(`$text phrase "america bank"`), ShouldBeNil)
+		So(search(`$text phrase "new york from paris"`), ShouldResemble, []string{"d1"})
+		So(search(`$text phrase "paris new york"`), ShouldBeNil)
+
+		So(search(`$text near 0 "york new"`), ShouldResemble, []string{"d1"})
+		So(search(`$text near 1 "york new"`), ShouldResemble, []string{"d1", "d2"})
+		So(search(`$text near 3 "new york"`), ShouldResemble, []string{"d1", "d2", "d3"})
+		So(search(`$text near 2 "new york paris"`), ShouldResemble, []string{"d1"})
+		So(search(`$text near 100 "new new"`), ShouldResemble, []string{"d2"})
+
+		So(search(`not $text phrase "new york"`), ShouldResemble, []string{"d2", "d3", "d4", "d5"})
+		So(search(`not ($text phrase "new york" or $text = "bank")`), ShouldResemble, []string{"d2", "d3", "d5"})
+
+		Convey("Words are not matched across fields", func() {
+			So(search(`$text near 5 "new york"`), ShouldNotContain, "d5")
+		})
+
+		Convey("Positions are replaced when a text is indexed again", func() {
+			So(engine.CreateTextMapping(&pb.TextMapping{ObjectId: "d3", Name: "body", Text: "New York old town"}), ShouldBeNil)
+			So(search(`$text phrase "new york"`), ShouldResemble, []string{"d1", "d3"})
+			So(search(`$text phrase "york old"`), ShouldResemble, []string{"d3"})
+
+			So(engine.DeleteObjectMappings("d1"), ShouldBeNil)
+			So(search(`$text phrase "new york"`), ShouldResemble, []string{"d3"})
+		})
+	})
+
+	Convey("Phrase and proximity conditions are validated", t, func() {
+		engine := NewEngine(newTestSQLStore())
+
+		long := &pb.SearchQuery{Query: &pb.SearchQuery_Text{Text: &pb.StrQuery{Bool: &pb.StrQuery_Phrase{Phrase: &pb.Phrase{Value: strings.Repeat("word ", MaxSequenceTerms+1)}}}}}
+		_, err := engine.Search(long, SearchOptions{})
+		So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+
+		far := &pb.SearchQuery{Query: &pb.SearchQuery_Text{Text: &pb.StrQuery{Bool: &pb.StrQuery_Near{Near: &pb.Near{Value: "a b", Distance: MaxNearDistance + 1}}}}}
+		_, err = engine.Search(far, SearchOptions{})
+		So(errors.HTTPStatus(err) == http.StatusBadRequest, ShouldBeTrue)
+	})
+}
@@ -0,0 +1,272 @@
+package se
+
+import (
+	"fmt"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"strconv"
+	"strings"
+)
+
+// FormatQuery returns the textual form of a search query. Parsing the result with ParseQuery gives back an equivalent query
+func FormatQuery(query *pb.SearchQuery) string {
+	switch q := query.GetQuery().(type) {
+	case *pb.SearchQuery_Text:
+		return formatStrQuery(q.Text)
+	case *pb.SearchQuery_Number:
+		return formatNumQuery(q.Number)
+	case *pb.SearchQuery_Fields:
+		return formatFieldQuery(q.Fields)
+	case *pb.SearchQuery_Geo:
+		return formatGeoQuery(q.Geo)
+	}
+	return ""
+}
+
+func formatGeoQuery(query *pb.GeoQuery) string {
+	switch v := query.GetBool().(type) {
+	case *pb.GeoQuery_And:
+		items := make([]string, len(v.And.Queries))
+		nested := make([]bool, len(v.And.Queries))
+		for ind, q := range v.And.Queries {
+			items[ind] = formatGeoQuery(q)
+			switch q.Bool.(type) {
+			case *pb.GeoQuery_And, *pb.GeoQuery_Or:
+				nested[ind] = true
+			}
+		}
+		return formatGroup("and", items, nested)
+
+	case *pb.GeoQuery_Or:
+		items := make([]string, len(v.Or.Queries))
+		nested := make([]bool, len(v.Or.Queries))
+		for ind, q := range v.Or.Queries {
+			items[ind] = formatGeoQuery(q)
+			switch q.Bool.(type) {
+			case *pb.GeoQuery_And, *pb.GeoQuery_Or:
+				nested[ind] = true
+			}
+		}
+		return formatGroup("or", items, nested)
+
+	case *pb.GeoQuery_Not:
+		var nested bool
+		switch v.Not.Expressions.GetBool().(type) {
+		case *pb.GeoQuery_And, *pb.GeoQuery_Or:
+			nested = true
+		}
+		return formatNot(formatGeoQuery(v.Not.Expressions), nested)
+
+	case *pb.GeoQuery_WithinRadius:
+		center := v.WithinRadius.GetCenter()
+		return formatNumbers(GeoField, "within_radius", center.GetLat(), center.GetLon(), v.WithinRadius.Meters)
+	case *pb.GeoQuery_WithinBox:
+		sw, ne := v.WithinBox.GetSouthWest(), v.WithinBox.GetNorthEast()
+		return formatNumbers(GeoField, "within_box", sw.GetLat(), sw.GetLon(), ne.GetLat(), ne.GetLon())
+	}
+	return ""
+}
+
+func formatNumbers(field string, operator string, numbers ...float64) string {
+	items := make([]string, len(numbers))
+	for ind, num := range numbers {
+		items[ind] = formatNumber(num)
+	}
+	return fmt.Sprintf("%s %s (%s)", field, operator, strings.Join(items, ", "))
+}
+
+func formatCondition(field string, operator string, value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return fmt.Sprintf("%s %s %s", field, operator, strconv.Quote(v))
+	case float64:
+		return fmt.Sprintf("%s %s %s", field, operator, formatNumber(v))
+	case nil:
+		return fmt.Sprintf("%s %s null", field, operator)
+	default:
+		return fmt.Sprintf("%s %s %v", field, operator, v)
+	}
+}
+
+func formatGroup(operator string, items []string, nested []bool) string {
+	for ind, item := range items {
+		if nested[ind] {
+			items[ind] = "(" + item + ")"
+		}
+	}
+	return strings.Join(items, " "+operator+" ")
+}
+
+func formatNot(item string, nested bool) string {
+	if nested {
+		return "not (" + item + ")"
+	}
+	return "not " + item
+}
+
+func formatStrQuery(query *pb.StrQuery) string {
+	switch v := query.GetBool().(type) {
+	case *pb.StrQuery_Or:
+		items := make([]string, len(v.Or.Queries))
+		nested := make([]bool, len(v.Or.Queries))
+		for ind, q := range v.Or.Queries {
+			items[ind] = formatStrQuery(q)
+			_, nested[ind] = q.Bool.(*pb.StrQuery_Or)
+		}
+		return formatGroup("or", items, nested)
+
+	case *pb.StrQuery_Not:
+		_, nested := v.Not.Expressions.GetBool().(*pb.StrQuery_Or)
+		return formatNot(formatStrQuery(v.Not.Expressions), nested)
+
+	case *pb.StrQuery_Eq:
+		return formatCondition(TextField, "=", v.Eq.Value)
+	case *pb.StrQuery_Contains:
+		return formatCondition(TextField, "contains", v.Contains.Value)
+	case *pb.StrQuery_StartsWith:
+		return formatCondition(TextField, "startswith", v.StartsWith.Value)
+	case *pb.StrQuery_EndsWith:
+		return formatCondition(TextField, "endswith", v.EndsWith.Value)
+	case *pb.StrQuery_Fuzzy:
+		operator := "~"
+		if v.Fuzzy.MaxDistance > 0 {
+			operator += strconv.FormatUint(uint64(v.Fuzzy.MaxDistance), 10)
+		}
+		return formatCondition(TextField, operator, v.Fuzzy.Value)
+	case *pb.StrQuery_Phrase:
+		return formatCondition(TextField, "phrase", v.Phrase.Value)
+	case *pb.StrQuery_Near:
+		return formatCondition(TextField, fmt.Sprintf("near %d", v.Near.Distance), v.Near.Value)
+	}
+	return ""
+}
+
+func formatNumQuery(query *pb.NumQuery) string {
+	switch v := query.GetBool().(type) {
+	case *pb.NumQuery_And:
+		items := make([]string, len(v.And.Queries))
+		nested := make([]bool, len(v.And.Queries))
+		for ind, q := range v.And.Queries {
+			items[ind] = formatNumQuery(q)
+			switch q.Bool.(type) {
+			case *pb.NumQuery_And, *pb.NumQuery_Or:
+				nested[ind] = true
+			}
+		}
+		return formatGroup("and", items, nested)
+
+	case *pb.NumQuery_Or:
+		items := make([]string, len(v.Or.Queries))
+		nested := make([]bool, len(v.Or.Queries))
+		for ind, q := range v.Or.Queries {
+			items[ind] = formatNumQuery(q)
+			switch q.Bool.(type) {
+			case *pb.NumQuery_And, *pb.NumQuery_Or:
+				nested[ind] = true
+			}
+		}
+		return formatGroup("or", items, nested)
+
+	case *pb.NumQuery_Not:
+		var nested bool
+		switch v.Not.Expressions.GetBool().(type) {
+		case *pb.NumQuery_And, *pb.NumQuery_Or:
+			nested = true
+		}
+		return formatNot(formatNumQuery(v.Not.Expressions), nested)
+
+	case *pb.NumQuery_Eq:
+		return formatCondition(NumberField, "=", v.Eq.Value)
+	case *pb.NumQuery_Gt:
+		return formatCondition(NumberField, ">", v.Gt.Value)
+	case *pb.NumQuery_Gte:
+		return formatCondition(NumberField, ">=", v.Gte.Value)
+	case *pb.NumQuery_Lt:
+		return formatCondition(NumberField, "<", v.Lt.Value)
+	case *pb.NumQuery_Lte:
+		return formatCondition(NumberField, "<=", v.Lte.Value)
+	}
+	return ""
+}
+
+func formatFieldQuery(query *pb.FieldQuery) string {
+	switch v := query.GetBool().(type) {
+	case *pb.FieldQuery_And:
+		items := make([]string, len(v.And.Queries))
+		nested := make([]bool, len(v.And.Queries))
+		for ind, q := range v.And.Queries {
+			items[ind] = formatFieldQuery(q)
+			switch q.Bool.(type) {
+			case *pb.FieldQuery_And, *pb.FieldQuery_Or:
+				nested[ind] = true
+			}
+		}
+		return formatGroup("and", items, nested)
+
+	case *pb.FieldQuery_Or:
+		items := make([]string, len(v.Or.Queries))
+		nested := make([]bool, len(v.Or.Queries))
+		for ind, q := range v.Or.Queries {
+			items[ind] = formatFieldQuery(q)
+			switch q.Bool.(type) {
+			case *pb.FieldQuery_And, *pb.FieldQuery_Or:
+				nested[ind] = true
+			}
+		}
+		return formatGroup("or", items, nested)
+
+	case *pb.FieldQuery_Not:
+		var nested bool
+		switch v.Not.Expressions.GetBool().(type) {
+		case *pb.FieldQuery_And, *pb.FieldQuery_Or:
+			nested = true
+		}
+		return formatNot(formatFieldQuery(v.Not.Expressions), nested)
+
+	case *pb.FieldQuery_StrEqual:
+		return formatCondition(v.StrEqual.Field, "=", v.StrEqual.Value)
+	case *pb.FieldQuery_Contains:
+		return formatCondition(v.Contains.Field, "contains", v.Contains.Value)
+	case *pb.FieldQuery_StartsWith:
+		return formatCondition(v.StartsWith.Field, "startswith", v.StartsWith.Value)
+	case *pb.FieldQuery_EndsWith:
+		return formatCondition(v.EndsWith.Field, "endswith", v.EndsWith.Value)
+	case *pb.FieldQuery_NumbEq:
+		return formatCondition(v.NumbEq.Field, "=", v.NumbEq.Value)
+	case *pb.FieldQuery_Gt:
+		return formatCondition(v.Gt.Field, ">", v.Gt.Value)
+	case *pb.FieldQuery_Gte:
+		return formatCondition(v.Gte.Field, ">=", v.Gte.Value)
+	case *pb.FieldQuery_Lt:
+		return formatCondition(v.Lt.Field, "<", v.Lt.Value)
+	case *pb.FieldQuery_Lte:
+		return formatCondition(v.Lte.Field, "<=", v.Lte.Value)
+	case *pb.FieldQuery_BoolEq:
+		return formatCondition(v.BoolEq.Field, "=", v.BoolEq.Value)
+	case *pb.FieldQuery_IsNull:
+		return formatCondition(v.IsNull.Field, "=", nil)
+	case *pb.FieldQuery_Exists:
+		return v.Exists.Field + " exists"
+	case *pb.FieldQuery_In:
+		return formatList(v.In.Field, "in", v.In.Values)
+	case *pb.FieldQuery_AnyOf:
+		return formatList(v.AnyOf.Field, "any_of", v.AnyOf.Values)
+	case *pb.FieldQuery_AllOf:
+		return formatList(v.AllOf.Field, "all_of", v.AllOf.Values)
+	}
+	return ""
+}
+
+func formatList(field string, operator string, values []*pb.Value) string {
+	items := make([]string, len(values))
+	for ind, value := range values {
+		switch v := value.GetValue().(type) {
+		case *pb.Value_Text:
+			items[ind] = strconv.Quote(v.Text)
+		case *pb.Value_Number:
+			items[ind] = formatNumber(v.Number)
+		case *pb.Value_Boolean:
+			items[ind] = strconv.FormatBool(v.Boolean)
+		}
+	}
+	return fmt.Sprintf("%s %s (%s)", field, operator, strings.Join(items, ", "))
+}
@@ -0,0 +1,821 @@
+package se
+
+import (
+	"fmt"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Query language
+//
+//   query      := or
+//   or         := and { "or" and }
+//   and        := operand { "and" operand }
+//   operand    := "not" operand | "(" or ")" | condition
+//   condition  := field operator value | field "exists" | field list_op "(" item { "," item } ")"
+//   operator   := "=" | "==" | "!=" | "<" | "<=" | ">" | ">=" | "contains" | "startswith" | "endswith" | "phrase" | "near" integer | "~" [ digit ]
+//   list_op    := "in" | "any_of" | "all_of" | "within_radius" | "within_box"
+//   value      := "string" | number | timestamp | "true" | "false" | "null"
+//   item       := "string" | number | timestamp | "true" | "false"
+//
+// Fields are properties index aliases. Three pseudo fields target the other indexes:
+// $text matches words of text indexes, $number matches values of the number index and $geo points of the geo index.
+// Conditions on properties, $text, $number and $geo cannot be mixed in the same query.
+//
+// "a != v" is a shorthand for "not a = v".
+//
+// Timestamps are ISO-8601 dates, like 2021-03-01 or 2021-03-01T10:30:00Z, compared as their number of milliseconds
+// since the unix epoch. Strings compared with "<", "<=", ">" or ">=" must be timestamps.
+//
+// "a = null" matches the properties indexed with a null value, "a exists" the properties indexed with any value.
+//
+// Conditions on array properties match when one of their elements matches. "a in (x, y)" matches when all the values
+// of a are x or y, "a any_of (x, y)" when one of them is x or y and "a all_of (x, y)" when both x and y are among them.
+//
+// "$geo within_radius (lat, lon, meters)" matches the points at most meters away from the center at lat and lon.
+// "$geo within_box (south, west, north, east)" matches the points within the latitudes and longitudes of a box.
+//
+// "$text ~ v" matches the words that are a few edits away from v. The maximum number of edits is chosen from the
+// length of v, unless a digit follows "~": "$text ~1 v".
+//
+// "$text phrase v" matches the words of v in the same order with no other word between them. "$text near 3 v"
+// matches the words of v in any order with at most 3 other words between the first and the last of them.
+//
+// Example: price > 10.5 and (name startswith "ab" or not tags any_of ("x", "y")) and created >= 2021-03-01
+
+const (
+	TextField   = "$text"
+	NumberField = "$number"
+)
+
+// SyntaxError reports an invalid query with the position of the faulty token
+type SyntaxError struct {
+	Offset  int
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+	tokenAnd
+	tokenOr
+	tokenNot
+)
+
+type queryToken struct {
+	kind   tokenKind
+	text   string
+	offset int
+}
+
+func (t queryToken) describe() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of query"
+	case tokenString:
+		return "string " + t.text
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+var wordOperators = map[string]bool{
+	"contains":   true,
+	"startswith": true,
+	"endswith":   true,
+	"phrase":     true,
+	"near":       true,
+	"exists":     true,
+	"in":         true,
+	"any_of":     true,
+	"all_of":     true,
+
+	"within_radius": true,
+	"within_box":    true,
+}
+
+// listOperators are the operators comparing a field to a list of values
+var listOperators = map[string]bool{
+	"in":            true,
+	"any_of":        true,
+	"all_of":        true,
+	"within_radius": true,
+	"within_box":    true,
+}
+
+type queryLexer struct {
+	input  string
+	pos    int
+	tokens []queryToken
+}
+
+func (l *queryLexer) position(offset int) (line int, column int) {
+	line, column = 1, 1
+	for _, r := range l.input[:offset] {
+		if r == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+	return
+}
+
+func (l *queryLexer) errorAt(offset int, format string, args ...interface{}) *SyntaxError {
+	line, column := l.position(offset)
+	return &SyntaxError{Offset: offset, Line: line, Column: column, Message: fmt.Sprintf(format, args...)}
+}
+
+func (l *queryLexer) scan() error {
+	for {
+		for l.pos < len(l.input) && unicode.IsSpace(rune(l.input[l.pos])) {
+			l.pos++
+		}
+
+		if l.pos >= len(l.input) {
+			l.tokens = append(l.tokens, queryToken{kind: tokenEOF, offset: l.pos})
+			return nil
+		}
+
+		start := l.pos
+		c := l.input[l.pos]
+
+		switch {
+		case c == '(':
+			l.pos++
+			l.tokens = append(l.tokens, queryToken{kind: tokenLeftParen, text: "(", offset: start})
+
+		case c == ')':
+			l.pos++
+			l.tokens = append(l.tokens, queryToken{kind: tokenRightParen, text: ")", offset: start})
+
+		case c == ',':
+			l.pos++
+			l.tokens = append(l.tokens, queryToken{kind: tokenComma, text: ",", offset: start})
+
+		case c == '=' || c == '<' || c == '>':
+			l.pos++
+			if l.pos < len(l.input) && l.input[l.pos] == '=' {
+				l.pos++
+			}
+			l.tokens = append(l.tokens, queryToken{kind: tokenOperator, text: l.input[start:l.pos], offset: start})
+
+		case c == '~':
+			l.pos++
+			for l.pos < len(l.input) && l.input[l.pos] >= '0' && l.input[l.pos] <= '9' {
+				l.pos++
+			}
+			l.tokens = append(l.tokens, queryToken{kind: tokenOperator, text: l.input[start:l.pos], offset: start})
+
+		case c == '!' && l.pos+1 < len(l.input) && l.input[l.pos+1] == '=':
+			l.pos += 2
+			l.tokens = append(l.tokens, queryToken{kind: tokenOperator, text: "!=", offset: start})
+
+		case c == '"':
+			l.pos++
+			for l.pos < len(l.input) && l.input[l.pos] != '"' {
+				if l.input[l.pos] == '\\' {
+					l.pos++
+				}
+				l.pos++
+			}
+			if l.pos >= len(l.input) {
+				return l.errorAt(start, "unterminated string")
+			}
+			l.pos++
+			l.tokens = append(l.tokens, queryToken{kind: tokenString, text: l.input[start:l.pos], offset: start})
+
+		case c == '-' || (c >= '0' && c <= '9'):
+			l.pos++
+			for l.pos < len(l.input) && (isIdentChar(l.input[l.pos]) || strings.IndexByte(".-+:", l.input[l.pos]) >= 0) {
+				l.pos++
+			}
+			l.tokens = append(l.tokens, queryToken{kind: tokenNumber, text: l.input[start:l.pos], offset: start})
+
+		case c == '$' || c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			l.pos++
+			for l.pos < len(l.input) && (isIdentChar(l.input[l.pos]) || l.input[l.pos] == '.') {
+				l.pos++
+			}
+
+			text := l.input[start:l.pos]
+			lower := strings.ToLower(text)
+			switch {
+			case lower == "and":
+				l.tokens = append(l.tokens, queryToken{kind: tokenAnd, text: text, offset: start})
+			case lower == "or":
+				l.tokens = append(l.tokens, queryToken{kind: tokenOr, text: text, offset: start})
+			case lower == "not":
+				l.tokens = append(l.tokens, queryToken{kind: tokenNot, text: text, offset: start})
+			case wordOperators[lower]:
+				l.tokens = append(l.tokens, queryToken{kind: tokenOperator, text: lower, offset: start})
+			default:
+				l.tokens = append(l.tokens, queryToken{kind: tokenIdent, text: text, offset: start})
+			}
+
+		default:
+			r, _ := utf8.DecodeRuneInString(l.input[start:])
+			return l.errorAt(start, "unexpected character %q", r)
+		}
+	}
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '$' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// queryNode is the parsed form of a query before it is compiled to protobuf messages
+type queryNode struct {
+	op       string
+	children []*queryNode
+
+	field    string
+	operator string
+	str      string
+	num      float64
+	isNum    bool
+	boolean  bool
+	isBool   bool
+	isNull   bool
+	values   []*pb.Value
+	distance uint32
+	offset   int
+}
+
+// isString tells whether the condition of the node compares a string
+func (n *queryNode) isString() bool {
+	return !n.isNum && !n.isBool && !n.isNull && n.operator != "exists" && !listOperators[n.operator]
+}
+
+type queryParser struct {
+	lexer *queryLexer
+	pos   int
+}
+
+func (p *queryParser) peek() queryToken {
+	return p.lexer.tokens[p.pos]
+}
+
+func (p *queryParser) next() queryToken {
+	t := p.lexer.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *queryParser) parseOr() (*queryNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().kind != tokenOr {
+		return left, nil
+	}
+
+	node := &queryNode{op: "or", children: []*queryNode{left}, offset: left.offset}
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		node.children = append(node.children, right)
+	}
+	return node, nil
+}
+
+func (p *queryParser) parseAnd() (*queryNode, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().kind != tokenAnd {
+		return left, nil
+	}
+
+	node := &queryNode{op: "and", children: []*queryNode{left}, offset: left.offset}
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		node.children = append(node.children, right)
+	}
+	return node, nil
+}
+
+func (p *queryParser) parseOperand() (*queryNode, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenNot:
+		operand, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return &queryNode{op: "not", children: []*queryNode{operand}, offset: t.offset}, nil
+
+	case tokenLeftParen:
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		closing := p.next()
+		if closing.kind != tokenRightParen {
+			line, column := p.lexer.position(t.offset)
+			return nil, p.lexer.errorAt(closing.offset, "expected \")\" to close \"(\" opened at line %d, column %d, found %s", line, column, closing.describe())
+		}
+		return node, nil
+
+	case tokenIdent:
+		return p.parseCondition(t)
+
+	default:
+		return nil, p.lexer.errorAt(t.offset, "expected a field name, \"not\" or \"(\", found %s", t.describe())
+	}
+}
+
+func (p *queryParser) parseCondition(field queryToken) (*queryNode, error) {
+	operator := p.next()
+	if operator.kind != tokenOperator {
+		return nil, p.lexer.errorAt(operator.offset, "expected an operator after field %q, found %s", field.text, operator.describe())
+	}
+
+	node := &queryNode{field: field.text, operator: operator.text, offset: field.offset}
+	if node.operator == "==" || node.operator == "!=" {
+		node.operator = "="
+	}
+
+	if strings.HasPrefix(node.operator, "~") {
+		if len(node.operator) > 1 {
+			distance, err := strconv.Atoi(node.operator[1:])
+			if err != nil || distance > MaxFuzzyDistance {
+				return nil, p.lexer.errorAt(operator.offset, "fuzzy distance must be at most %d, found %q", MaxFuzzyDistance, node.operator[1:])
+			}
+			node.distance = uint32(distance)
+		}
+		node.operator = "~"
+	}
+
+	if node.operator == "near" {
+		distance := p.next()
+		if distance.kind != tokenNumber {
+			return nil, p.lexer.errorAt(distance.offset, "expected a number of words after \"near\", found %s", distance.describe())
+		}
+
+		d, err := strconv.Atoi(distance.text)
+		if err != nil || d > MaxNearDistance {
+			return nil, p.lexer.errorAt(distance.offset, "proximity distance must be at most %d, found %q", MaxNearDistance, distance.text)
+		}
+		if d < 0 {
+			return nil, p.lexer.errorAt(distance.offset, "proximity distance must not be negative, found %q", distance.text)
+		}
+		node.distance = uint32(d)
+	}
+
+	if node.operator == "exists" {
+		return node, nil
+	}
+
+	if listOperators[node.operator] {
+		return p.parseList(node)
+	}
+
+	value := p.next()
+	switch value.kind {
+	case tokenString:
+		str, err := strconv.Unquote(value.text)
+		if err != nil {
+			return nil, p.lexer.errorAt(value.offset, "invalid string %s", value.text)
+		}
+		node.str = str
+
+		switch node.operator {
+		case "<", "<=", ">", ">=":
+			ms, ok := ParseTimestamp(str)
+			if !ok {
+				return nil, p.lexer.errorAt(value.offset, "operator %q requires a number or a timestamp value", node.operator)
+			}
+			node.num = float64(ms)
+			node.isNum = true
+		}
+
+	case tokenNumber:
+		num, ok := parseNumber(value.text)
+		if !ok {
+			return nil, p.lexer.errorAt(value.offset, "invalid number or timestamp %q", value.text)
+		}
+		node.num = num
+		node.isNum = true
+
+	case tokenIdent:
+		switch strings.ToLower(value.text) {
+		case "true", "false":
+			node.boolean = strings.ToLower(value.text) == "true"
+			node.isBool = true
+		case "null":
+			node.isNull = true
+		default:
+			return nil, p.lexer.errorAt(value.offset, "expected a string, a number, a timestamp, true, false or null, found %s", value.describe())
+		}
+
+	default:
+		return nil, p.lexer.errorAt(value.offset, "expected a string, a number, a timestamp, true, false or null, found %s", value.describe())
+	}
+
+	if !node.isString() && (wordOperators[node.operator] || node.operator == "~") {
+		return nil, p.lexer.errorAt(value.offset, "operator %q requires a string value", node.operator)
+	}
+
+	if (node.isBool || node.isNull) && node.operator != "=" {
+		return nil, p.lexer.errorAt(value.offset, "operator %q does not compare %s", node.operator, value.text)
+	}
+
+	if operator.text == "!=" {
+		return &queryNode{op: "not", children: []*queryNode{node}, offset: node.offset}, nil
+	}
+	return node, nil
+}
+
+// parseList parses the parenthesized values compared to the field of node by a list operator
+func (p *queryParser) parseList(node *queryNode) (*queryNode, error) {
+	opening := p.next()
+	if opening.kind != tokenLeftParen {
+		return nil, p.lexer.errorAt(opening.offset, "expected \"(\" after operator %q, found %s", node.operator, opening.describe())
+	}
+
+	for {
+		item := p.next()
+		switch item.kind {
+		case tokenString:
+			str, err := strconv.Unquote(item.text)
+			if err != nil {
+				return nil, p.lexer.errorAt(item.offset, "invalid string %s", item.text)
+			}
+			node.values = append(node.values, &pb.Value{Value: &pb.Value_Text{Text: str}})
+
+		case tokenNumber:
+			num, ok := parseNumber(item.text)
+			if !ok {
+				return nil, p.lexer.errorAt(item.offset, "invalid number or timestamp %q", item.text)
+			}
+			node.values = append(node.values, &pb.Value{Value: &pb.Value_Number{Number: num}})
+
+		default:
+			lower := strings.ToLower(item.text)
+			if item.kind != tokenIdent || lower != "true" && lower != "false" {
+				return nil, p.lexer.errorAt(item.offset, "expected a string, a number, a timestamp, true or false, found %s", item.describe())
+			}
+			node.values = append(node.values, &pb.Value{Value: &pb.Value_Boolean{Boolean: lower == "true"}})
+		}
+
+		if len(node.values) > MaxListValues {
+			return nil, p.lexer.errorAt(item.offset, "operator %q accepts at most %d values", node.operator, MaxListValues)
+		}
+
+		separator := p.next()
+		switch separator.kind {
+		case tokenRightParen:
+			return node, nil
+		case tokenComma:
+		default:
+			line, column := p.lexer.position(opening.offset)
+			return nil, p.lexer.errorAt(separator.offset, "expected \",\" or \")\" to close \"(\" opened at line %d, column %d, found %s", line, column, separator.describe())
+		}
+	}
+}
+
+// ParseQuery compiles a textual query into a search query
+func ParseQuery(text string) (*pb.SearchQuery, error) {
+	lexer := &queryLexer{input: text}
+	err := lexer.scan()
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &queryParser{lexer: lexer}
+	if parser.peek().kind == tokenEOF {
+		return nil, lexer.errorAt(0, "empty query")
+	}
+
+	root, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if t := parser.peek(); t.kind != tokenEOF {
+		return nil, lexer.errorAt(t.offset, "expected \"and\", \"or\" or end of query, found %s", t.describe())
+	}
+
+	c := &queryCompiler{lexer: lexer}
+	return c.compile(root)
+}
+
+type queryCompiler struct {
+	lexer *queryLexer
+}
+
+// target returns the pseudo field targeted by all conditions of the node, or an empty string for properties
+func (c *queryCompiler) target(node *queryNode) (string, error) {
+	if node.op == "" {
+		if node.field == TextField || node.field == NumberField || node.field == GeoField {
+			return node.field, nil
+		}
+		return "", nil
+	}
+
+	first, err := c.target(node.children[0])
+	if err != nil {
+		return "", err
+	}
+
+	for _, child := range node.children[1:] {
+		t, err := c.target(child)
+		if err != nil {
+			return "", err
+		}
+		if t != first {
+			return "", c.lexer.errorAt(child.offset, "conditions on %s cannot be combined with conditions on %s", targetName(t), targetName(first))
+		}
+	}
+	return first, nil
+}
+
+func targetName(target string) string {
+	if target == "" {
+		return "properties"
+	}
+	return target
+}
+
+func (c *queryCompiler) compile(root *queryNode) (*pb.SearchQuery, error) {
+	target, err := c.target(root)
+	if err != nil {
+		return nil, err
+	}
+
+	switch target {
+	case TextField:
+		q, err := c.compileText(root)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.SearchQuery{Query: &pb.SearchQuery_Text{Text: q}}, nil
+
+	case NumberField:
+		q, err := c.compileNumber(root)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.SearchQuery{Query: &pb.SearchQuery_Number{Number: q}}, nil
+
+	case GeoField:
+		q, err := c.compileGeo(root)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.SearchQuery{Query: &pb.SearchQuery_Geo{Geo: q}}, nil
+
+	default:
+		q, err := c.compileFields(root)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.SearchQuery{Query: &pb.SearchQuery_Fields{Fields: q}}, nil
+	}
+}
+
+func (c *queryCompiler) compileText(node *queryNode) (*pb.StrQuery, error) {
+	switch node.op {
+	case "and":
+		return nil, c.lexer.errorAt(node.children[1].offset, "\"and\" is not supported on %s conditions", TextField)
+
+	case "not":
+		q, err := c.compileText(node.children[0])
+		if err != nil {
+			return nil, err
+		}
+		return &pb.StrQuery{Bool: &pb.StrQuery_Not{Not: &pb.StrNot{Expressions: q}}}, nil
+
+	case "or":
+		or := &pb.StrOr{}
+		for _, child := range node.children {
+			q, err := c.compileText(child)
+			if err != nil {
+				return nil, err
+			}
+			or.Queries = append(or.Queries, q)
+		}
+		return &pb.StrQuery{Bool: &pb.StrQuery_Or{Or: or}}, nil
+	}
+
+	if !node.isString() {
+		return nil, c.lexer.errorAt(node.offset, "%s conditions require a string value", TextField)
+	}
+
+	switch node.operator {
+	case "=":
+		return &pb.StrQuery{Bool: &pb.StrQuery_Eq{Eq: &pb.StrEqual{Value: node.str}}}, nil
+	case "contains":
+		return &pb.StrQuery{Bool: &pb.StrQuery_Contains{Contains: &pb.Contains{Value: node.str}}}, nil
+	case "startswith":
+		return &pb.StrQuery{Bool: &pb.StrQuery_StartsWith{StartsWith: &pb.StartsWith{Value: node.str}}}, nil
+	case "endswith":
+		return &pb.StrQuery{Bool: &pb.StrQuery_EndsWith{EndsWith: &pb.EndsWith{Value: node.str}}}, nil
+	case "~":
+		return &pb.StrQuery{Bool: &pb.StrQuery_Fuzzy{Fuzzy: &pb.Fuzzy{Value: node.str, MaxDistance: node.distance}}}, nil
+	case "phrase":
+		return &pb.StrQuery{Bool: &pb.StrQuery_Phrase{Phrase: &pb.Phrase{Value: node.str}}}, nil
+	case "near":
+		return &pb.StrQuery{Bool: &pb.StrQuery_Near{Near: &pb.Near{Value: node.str, Distance: node.distance}}}, nil
+	}
+	return nil, c.lexer.errorAt(node.offset, "operator %q is not supported on %s", node.operator, TextField)
+}
+
+func (c *queryCompiler) compileNumber(node *queryNode) (*pb.NumQuery, error) {
+	switch node.op {
+	case "not":
+		q, err := c.compileNumber(node.children[0])
+		if err != nil {
+			return nil, err
+		}
+		return &pb.NumQuery{Bool: &pb.NumQuery_Not{Not: &pb.NumNot{Expressions: q}}}, nil
+
+	case "and", "or":
+		var queries []*pb.NumQuery
+		for _, child := range node.children {
+			q, err := c.compileNumber(child)
+			if err != nil {
+				return nil, err
+			}
+			queries = append(queries, q)
+		}
+
+		if node.op == "and" {
+			return &pb.NumQuery{Bool: &pb.NumQuery_And{And: &pb.NumAnd{Queries: queries}}}, nil
+		}
+		return &pb.NumQuery{Bool: &pb.NumQuery_Or{Or: &pb.NumOr{Queries: queries}}}, nil
+	}
+
+	if !node.isNum {
+		return nil, c.lexer.errorAt(node.offset, "%s conditions require a number or a timestamp value", NumberField)
+	}
+
+	switch node.operator {
+	case "=":
+		return &pb.NumQuery{Bool: &pb.NumQuery_Eq{Eq: &pb.NumbEq{Value: node.num}}}, nil
+	case "<":
+		return &pb.NumQuery{Bool: &pb.NumQuery_Lt{Lt: &pb.Lt{Value: node.num}}}, nil
+	case "<=":
+		return &pb.NumQuery{Bool: &pb.NumQuery_Lte{Lte: &pb.Lte{Value: node.num}}}, nil
+	case ">":
+		return &pb.NumQuery{Bool: &pb.NumQuery_Gt{Gt: &pb.Gt{Value: node.num}}}, nil
+	case ">=":
+		return &pb.NumQuery{Bool: &pb.NumQuery_Gte{Gte: &pb.Gte{Value: node.num}}}, nil
+	}
+	return nil, c.lexer.errorAt(node.offset, "operator %q is not supported on %s", node.operator, NumberField)
+}
+
+func (c *queryCompiler) compileGeo(node *queryNode) (*pb.GeoQuery, error) {
+	switch node.op {
+	case "not":
+		q, err := c.compileGeo(node.children[0])
+		if err != nil {
+			return nil, err
+		}
+		return &pb.GeoQuery{Bool: &pb.GeoQuery_Not{Not: &pb.GeoNot{Expressions: q}}}, nil
+
+	case "and", "or":
+		var queries []*pb.GeoQuery
+		for _, child := range node.children {
+			q, err := c.compileGeo(child)
+			if err != nil {
+				return nil, err
+			}
+			queries = append(queries, q)
+		}
+
+		if node.op == "and" {
+			return &pb.GeoQuery{Bool: &pb.GeoQuery_And{And: &pb.GeoAnd{Queries: queries}}}, nil
+		}
+		return &pb.GeoQuery{Bool: &pb.GeoQuery_Or{Or: &pb.GeoOr{Queries: queries}}}, nil
+	}
+
+	count := map[string]int{"within_radius": 3, "within_box": 4}[node.operator]
+	if count == 0 {
+		return nil, c.lexer.errorAt(node.offset, "operator %q is not supported on %s", node.operator, GeoField)
+	}
+
+	var numbers []float64
+	for _, value := range node.values {
+		if num, ok := value.Value.(*pb.Value_Number); ok {
+			numbers = append(numbers, num.Number)
+		}
+	}
+	if len(numbers) != count || len(node.values) != count {
+		return nil, c.lexer.errorAt(node.offset, "operator %q requires %d numbers", node.operator, count)
+	}
+
+	if node.operator == "within_radius" {
+		return &pb.GeoQuery{Bool: &pb.GeoQuery_WithinRadius{WithinRadius: &pb.WithinRadius{
+			Center: &pb.GeoPoint{Lat: numbers[0], Lon: numbers[1]},
+			Meters: numbers[2],
+		}}}, nil
+	}
+	return &pb.GeoQuery{Bool: &pb.GeoQuery_WithinBox{WithinBox: &pb.WithinBox{
+		SouthWest: &pb.GeoPoint{Lat: numbers[0], Lon: numbers[1]},
+		NorthEast: &pb.GeoPoint{Lat: numbers[2], Lon: numbers[3]},
+	}}}, nil
+}
+
+func (c *queryCompiler) compileFields(node *queryNode) (*pb.FieldQuery, error) {
+	switch node.op {
+	case "not":
+		q, err := c.compileFields(node.children[0])
+		if err != nil {
+			return nil, err
+		}
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_Not{Not: &pb.Not{Expressions: q}}}, nil
+
+	case "and", "or":
+		var queries []*pb.FieldQuery
+		for _, child := range node.children {
+			q, err := c.compileFields(child)
+			if err != nil {
+				return nil, err
+			}
+			queries = append(queries, q)
+		}
+
+		if node.op == "and" {
+			return &pb.FieldQuery{Bool: &pb.FieldQuery_And{And: &pb.And{Queries: queries}}}, nil
+		}
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_Or{Or: &pb.Or{Queries: queries}}}, nil
+	}
+
+	field := node.field
+	switch {
+	case node.operator == "in":
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_In{In: &pb.In{Field: field, Values: node.values}}}, nil
+	case node.operator == "any_of":
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_AnyOf{AnyOf: &pb.AnyOf{Field: field, Values: node.values}}}, nil
+	case node.operator == "all_of":
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_AllOf{AllOf: &pb.AllOf{Field: field, Values: node.values}}}, nil
+	case node.operator == "exists":
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_Exists{Exists: &pb.Exists{Field: field}}}, nil
+	case node.isNull:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_IsNull{IsNull: &pb.IsNull{Field: field}}}, nil
+	case node.isBool:
+		return &pb.FieldQuery{Bool: &pb.FieldQuery_BoolEq{BoolEq: &pb.BoolEq{Field: field, Value: node.boolean}}}, nil
+	}
+
+	if node.isNum {
+		switch node.operator {
+		case "=":
+			return &pb.FieldQuery{Bool: &pb.FieldQuery_NumbEq{NumbEq: &pb.NumbEq{Field: field, Value: node.num}}}, nil
+		case "<":
+			return &pb.FieldQuery{Bool: &pb.FieldQuery_Lt{Lt: &pb.Lt{Field: field, Value: node.num}}}, nil
+		case "<=":
+			return &pb.FieldQuery{Bool: &pb.FieldQuery_Lte{Lte: &pb.Lte{Field: field, Value: node.num}}}, nil
+		case ">":
+			return &pb.FieldQuery{Bool: &pb.FieldQuery_Gt{Gt: &pb.Gt{Field: field, Value: node.num}}}, nil
+		case ">=":
+			return &pb.FieldQuery{Bool: &pb.FieldQuery_Gte{Gte: &pb.Gte{Field: field, Value: node.num}}}, nil
+		}
+
+	} else {
+		switch node.operator {
+		case "=":
+			return &pb.FieldQuery{Bool: &pb.FieldQuery_StrEqual{StrEqual: &pb.StrEqual{Field: field, Value: node.str}}}, nil
+		case "contains":
+			return &pb.FieldQuery{Bool: &pb.FieldQuery_Contains{Contains: &pb.Contains{Field: field, Value: node.str}}}, nil
+		case "startswith":
+			return &pb.FieldQuery{Bool: &pb.FieldQuery_StartsWith{StartsWith: &pb.StartsWith{Field: field, Value: node.str}}}, nil
+		case "endswith":
+			return &pb.FieldQuery{Bool: &pb.FieldQuery_EndsWith{EndsWith: &pb.EndsWith{Field: field, Value: node.str}}}, nil
+		}
+	}
+	return nil, c.lexer.errorAt(node.offset, "operator %q is not supported", node.operator)
+}
@@ -0,0 +1,204 @@
+package se
+
+import (
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestParseQuery(t *testing.T) {
+	Convey("Field conditions are compiled to a properties query", t, func() {
+		q, err := ParseQuery(`price > 10 and (name startswith "ab" or tags contains "x")`)
+		So(err, ShouldBeNil)
+
+		expected := &pb.SearchQuery{Query: &pb.SearchQuery_Fields{Fields: &pb.FieldQuery{Bool: &pb.FieldQuery_And{And: &pb.And{Queries: []*pb.FieldQuery{
+			{Bool: &pb.FieldQuery_Gt{Gt: &pb.Gt{Field: "price", Value: 10}}},
+			{Bool: &pb.FieldQuery_Or{Or: &pb.Or{Queries: []*pb.FieldQuery{
+				{Bool: &pb.FieldQuery_StartsWith{StartsWith: &pb.StartsWith{Field: "name", Value: "ab"}}},
+				{Bool: &pb.FieldQuery_Contains{Contains: &pb.Contains{Field: "tags", Value: "x"}}},
+			}}}},
+		}}}}}}
+		So(proto.Equal(q, expected), ShouldBeTrue)
+	})
+
+	Convey("Pseudo fields target text and number indexes", t, func() {
+		q, err := ParseQuery(`$text contains "juve" or $text = "turin"`)
+		So(err, ShouldBeNil)
+		So(q.GetText().GetOr().GetQueries(), ShouldHaveLength, 2)
+
+		q, err = ParseQuery(`$text ~ "iphnoe" or $text ~1 "galxy"`)
+		So(err, ShouldBeNil)
+		So(q.GetText().GetOr().GetQueries()[0].GetFuzzy().GetMaxDistance(), ShouldEqual, 0)
+		So(q.GetText().GetOr().GetQueries()[1].GetFuzzy().GetMaxDistance(), ShouldEqual, 1)
+
+		q, err = ParseQuery(`$text phrase "new york" or $text NEAR 3 "york city"`)
+		So(err, ShouldBeNil)
+		So(q.GetText().GetOr().GetQueries()[0].GetPhrase().GetValue(), ShouldEqual, "new york")
+		So(q.GetText().GetOr().GetQueries()[1].GetNear().GetValue(), ShouldEqual, "york city")
+		So(q.GetText().GetOr().GetQueries()[1].GetNear().GetDistance(), ShouldEqual, 3)
+
+		q, err = ParseQuery(`$number >= -3 AND $number < 40`)
+		So(err, ShouldBeNil)
+		So(q.GetNumber().GetAnd().GetQueries()[0].GetGte().GetValue(), ShouldEqual, -3)
+	})
+
+	Convey("Values are strings, numbers, timestamps, booleans or null", t, func() {
+		q, err := ParseQuery(`price > 10.5 and created >= 2021-03-01 and updated < "2021-03-01T10:00:00+02:00" and active = true and deleted = null and tags exists`)
+		So(err, ShouldBeNil)
+
+		expected := &pb.SearchQuery{Query: &pb.SearchQuery_Fields{Fields: &pb.FieldQuery{Bool: &pb.FieldQuery_And{And: &pb.And{Queries: []*pb.FieldQuery{
+			{Bool: &pb.FieldQuery_Gt{Gt: &pb.Gt{Field: "price", Value: 10.5}}},
+			{Bool: &pb.FieldQuery_Gte{Gte: &pb.Gte{Field: "created", Value: 1614556800000}}},
+			{Bool: &pb.FieldQuery_Lt{Lt: &pb.Lt{Field: "updated", Value: 1614585600000}}},
+			{Bool: &pb.FieldQuery_BoolEq{BoolEq: &pb.BoolEq{Field: "active", Value: true}}},
+			{Bool: &pb.FieldQuery_IsNull{IsNull: &pb.IsNull{Field: "deleted"}}},
+			{Bool: &pb.FieldQuery_Exists{Exists: &pb.Exists{Field: "tags"}}},
+		}}}}}}
+		So(proto.Equal(q, expected), ShouldBeTrue)
+
+		q, err = ParseQuery(`$number >= 2021-03-01T00:00:00.250Z or $number = -1.5e3`)
+		So(err, ShouldBeNil)
+		So(q.GetNumber().GetOr().GetQueries()[0].GetGte().GetValue(), ShouldEqual, 1614556800250)
+		So(q.GetNumber().GetOr().GetQueries()[1].GetEq().GetValue(), ShouldEqual, -1500)
+
+		q, err = ParseQuery(`name = "2021-03-01"`)
+		So(err, ShouldBeNil)
+		So(q.GetFields().GetStrEqual().GetValue(), ShouldEqual, "2021-03-01")
+	})
+
+	Convey("List operators compare fields to parenthesized values", t, func() {
+		q, err := ParseQuery(`tags in ("a", "b") or sizes any_of (1.5, 2021-03-01) or flags all_of (true, "x")`)
+		So(err, ShouldBeNil)
+
+		expected := &pb.SearchQuery{Query: &pb.SearchQuery_Fields{Fields: &pb.FieldQuery{Bool: &pb.FieldQuery_Or{Or: &pb.Or{Queries: []*pb.FieldQuery{
+			{Bool: &pb.FieldQuery_In{In: &pb.In{Field: "tags", Values: []*pb.Value{
+				{Value: &pb.Value_Text{Text: "a"}},
+				{Value: &pb.Value_Text{Text: "b"}},
+			}}}},
+			{Bool: &pb.FieldQuery_AnyOf{AnyOf: &pb.AnyOf{Field: "sizes", Values: []*pb.Value{
+				{Value: &pb.Value_Number{Number: 1.5}},
+				{Value: &pb.Value_Number{Number: 1614556800000}},
+			}}}},
+			{Bool: &pb.FieldQuery_AllOf{AllOf: &pb.AllOf{Field: "flags", Values: []*pb.Value{
+				{Value: &pb.Value_Boolean{Boolean: true}},
+				{Value: &pb.Value_Text{Text: "x"}},
+			}}}},
+		}}}}}}
+		So(proto.Equal(q, expected), ShouldBeTrue)
+	})
+
+	Convey("Geo operators match points within a radius or a box", t, func() {
+		q, err := ParseQuery(`$geo within_radius (48.85, 2.35, 1500) and not $geo within_box (-10, 170, 10, -170)`)
+		So(err, ShouldBeNil)
+
+		expected := &pb.SearchQuery{Query: &pb.SearchQuery_Geo{Geo: &pb.GeoQuery{Bool: &pb.GeoQuery_And{And: &pb.GeoAnd{Queries: []*pb.GeoQuery{
+			{Bool: &pb.GeoQuery_WithinRadius{WithinRadius: &pb.WithinRadius{Center: &pb.GeoPoint{Lat: 48.85, Lon: 2.35}, Meters: 1500}}},
+			{Bool: &pb.GeoQuery_Not{Not: &pb.GeoNot{Expressions: &pb.GeoQuery{Bool: &pb.GeoQuery_WithinBox{WithinBox: &pb.WithinBox{
+				SouthWest: &pb.GeoPoint{Lat: -10, Lon: 170},
+				NorthEast: &pb.GeoPoint{Lat: 10, Lon: -170},
+			}}}}}},
+		}}}}}}
+		So(proto.Equal(q, expected), ShouldBeTrue)
+	})
+
+	Convey("Negations apply to the following operand", t, func() {
+		q, err := ParseQuery(`status != "archived" and not (tags contains "x" or age < 3)`)
+		So(err, ShouldBeNil)
+
+		expected := &pb.SearchQuery{Query: &pb.SearchQuery_Fields{Fields: &pb.FieldQuery{Bool: &pb.FieldQuery_And{And: &pb.And{Queries: []*pb.FieldQuery{
+			{Bool: &pb.FieldQuery_Not{Not: &pb.Not{Expressions: &pb.FieldQuery{Bool: &pb.FieldQuery_StrEqual{StrEqual: &pb.StrEqual{Field: "status", Value: "archived"}}}}}},
+			{Bool: &pb.FieldQuery_Not{Not: &pb.Not{Expressions: &pb.FieldQuery{Bool: &pb.FieldQuery_Or{Or: &pb.Or{Queries: []*pb.FieldQuery{
+				{Bool: &pb.FieldQuery_Contains{Contains: &pb.Contains{Field: "tags", Value: "x"}}},
+				{Bool: &pb.FieldQuery_Lt{Lt: &pb.Lt{Field: "age", Value: 3}}},
+			}}}}}}},
+		}}}}}}
+		So(proto.Equal(q, expected), ShouldBeTrue)
+
+		q, err = ParseQuery(`not $text contains "juve"`)
+		So(err, ShouldBeNil)
+		So(q.GetText().GetNot().GetExpressions().GetContains().GetValue(), ShouldEqual, "juve")
+
+		q, err = ParseQuery(`$number != 4`)
+		So(err, ShouldBeNil)
+		So(q.GetNumber().GetNot().GetExpressions().GetEq().GetValue(), ShouldEqual, 4)
+	})
+
+	Convey("Syntax errors report the position of the faulty token", t, func() {
+		cases := map[string]string{
+			``:                                "syntax error at line 1, column 1: empty query",
+			`price >`:                         "syntax error at line 1, column 8: expected a string, a number, a timestamp, true, false or null, found end of query",
+			`price > 1 and (name = "a"`:       "syntax error at line 1, column 26: expected \")\" to close \"(\" opened at line 1, column 15, found end of query",
+			"name = \"a\"\nor age > \"b\"":    "syntax error at line 2, column 10: operator \">\" requires a number or a timestamp value",
+			`name # "a"`:                      "syntax error at line 1, column 6: unexpected character '#'",
+			`name ~ "a"`:                      "syntax error at line 1, column 1: operator \"~\" is not supported",
+			`$text ~3 "a"`:                    "syntax error at line 1, column 7: fuzzy distance must be at most 2, found \"3\"",
+			`$text ~ 3`:                       "syntax error at line 1, column 9: operator \"~\" requires a string value",
+			`$text near "a b"`:                "syntax error at line 1, column 12: expected a number of words after \"near\", found string \"a b\"",
+			`$text near 1001 "a b"`:           "syntax error at line 1, column 12: proximity distance must be at most 1000, found \"1001\"",
+			`$text near -1 "a b"`:             "syntax error at line 1, column 12: proximity distance must not be negative, found \"-1\"",
+			`$text phrase 3`:                  "syntax error at line 1, column 14: operator \"phrase\" requires a string value",
+			`name phrase "a b"`:               "syntax error at line 1, column 1: operator \"phrase\" is not supported",
+			`name = "a" price > 1`:            "syntax error at line 1, column 12: expected \"and\", \"or\" or end of query, found \"price\"",
+			`$text = "a" and $text = "b"`:     "syntax error at line 1, column 17: \"and\" is not supported on $text conditions",
+			`$number > 1 or price > 1`:        "syntax error at line 1, column 16: conditions on properties cannot be combined with conditions on $number",
+			`age > 12ab`:                      "syntax error at line 1, column 7: invalid number or timestamp \"12ab\"",
+			`age > 2021-13-01`:                "syntax error at line 1, column 7: invalid number or timestamp \"2021-13-01\"",
+			`name = nothing`:                  "syntax error at line 1, column 8: expected a string, a number, a timestamp, true, false or null, found \"nothing\"",
+			`active > true`:                   "syntax error at line 1, column 10: operator \">\" does not compare true",
+			`name contains null`:              "syntax error at line 1, column 15: operator \"contains\" requires a string value",
+			`$text = null`:                    "syntax error at line 1, column 1: $text conditions require a string value",
+			`$number exists`:                  "syntax error at line 1, column 1: $number conditions require a number or a timestamp value",
+			`not`:                             "syntax error at line 1, column 4: expected a field name, \"not\" or \"(\", found end of query",
+			`tags in "a"`:                     "syntax error at line 1, column 9: expected \"(\" after operator \"in\", found string \"a\"",
+			`tags in ()`:                      "syntax error at line 1, column 10: expected a string, a number, a timestamp, true or false, found \")\"",
+			`tags any_of ("a", null)`:         "syntax error at line 1, column 19: expected a string, a number, a timestamp, true or false, found \"null\"",
+			`tags all_of ("a" "b")`:           "syntax error at line 1, column 18: expected \",\" or \")\" to close \"(\" opened at line 1, column 13, found string \"b\"",
+			`$text in ("a")`:                  "syntax error at line 1, column 1: $text conditions require a string value",
+			`$geo within_radius (1, 2)`:       "syntax error at line 1, column 1: operator \"within_radius\" requires 3 numbers",
+			`$geo within_box (1, 2, 3, "a")`:  "syntax error at line 1, column 1: operator \"within_box\" requires 4 numbers",
+			`$geo = 1`:                        "syntax error at line 1, column 1: operator \"=\" is not supported on $geo",
+			`$geo exists or price > 1`:        "syntax error at line 1, column 16: conditions on properties cannot be combined with conditions on $geo",
+			`name within_box (1, 2, 3, 4)`:    "syntax error at line 1, column 1: operator \"within_box\" is not supported",
+			`$number within_radius (1, 2, 3)`: "syntax error at line 1, column 1: $number conditions require a number or a timestamp value",
+			`a ! 1`:                           "syntax error at line 1, column 3: unexpected character '!'",
+		}
+
+		for text, message := range cases {
+			_, err := ParseQuery(text)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, message)
+		}
+	})
+}
+
+func TestFormatQuery(t *testing.T) {
+	Convey("Formatted queries parse back to the same query", t, func() {
+		for _, text := range []string{
+			`price > 10 and (name startswith "ab" or tags contains "x")`,
+			`a = 1 or b = "q\"uote" and c endswith "z"`,
+			`(a <= 1 or b >= 2) or c = "été"`,
+			`$text contains "juve" or $text endswith "us"`,
+			`$number = 4 or ($number > 10 and $number < 20)`,
+			`not a = 1 and not (b = 2 or c != "x")`,
+			`not not $text = "a" or $text != "b"`,
+			`$text ~ "iphnoe" or not $text ~2 "galxy"`,
+			`$text phrase "new york" or $text near 0 "york new" or $text near 12 "a b c"`,
+			`$number != 4 and not ($number > 10 and $number < 20)`,
+			`price >= 10.25 and (active = true or deleted = null) and not tags exists`,
+			`created >= 2021-03-01 and size < 0.001`,
+			`$number >= 1614556800000.5 or $number = -1e-7`,
+			`tags in ("a", "b") and not (sizes any_of (1.5, -2) or flags all_of (true, "x,y"))`,
+			`$geo within_radius (48.85, 2.35, 1500) or not ($geo within_box (-10, 170, 10, -170) and $geo within_box (0, 0, 1, 1))`,
+		} {
+			q, err := ParseQuery(text)
+			So(err, ShouldBeNil)
+
+			formatted := FormatQuery(q)
+			parsed, err := ParseQuery(formatted)
+			So(err, ShouldBeNil)
+			So(proto.Equal(q, parsed), ShouldBeTrue)
+			So(FormatQuery(parsed), ShouldEqual, formatted)
+		}
+	})
+}
@@ -1,89 +1,206 @@
 package se
 
-import "strings"
+import (
+	pb "github.com/omecodes/store/gen/go/proto"
+	"math"
+	"sort"
+	"strings"
+)
 
-type scoreRecords struct {
-	scores map[string]int
-}
+const (
+	// bm25K1 controls how quickly repeated occurrences of a term stop increasing the score
+	bm25K1 = 1.2
 
-func (s *scoreRecords) append(ids ...string) {
-	if s.scores == nil {
-		s.scores = make(map[string]int)
-	}
+	// bm25B controls how much scores are normalized by the document length
+	bm25B = 0.75
+)
 
-	for _, id := range ids {
-		score, found := s.scores[id]
-		if found {
-			s.scores[id] = score + 1
-		} else {
-			s.scores[id] = 0
-		}
-	}
+// posting is an occurrence of a token in an indexed text field of an object
+type posting struct {
+	token  string
+	id     string
+	field  string
+	tf     int64
+	length int64
 }
 
-func (s *scoreRecords) sorted() []string {
-	var sortedIds []string
-	var sortedValues []int
+// fieldStats holds the statistics of an indexed text field used to normalize scores
+type fieldStats struct {
+	docs      int64
+	avgLength float64
+}
 
-	for key, val := range s.scores {
-		ind := 0
-		for _, sk := range sortedValues {
-			if val > sk {
-				break
-			}
-			ind++
-		}
+// tokenMatch describes how a token satisfies a text query condition
+type tokenMatch struct {
+	// term is the query term the token matched. Tokens matching the same term share its document frequency
+	term string
 
-		if ind == len(sortedValues) {
-			sortedValues = append(sortedValues, val)
-			sortedIds = append(sortedIds, key)
+	// weight is the factor applied to the relevance of the token
+	weight float64
+}
 
-		} else if ind == 0 {
-			sortedValues = append([]int{val}, sortedValues...)
-			sortedIds = append([]string{key}, sortedIds...)
+// tokenMatcher tells whether a token indexed from field satisfies a text query condition
+type tokenMatcher func(field, token string) (tokenMatch, bool)
 
-		} else {
-			sortedValues = append(append(sortedValues[:ind], val), sortedValues[ind+1:]...)
-			sortedIds = append(append(sortedIds[:ind], key), sortedIds[ind+1:]...)
+func exactMatch(token string) (tokenMatch, bool) {
+	return tokenMatch{term: token, weight: 1}, true
+}
+
+func containsMatcher(pattern string) tokenMatcher {
+	return func(_, token string) (tokenMatch, bool) {
+		if !strings.Contains(token, pattern) {
+			return tokenMatch{}, false
 		}
+		return exactMatch(token)
 	}
-	return sortedIds
 }
 
-type tokenMatchScorer func(string, string, *scoreRecords)
+func startsWithMatcher(pattern string) tokenMatcher {
+	return func(_, token string) (tokenMatch, bool) {
+		if !strings.HasPrefix(token, pattern) {
+			return tokenMatch{}, false
+		}
+		return exactMatch(token)
+	}
+}
 
-func containsScorer(pattern string) tokenMatchScorer {
-	return func(token string, id string, records *scoreRecords) {
-		if strings.Contains(token, pattern) {
-			records.append(id)
+func equalsMatcher(pattern string) tokenMatcher {
+	return func(_, token string) (tokenMatch, bool) {
+		if pattern != token {
+			return tokenMatch{}, false
 		}
+		return exactMatch(token)
 	}
 }
 
-func startsWithScorer(pattern string) tokenMatchScorer {
-	return func(token string, id string, records *scoreRecords) {
-		if strings.HasPrefix(token, pattern) {
-			records.append(id)
+func endsWithMatcher(pattern string) tokenMatcher {
+	return func(_, token string) (tokenMatch, bool) {
+		if !strings.HasSuffix(token, pattern) {
+			return tokenMatch{}, false
 		}
+		return exactMatch(token)
 	}
 }
 
-func equalsScorer(pattern string) tokenMatchScorer {
-	return func(token string, id string, records *scoreRecords) {
-		if pattern == token {
-			records.append(id)
+// bestMatch returns the match of the matcher that weighs token the most
+func bestMatch(matchers []tokenMatcher, field, token string) (tokenMatch, bool) {
+	var (
+		best    tokenMatch
+		matched bool
+	)
+	for _, matcher := range matchers {
+		if m, ok := matcher(field, token); ok && (!matched || m.weight > best.weight) {
+			best, matched = m, true
 		}
 	}
+	return best, matched
 }
 
-func endsWithScorer(pattern string) tokenMatchScorer {
-	return func(token string, id string, records *scoreRecords) {
-		if strings.HasSuffix(token, pattern) {
-			records.append(id)
+// fieldsMatcher restricts matchers to tokens indexed from fields. When exclude is set, it restricts them to
+// tokens indexed from any other field
+func fieldsMatcher(fields map[string]bool, exclude bool, matchers []tokenMatcher) tokenMatcher {
+	return func(field, token string) (tokenMatch, bool) {
+		if len(fields) > 0 && fields[field] == exclude {
+			return tokenMatch{}, false
 		}
+		return bestMatch(matchers, field, token)
+	}
+}
+
+// termScore is the contribution of a posting to the score of its object
+type termScore struct {
+	*posting
+	match  tokenMatch
+	df     int64
+	stats  *fieldStats
+	idf    float64
+	norm   float64
+	boost  float64
+	weight float64
+}
+
+// bm25 ranks the objects of postings. Only postings whose token is accepted by one of matchers contribute to the
+// score; the others, loaded because of negations, only make their object part of the result.
+// Term weights are multiplied by the weight of their match and by the boost of the field they were indexed from
+func bm25(postings []*posting, matchers []tokenMatcher, stats map[string]*fieldStats, boosts map[string]float64) []*pb.SearchHit {
+	ids, terms := bm25Terms(postings, matchers, stats, boosts)
+
+	scores := map[string]float64{}
+	for _, t := range terms {
+		scores[t.id] += t.weight
+	}
+
+	hits := make([]*pb.SearchHit, len(ids))
+	for ind, id := range ids {
+		hits[ind] = &pb.SearchHit{Id: id, Score: scores[id]}
 	}
+	sortHits(hits)
+	return hits
 }
 
-func presenceScorer(_ string, id string, records *scoreRecords) {
-	records.append(id)
+// bm25Terms returns the ids of the objects of postings, in the order of postings, and the contributions of the
+// postings whose token is accepted by one of matchers
+func bm25Terms(postings []*posting, matchers []tokenMatcher, stats map[string]*fieldStats, boosts map[string]float64) ([]string, []*termScore) {
+	type termKey struct{ term, field string }
+
+	documents := map[termKey]map[string]bool{}
+	var matched []*termScore
+
+	seen := map[string]bool{}
+	var ids []string
+
+	for _, p := range postings {
+		if !seen[p.id] {
+			seen[p.id] = true
+			ids = append(ids, p.id)
+		}
+
+		if m, ok := bestMatch(matchers, p.field, p.token); ok {
+			key := termKey{m.term, p.field}
+			if documents[key] == nil {
+				documents[key] = map[string]bool{}
+			}
+			documents[key][p.id] = true
+			matched = append(matched, &termScore{posting: p, match: m})
+		}
+	}
+
+	var terms []*termScore
+	for _, t := range matched {
+		st := stats[t.field]
+		if st == nil || st.docs == 0 {
+			continue
+		}
+		t.stats = st
+
+		t.df = int64(len(documents[termKey{t.match.term, t.field}]))
+		df := float64(t.df)
+		t.idf = math.Log(1 + (float64(st.docs)-df+0.5)/(df+0.5))
+
+		t.norm = 1.0
+		if st.avgLength > 0 {
+			t.norm = 1 - bm25B + bm25B*float64(t.length)/st.avgLength
+		}
+
+		tf := float64(t.tf)
+		t.weight = t.idf * tf * (bm25K1 + 1) / (tf + bm25K1*t.norm) * t.match.weight
+
+		t.boost = 1
+		if boost, found := boosts[t.field]; found && boost > 0 {
+			t.boost = boost
+		}
+		t.weight *= t.boost
+		terms = append(terms, t)
+	}
+	return ids, terms
+}
+
+// sortHits orders hits by decreasing score. Hits with the same score are ordered by id
+func sortHits(hits []*pb.SearchHit) {
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].Id < hits[j].Id
+	})
 }
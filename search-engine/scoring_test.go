@@ -0,0 +1,64 @@
+package se
+
+import (
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func hitIDs(hits []*pb.SearchHit) []string {
+	var ids []string
+	for _, hit := range hits {
+		ids = append(ids, hit.Id)
+	}
+	return ids
+}
+
+func TestEngine_SearchRanking(t *testing.T) {
+	Convey("Text hits are ranked with BM25", t, func() {
+		engine := NewEngine(newTestSQLStore())
+
+		for _, m := range []*pb.TextMapping{
+			{ObjectId: "d1", Name: "body", Text: "juventus beat roma and juventus lead"},
+			{ObjectId: "d2", Name: "body", Text: "a long report about the derby where juventus played in turin on sunday"},
+			{ObjectId: "d3", Name: "body", Text: "roma won"},
+			{ObjectId: "d4", Name: "title", Text: "juventus"},
+		} {
+			So(engine.CreateTextMapping(m), ShouldBeNil)
+		}
+
+		q, err := ParseQuery(`$text = "juventus"`)
+		So(err, ShouldBeNil)
+
+		hits, err := engine.Search(q, SearchOptions{Boosts: map[string]float64{"title": 0.1}})
+		So(err, ShouldBeNil)
+		So(hitIDs(hits), ShouldResemble, []string{"d1", "d2", "d4"})
+		So(hits[0].Score, ShouldBeGreaterThan, hits[1].Score)
+		So(hits[2].Score, ShouldBeGreaterThan, 0)
+
+		Convey("Index boosts raise the score of the terms they indexed", func() {
+			hits, err := engine.Search(q, SearchOptions{Boosts: map[string]float64{"title": 10}})
+			So(err, ShouldBeNil)
+			So(hitIDs(hits), ShouldResemble, []string{"d4", "d1", "d2"})
+		})
+
+		Convey("Objects only selected by negations are returned with a zero score", func() {
+			q, err := ParseQuery(`$text startswith "juv" or not $text = "juventus"`)
+			So(err, ShouldBeNil)
+
+			hits, err := engine.Search(q, SearchOptions{})
+			So(err, ShouldBeNil)
+			So(hits, ShouldHaveLength, 4)
+			So(hits[3].Id, ShouldEqual, "d3")
+			So(hits[3].Score, ShouldEqual, 0)
+		})
+
+		Convey("Deleted objects are no longer found", func() {
+			So(engine.DeleteObjectMappings("d1"), ShouldBeNil)
+
+			hits, err := engine.Search(q, SearchOptions{})
+			So(err, ShouldBeNil)
+			So(hitIDs(hits), ShouldNotContain, "d1")
+		})
+	})
+}
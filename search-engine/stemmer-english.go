@@ -0,0 +1,306 @@
+package se
+
+import "strings"
+
+// English stemmer implementing the Snowball "Porter2" algorithm
+// (https://snowballstem.org/algorithms/english/stemmer.html)
+
+var englishExceptions = map[string]string{
+	"skies": "ski", "dying": "die", "lying": "lie", "tying": "tie",
+	"idly": "idl", "gently": "gentl", "ugly": "ugli", "early": "earli", "only": "onli", "singly": "singl",
+	"sky": "sky", "news": "news", "howe": "howe", "atlas": "atlas", "cosmos": "cosmos", "bias": "bias", "andes": "andes",
+}
+
+var englishInvariantsAfterStep1a = map[string]bool{
+	"inning": true, "outing": true, "canning": true, "herring": true, "earring": true,
+	"proceed": true, "exceed": true, "succeed": true,
+}
+
+type englishWord struct {
+	w  []rune
+	r1 int
+	r2 int
+}
+
+func isEnglishVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	}
+	return false
+}
+
+func (e *englishWord) String() string {
+	return string(e.w)
+}
+
+func (e *englishWord) hasSuffix(suffix string) bool {
+	return strings.HasSuffix(string(e.w), suffix)
+}
+
+// longestSuffix returns the longest of suffixes ending the word
+func (e *englishWord) longestSuffix(suffixes ...string) string {
+	longest := ""
+	s := string(e.w)
+	for _, suffix := range suffixes {
+		if len(suffix) > len(longest) && strings.HasSuffix(s, suffix) {
+			longest = suffix
+		}
+	}
+	return longest
+}
+
+func (e *englishWord) suffixStart(suffix string) int {
+	return len(e.w) - len([]rune(suffix))
+}
+
+func (e *englishWord) inR1(suffix string) bool {
+	return e.suffixStart(suffix) >= e.r1
+}
+
+func (e *englishWord) inR2(suffix string) bool {
+	return e.suffixStart(suffix) >= e.r2
+}
+
+func (e *englishWord) replace(suffix string, by string) {
+	e.w = append(e.w[:e.suffixStart(suffix)], []rune(by)...)
+}
+
+// containsVowel tells whether the word has a vowel before position end
+func (e *englishWord) containsVowel(end int) bool {
+	for _, r := range e.w[:end] {
+		if isEnglishVowel(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// endsWithShortSyllable tells whether the word ends with a short syllable
+func (e *englishWord) endsWithShortSyllable() bool {
+	n := len(e.w)
+	if n == 2 {
+		return isEnglishVowel(e.w[0]) && !isEnglishVowel(e.w[1])
+	}
+	if n >= 3 {
+		last := e.w[n-1]
+		return !isEnglishVowel(e.w[n-3]) && isEnglishVowel(e.w[n-2]) && !isEnglishVowel(last) && last != 'w' && last != 'x' && last != 'Y'
+	}
+	return false
+}
+
+func (e *englishWord) isShort() bool {
+	return e.r1 >= len(e.w) && e.endsWithShortSyllable()
+}
+
+// regionAfter returns the position following the first non-vowel that follows a vowel, starting at start
+func englishRegionAfter(w []rune, start int) int {
+	for i := start + 1; i < len(w); i++ {
+		if !isEnglishVowel(w[i]) && isEnglishVowel(w[i-1]) {
+			return i + 1
+		}
+	}
+	return len(w)
+}
+
+// StemEnglish returns the stem of an english word. The word is expected to be lower case
+func StemEnglish(word string) string {
+	if len([]rune(word)) <= 2 {
+		return word
+	}
+
+	if exception, found := englishExceptions[word]; found {
+		return exception
+	}
+
+	w := []rune(strings.TrimPrefix(word, "'"))
+	if len(w) == 0 {
+		return word
+	}
+
+	if w[0] == 'y' {
+		w[0] = 'Y'
+	}
+	for i := 1; i < len(w); i++ {
+		if w[i] == 'y' && isEnglishVowel(w[i-1]) {
+			w[i] = 'Y'
+		}
+	}
+
+	e := &englishWord{w: w}
+	s := string(w)
+	switch {
+	case strings.HasPrefix(s, "gener"), strings.HasPrefix(s, "arsen"):
+		e.r1 = 5
+	case strings.HasPrefix(s, "commun"):
+		e.r1 = 6
+	default:
+		e.r1 = englishRegionAfter(w, 0)
+	}
+	e.r2 = englishRegionAfter(w, e.r1)
+	if e.r1 == len(w) {
+		e.r2 = len(w)
+	}
+
+	e.step0()
+	e.step1a()
+	if englishInvariantsAfterStep1a[e.String()] {
+		return e.String()
+	}
+	e.step1b()
+	e.step1c()
+	e.step2()
+	e.step3()
+	e.step4()
+	e.step5()
+
+	return strings.Replace(e.String(), "Y", "y", -1)
+}
+
+func (e *englishWord) step0() {
+	if suffix := e.longestSuffix("'", "'s", "'s'"); suffix != "" {
+		e.replace(suffix, "")
+	}
+}
+
+func (e *englishWord) step1a() {
+	switch suffix := e.longestSuffix("sses", "ied", "ies", "s", "us", "ss"); suffix {
+	case "sses":
+		e.replace(suffix, "ss")
+
+	case "ied", "ies":
+		if e.suffixStart(suffix) > 1 {
+			e.replace(suffix, "i")
+		} else {
+			e.replace(suffix, "ie")
+		}
+
+	case "s":
+		if e.containsVowel(len(e.w) - 2) {
+			e.replace(suffix, "")
+		}
+	}
+}
+
+func (e *englishWord) step1b() {
+	switch suffix := e.longestSuffix("eed", "eedly", "ed", "edly", "ing", "ingly"); suffix {
+	case "eed", "eedly":
+		if e.inR1(suffix) {
+			e.replace(suffix, "ee")
+		}
+
+	case "ed", "edly", "ing", "ingly":
+		if !e.containsVowel(e.suffixStart(suffix)) {
+			return
+		}
+		e.replace(suffix, "")
+
+		switch {
+		case e.hasSuffix("at"), e.hasSuffix("bl"), e.hasSuffix("iz"):
+			e.w = append(e.w, 'e')
+		case e.longestSuffix("bb", "dd", "ff", "gg", "mm", "nn", "pp", "rr", "tt") != "":
+			e.w = e.w[:len(e.w)-1]
+		case e.isShort():
+			e.w = append(e.w, 'e')
+		}
+	}
+}
+
+func (e *englishWord) step1c() {
+	n := len(e.w)
+	if n > 2 && (e.w[n-1] == 'y' || e.w[n-1] == 'Y') && !isEnglishVowel(e.w[n-2]) {
+		e.w[n-1] = 'i'
+	}
+}
+
+var englishStep2Suffixes = map[string]string{
+	"tional": "tion", "enci": "ence", "anci": "ance", "abli": "able", "entli": "ent",
+	"izer": "ize", "ization": "ize", "ational": "ate", "ation": "ate", "ator": "ate",
+	"alism": "al", "aliti": "al", "alli": "al", "fulness": "ful", "ousli": "ous", "ousness": "ous",
+	"iveness": "ive", "iviti": "ive", "biliti": "ble", "bli": "ble", "ogi": "og", "fulli": "ful", "lessli": "less", "li": "",
+}
+
+func (e *englishWord) step2() {
+	var suffixes []string
+	for suffix := range englishStep2Suffixes {
+		suffixes = append(suffixes, suffix)
+	}
+
+	suffix := e.longestSuffix(suffixes...)
+	if suffix == "" || !e.inR1(suffix) {
+		return
+	}
+
+	start := e.suffixStart(suffix)
+	switch suffix {
+	case "ogi":
+		if start > 0 && e.w[start-1] == 'l' {
+			e.replace(suffix, "og")
+		}
+	case "li":
+		if start > 0 && strings.ContainsRune("cdeghkmnrt", e.w[start-1]) {
+			e.replace(suffix, "")
+		}
+	default:
+		e.replace(suffix, englishStep2Suffixes[suffix])
+	}
+}
+
+var englishStep3Suffixes = map[string]string{
+	"tional": "tion", "ational": "ate", "alize": "al", "icate": "ic", "iciti": "ic", "ical": "ic",
+	"ful": "", "ness": "", "ative": "",
+}
+
+func (e *englishWord) step3() {
+	var suffixes []string
+	for suffix := range englishStep3Suffixes {
+		suffixes = append(suffixes, suffix)
+	}
+
+	suffix := e.longestSuffix(suffixes...)
+	if suffix == "" || !e.inR1(suffix) {
+		return
+	}
+
+	if suffix == "ative" && !e.inR2(suffix) {
+		return
+	}
+	e.replace(suffix, englishStep3Suffixes[suffix])
+}
+
+func (e *englishWord) step4() {
+	suffix := e.longestSuffix("al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement", "ment", "ent", "ism", "ate", "iti", "ous", "ive", "ize", "ion")
+	if suffix == "" || !e.inR2(suffix) {
+		return
+	}
+
+	if suffix == "ion" {
+		start := e.suffixStart(suffix)
+		if start == 0 || (e.w[start-1] != 's' && e.w[start-1] != 't') {
+			return
+		}
+	}
+	e.replace(suffix, "")
+}
+
+func (e *englishWord) step5() {
+	n := len(e.w)
+	switch e.w[n-1] {
+	case 'e':
+		if e.inR2("e") {
+			e.replace("e", "")
+		} else if e.inR1("e") {
+			e.w = e.w[:n-1]
+			short := e.endsWithShortSyllable()
+			e.w = append(e.w, 'e')
+			if !short {
+				e.w = e.w[:n-1]
+			}
+		}
+
+	case 'l':
+		if e.inR2("l") && n > 1 && e.w[n-2] == 'l' {
+			e.w = e.w[:n-1]
+		}
+	}
+}
@@ -0,0 +1,400 @@
+package se
+
+import "strings"
+
+// French stemmer implementing the Snowball french algorithm
+// (https://snowballstem.org/algorithms/french/stemmer.html)
+
+func isFrenchVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'â', 'à', 'ë', 'é', 'ê', 'è', 'ï', 'î', 'ô', 'û', 'ù':
+		return true
+	}
+	return false
+}
+
+type frenchWord struct {
+	w  []rune
+	rv int
+	r1 int
+	r2 int
+}
+
+func (f *frenchWord) String() string {
+	return string(f.w)
+}
+
+func (f *frenchWord) longestSuffix(suffixes ...string) string {
+	longest := ""
+	s := string(f.w)
+	for _, suffix := range suffixes {
+		if len(suffix) > len(longest) && strings.HasSuffix(s, suffix) {
+			longest = suffix
+		}
+	}
+	return longest
+}
+
+func (f *frenchWord) hasSuffix(suffix string) bool {
+	return strings.HasSuffix(string(f.w), suffix)
+}
+
+func (f *frenchWord) suffixStart(suffix string) int {
+	return len(f.w) - len([]rune(suffix))
+}
+
+func (f *frenchWord) in(region int, suffix string) bool {
+	return f.suffixStart(suffix) >= region
+}
+
+func (f *frenchWord) replace(suffix string, by string) {
+	f.w = append(f.w[:f.suffixStart(suffix)], []rune(by)...)
+}
+
+// precededBy tells whether the suffix is preceded by one of the given strings located in region
+func (f *frenchWord) precededBy(suffix string, region int, preceding string) bool {
+	start := f.suffixStart(suffix)
+	p := []rune(preceding)
+	if start-len(p) < region {
+		return false
+	}
+	return string(f.w[start-len(p):start]) == preceding
+}
+
+func frenchRegionAfter(w []rune, start int) int {
+	for i := start + 1; i < len(w); i++ {
+		if !isFrenchVowel(w[i]) && isFrenchVowel(w[i-1]) {
+			return i + 1
+		}
+	}
+	return len(w)
+}
+
+// StemFrench returns the stem of a french word. The word is expected to be lower case
+func StemFrench(word string) string {
+	w := []rune(word)
+	if len(w) <= 2 {
+		return word
+	}
+
+	// mark vowels that must be treated as consonants
+	for i, r := range w {
+		prevVowel := i > 0 && isFrenchVowel(w[i-1])
+		nextVowel := i+1 < len(w) && isFrenchVowel(w[i+1])
+		switch {
+		case (r == 'u' || r == 'i') && prevVowel && nextVowel:
+			w[i] = r - 'a' + 'A'
+		case r == 'y' && (prevVowel || nextVowel):
+			w[i] = 'Y'
+		case r == 'u' && i > 0 && w[i-1] == 'q':
+			w[i] = 'U'
+		}
+	}
+
+	f := &frenchWord{w: w}
+	s := string(w)
+	switch {
+	case strings.HasPrefix(s, "par"), strings.HasPrefix(s, "col"), strings.HasPrefix(s, "tap"):
+		f.rv = 3
+	case len(w) >= 2 && isFrenchVowel(w[0]) && isFrenchVowel(w[1]):
+		f.rv = 3
+	default:
+		f.rv = len(w)
+		for i := 1; i < len(w); i++ {
+			if isFrenchVowel(w[i]) {
+				f.rv = i + 1
+				break
+			}
+		}
+	}
+	if f.rv > len(w) {
+		f.rv = len(w)
+	}
+	f.r1 = frenchRegionAfter(w, 0)
+	f.r2 = frenchRegionAfter(w, f.r1)
+	if f.r1 == len(w) {
+		f.r2 = len(w)
+	}
+
+	original := f.String()
+	step1, continueWithVerbs := f.step1()
+	changed := step1
+	if !step1 || continueWithVerbs {
+		before := f.String()
+		if !f.step2a() {
+			f.step2b()
+		}
+		changed = changed || f.String() != before
+	}
+
+	if changed {
+		n := len(f.w)
+		if f.w[n-1] == 'Y' {
+			f.w[n-1] = 'i'
+		} else if f.w[n-1] == 'ç' {
+			f.w[n-1] = 'c'
+		}
+	} else if f.String() == original {
+		f.step4()
+	}
+
+	f.step5()
+	f.step6()
+
+	return strings.NewReplacer("I", "i", "U", "u", "Y", "y").Replace(f.String())
+}
+
+// step1 removes standard suffixes. It reports whether a suffix was removed and whether the verb suffixes steps
+// must still be run
+func (f *frenchWord) step1() (bool, bool) {
+	suffix := f.longestSuffix(
+		"ance", "iqUe", "isme", "able", "iste", "eux", "ances", "iqUes", "ismes", "ables", "istes",
+		"atrice", "ateur", "ation", "atrices", "ateurs", "ations",
+		"logie", "logies", "usion", "ution", "usions", "utions", "ence", "ences",
+		"ement", "ements", "ité", "ités", "if", "ive", "ifs", "ives", "eaux", "aux", "euse", "euses",
+		"issement", "issements", "amment", "emment", "ment", "ments",
+	)
+
+	switch suffix {
+	case "ance", "iqUe", "isme", "able", "iste", "eux", "ances", "iqUes", "ismes", "ables", "istes":
+		if f.in(f.r2, suffix) {
+			f.replace(suffix, "")
+			return true, false
+		}
+
+	case "atrice", "ateur", "ation", "atrices", "ateurs", "ations":
+		if f.in(f.r2, suffix) {
+			f.replace(suffix, "")
+			if f.hasSuffix("ic") {
+				if f.in(f.r2, "ic") {
+					f.replace("ic", "")
+				} else {
+					f.replace("ic", "iqU")
+				}
+			}
+			return true, false
+		}
+
+	case "logie", "logies":
+		if f.in(f.r2, suffix) {
+			f.replace(suffix, "log")
+			return true, false
+		}
+
+	case "usion", "ution", "usions", "utions":
+		if f.in(f.r2, suffix) {
+			f.replace(suffix, "u")
+			return true, false
+		}
+
+	case "ence", "ences":
+		if f.in(f.r2, suffix) {
+			f.replace(suffix, "ent")
+			return true, false
+		}
+
+	case "ement", "ements":
+		if f.in(f.rv, suffix) {
+			f.replace(suffix, "")
+			switch {
+			case f.hasSuffix("iv") && f.in(f.r2, "iv"):
+				f.replace("iv", "")
+				if f.hasSuffix("at") && f.in(f.r2, "at") {
+					f.replace("at", "")
+				}
+			case f.hasSuffix("eus"):
+				if f.in(f.r2, "eus") {
+					f.replace("eus", "")
+				} else if f.in(f.r1, "eus") {
+					f.replace("eus", "eux")
+				}
+			case f.hasSuffix("abl") && f.in(f.r2, "abl"):
+				f.replace("abl", "")
+			case f.hasSuffix("iqU") && f.in(f.r2, "iqU"):
+				f.replace("iqU", "")
+			case f.hasSuffix("ièr") && f.in(f.rv, "ièr"):
+				f.replace("ièr", "i")
+			case f.hasSuffix("Ièr") && f.in(f.rv, "Ièr"):
+				f.replace("Ièr", "i")
+			}
+			return true, false
+		}
+
+	case "ité", "ités":
+		if f.in(f.r2, suffix) {
+			f.replace(suffix, "")
+			switch {
+			case f.hasSuffix("abil"):
+				if f.in(f.r2, "abil") {
+					f.replace("abil", "")
+				} else {
+					f.replace("abil", "abl")
+				}
+			case f.hasSuffix("ic"):
+				if f.in(f.r2, "ic") {
+					f.replace("ic", "")
+				} else {
+					f.replace("ic", "iqU")
+				}
+			case f.hasSuffix("iv") && f.in(f.r2, "iv"):
+				f.replace("iv", "")
+			}
+			return true, false
+		}
+
+	case "if", "ive", "ifs", "ives":
+		if f.in(f.r2, suffix) {
+			f.replace(suffix, "")
+			if f.hasSuffix("at") && f.in(f.r2, "at") {
+				f.replace("at", "")
+				if f.hasSuffix("ic") {
+					if f.in(f.r2, "ic") {
+						f.replace("ic", "")
+					} else {
+						f.replace("ic", "iqU")
+					}
+				}
+			}
+			return true, false
+		}
+
+	case "eaux":
+		f.replace(suffix, "eau")
+		return true, false
+
+	case "aux":
+		if f.in(f.r1, suffix) {
+			f.replace(suffix, "al")
+			return true, false
+		}
+
+	case "euse", "euses":
+		if f.in(f.r2, suffix) {
+			f.replace(suffix, "")
+			return true, false
+		}
+		if f.in(f.r1, suffix) {
+			f.replace(suffix, "eux")
+			return true, false
+		}
+
+	case "issement", "issements":
+		start := f.suffixStart(suffix)
+		if f.in(f.r1, suffix) && start > 0 && !isFrenchVowel(f.w[start-1]) {
+			f.replace(suffix, "")
+			return true, false
+		}
+
+	case "amment":
+		if f.in(f.rv, suffix) {
+			f.replace(suffix, "ant")
+			return true, true
+		}
+
+	case "emment":
+		if f.in(f.rv, suffix) {
+			f.replace(suffix, "ent")
+			return true, true
+		}
+
+	case "ment", "ments":
+		start := f.suffixStart(suffix)
+		if f.in(f.rv, suffix) && start > 0 && start-1 >= f.rv && isFrenchVowel(f.w[start-1]) {
+			f.replace(suffix, "")
+			return true, true
+		}
+	}
+	return false, false
+}
+
+// step2a removes verb suffixes beginning with i
+func (f *frenchWord) step2a() bool {
+	suffix := f.longestSuffix(
+		"îmes", "ît", "îtes", "i", "ie", "ies", "ir", "ira", "irai", "iraIent", "irais", "irait", "iras", "irent",
+		"irez", "iriez", "irions", "irons", "iront", "is", "issaIent", "issais", "issait", "issant", "issante",
+		"issantes", "issants", "isse", "issent", "isses", "issez", "issiez", "issions", "issons", "it",
+	)
+	if suffix == "" || !f.in(f.rv, suffix) {
+		return false
+	}
+
+	start := f.suffixStart(suffix)
+	if start-1 < f.rv || isFrenchVowel(f.w[start-1]) {
+		return false
+	}
+	f.replace(suffix, "")
+	return true
+}
+
+// step2b removes other verb suffixes
+func (f *frenchWord) step2b() {
+	suffix := f.longestSuffix(
+		"ions",
+		"é", "ée", "ées", "és", "èrent", "er", "era", "erai", "eraIent", "erais", "erait", "eras", "erez", "eriez",
+		"erions", "erons", "eront", "ez", "iez",
+		"âmes", "ât", "âtes", "a", "ai", "aIent", "ais", "ait", "ant", "ante", "antes", "ants", "as", "asse",
+		"assent", "asses", "assiez", "assions",
+	)
+	if suffix == "" || !f.in(f.rv, suffix) {
+		return
+	}
+
+	switch suffix {
+	case "ions":
+		if f.in(f.r2, suffix) {
+			f.replace(suffix, "")
+		}
+
+	case "âmes", "ât", "âtes", "a", "ai", "aIent", "ais", "ait", "ant", "ante", "antes", "ants", "as", "asse",
+		"assent", "asses", "assiez", "assions":
+		f.replace(suffix, "")
+		if f.hasSuffix("e") && f.in(f.rv, "e") {
+			f.replace("e", "")
+		}
+
+	default:
+		f.replace(suffix, "")
+	}
+}
+
+// step4 removes residual suffixes
+func (f *frenchWord) step4() {
+	n := len(f.w)
+	if n > 1 && f.w[n-1] == 's' && !strings.ContainsRune("aiouès", f.w[n-2]) {
+		f.w = f.w[:n-1]
+	}
+
+	suffix := f.longestSuffix("ion", "ier", "ière", "Ier", "Ière", "e")
+	if suffix == "" || !f.in(f.rv, suffix) {
+		return
+	}
+
+	switch suffix {
+	case "ion":
+		if f.in(f.r2, suffix) && (f.precededBy(suffix, f.rv, "s") || f.precededBy(suffix, f.rv, "t")) {
+			f.replace(suffix, "")
+		}
+	case "ier", "ière", "Ier", "Ière":
+		f.replace(suffix, "i")
+	case "e":
+		f.replace(suffix, "")
+	}
+}
+
+// step5 undoubles final consonants
+func (f *frenchWord) step5() {
+	if f.longestSuffix("enn", "onn", "ett", "ell", "eill") != "" {
+		f.w = f.w[:len(f.w)-1]
+	}
+}
+
+// step6 removes the accent of é or è followed by final non-vowels
+func (f *frenchWord) step6() {
+	i := len(f.w) - 1
+	for i >= 0 && !isFrenchVowel(f.w[i]) {
+		i--
+	}
+	if i >= 0 && i < len(f.w)-1 && (f.w[i] == 'é' || f.w[i] == 'è') {
+		f.w[i] = 'e'
+	}
+}
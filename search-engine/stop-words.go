@@ -1,6 +1,6 @@
 package se
 
-var stopWords = []string{
+var englishStopWords = []string{
 	"a", "about", "above", "above", "across", "after", "afterwards", "again", "against", "all", "almost", "alone",
 	"along", "already", "also", "although", "always", "am", "among", "amongst", "amoungst", "amount", "an", "and",
 	"another", "any", "anyhow", "anyone", "anything", "anyway", "anywhere", "are", "around", "as", "at", "back", "be",
@@ -27,6 +27,9 @@ var stopWords = []string{
 	"where", "whereafter", "whereas", "whereby", "wherein", "whereupon", "wherever", "whether", "which", "while",
 	"whither", "who", "whoever", "whole", "whom", "whose", "why", "will", "with", "within", "without", "would", "yet",
 	"you", "your", "yours", "yourself", "yourselves", "the",
+}
+
+var frenchStopWords = []string{
 	"a", "abord", "absolument", "afin", "ah", "ai", "aie", "aient", "aies", "ailleurs", "ainsi", "ait", "allaient", "allo", "allons",
 	"allô", "alors", "anterieur", "anterieure", "anterieures", "apres", "après", "as", "assez", "attendu", "au", "aucun", "aucune",
 	"aucuns", "aujourd", "aujourd'hui", "aupres", "auquel", "aura", "aurai", "auraient", "aurais", "aurait", "auras", "aurez",
@@ -0,0 +1,1216 @@
+package se
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"sort"
+	"strings"
+)
+
+// rowKey identifies a posting: the occurrences of a term in a field of a segment document
+type rowKey struct {
+	seg, doc, term, field int
+}
+
+// rowSet maps the postings that satisfy a text condition to their term frequency
+type rowSet map[rowKey]int64
+
+// docKey identifies a segment document
+type docKey struct {
+	seg, doc int
+}
+
+// diskQuery evaluates search queries over the segments of a disk store the same way the SQL index store evaluates
+// them over its tables: text conditions select postings, number conditions numbers and field conditions properties
+type diskQuery struct {
+	queryLimits
+	segments []*segment
+	fields   map[string]bool
+	groups   []*analyzerGroup
+	matchers []tokenMatcher
+	all      rowSet
+}
+
+func newDiskQuery(segments []*segment, query *pb.SearchQuery, opts SearchOptions) (*diskQuery, error) {
+	q := &diskQuery{
+		queryLimits: newQueryLimits(opts),
+		segments:    segments,
+		fields:      map[string]bool{},
+	}
+	for _, field := range opts.Fields {
+		q.fields[field] = true
+	}
+
+	if _, ok := query.GetQuery().(*pb.SearchQuery_Text); ok {
+		groups, err := analyzerGroups(opts.Analyzers, opts.Synonyms)
+		if err != nil {
+			return nil, err
+		}
+		q.groups = groups
+	}
+	return q, nil
+}
+
+func (s *DiskStore) Search(query *pb.SearchQuery, opts SearchOptions) (Cursor, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	segments, stats, err := s.view()
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := newDiskQuery(segments, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if text, ok := query.GetQuery().(*pb.SearchQuery_Text); ok {
+		rows, err := q.text(text.Text, 1)
+		if err != nil {
+			return nil, err
+		}
+		return &hitListCursor{hits: bm25(q.postings(rows), q.matchers, stats, opts.Boosts)}, nil
+	}
+
+	docs, err := q.documents(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var hits []*pb.SearchHit
+	for key := range docs {
+		hits = append(hits, &pb.SearchHit{Id: segments[key.seg].docs[key.doc].id})
+	}
+	return &hitListCursor{hits: hits}, nil
+}
+
+// Explain evaluates query like Search. Disk stores evaluate queries over their segments without compiling them
+func (s *DiskStore) Explain(query *pb.SearchQuery, opts SearchOptions) (*pb.SearchExplanation, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	segments, stats, err := s.view()
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := newDiskQuery(segments, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := &pb.SearchExplanation{}
+	if text, ok := query.GetQuery().(*pb.SearchQuery_Text); ok {
+		rows, err := q.text(text.Text, 1)
+		if err != nil {
+			return nil, err
+		}
+		explanation.Hits, explanation.HitsCount = explainTerms(bm25Terms(q.postings(rows), q.matchers, stats, opts.Boosts))
+		return explanation, nil
+	}
+
+	docs, err := q.documents(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for key := range docs {
+		ids = append(ids, segments[key.seg].docs[key.doc].id)
+	}
+	explanation.Hits, explanation.HitsCount = explainIDs(ids)
+	return explanation, nil
+}
+
+func (s *DiskStore) Facets(query *pb.SearchQuery, opts SearchOptions, facets []*pb.Facet) ([]*pb.FacetResult, error) {
+	err := validateFacets(facets, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	segments, _, err := s.view()
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := newDiskQuery(segments, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	docs, err := q.documents(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*pb.FacetResult
+	for _, facet := range facets {
+		values := q.facetValues(docs, facet.Field)
+
+		result := &pb.FacetResult{Name: facetName(facet)}
+		if len(facet.Ranges) > 0 {
+			result.Buckets = rangeBuckets(values, facet.Ranges)
+		} else {
+			result.Buckets = termBuckets(values, int(facet.Size))
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// documents returns the documents matching query
+func (q *diskQuery) documents(query *pb.SearchQuery) (map[docKey]bool, error) {
+	docs := map[docKey]bool{}
+
+	switch v := query.GetQuery().(type) {
+	case *pb.SearchQuery_Text:
+		rows, err := q.text(v.Text, 1)
+		if err != nil {
+			return nil, err
+		}
+		for row := range rows {
+			docs[docKey{row.seg, row.doc}] = true
+		}
+
+	case *pb.SearchQuery_Number:
+		match, err := q.number(v.Number, 1)
+		if err != nil {
+			return nil, err
+		}
+		q.eachDoc(func(key docKey, doc *segmentDoc) {
+			for _, num := range doc.numbers {
+				if match(num) {
+					docs[key] = true
+					return
+				}
+			}
+		})
+
+	case *pb.SearchQuery_Geo:
+		match, err := q.geo(v.Geo, 1)
+		if err != nil {
+			return nil, err
+		}
+		q.eachDoc(func(key docKey, doc *segmentDoc) {
+			for _, p := range doc.points {
+				if match(p) {
+					docs[key] = true
+					return
+				}
+			}
+		})
+
+	case *pb.SearchQuery_Fields:
+		match, err := q.properties(v.Fields, 1)
+		if err != nil {
+			return nil, err
+		}
+		for seg := range q.segments {
+			for doc, props := range q.segments[seg].decodedProps() {
+				if props != nil && q.segments[seg].live(doc) && match(props) {
+					docs[docKey{seg, doc}] = true
+				}
+			}
+		}
+
+	default:
+		return nil, errors.BadRequest("empty search query")
+	}
+	return docs, nil
+}
+
+// eachDoc calls visit with every live document
+func (q *diskQuery) eachDoc(visit func(key docKey, doc *segmentDoc)) {
+	for seg, s := range q.segments {
+		for doc, d := range s.docs {
+			if s.live(doc) {
+				visit(docKey{seg, doc}, d)
+			}
+		}
+	}
+}
+
+// postings returns the postings of rows with the length of their field
+func (q *diskQuery) postings(rows rowSet) []*posting {
+	postings := make([]*posting, 0, len(rows))
+	for row, tf := range rows {
+		s := q.segments[row.seg]
+		doc := s.docs[row.doc]
+		postings = append(postings, &posting{
+			token:  s.terms[row.term],
+			id:     doc.id,
+			field:  s.fields[row.field],
+			tf:     tf,
+			length: doc.length(row.field),
+		})
+	}
+	sort.Slice(postings, func(i, j int) bool {
+		if postings[i].id != postings[j].id {
+			return postings[i].id < postings[j].id
+		}
+		if postings[i].field != postings[j].field {
+			return postings[i].field < postings[j].field
+		}
+		return postings[i].token < postings[j].token
+	})
+	return postings
+}
+
+func (q *diskQuery) text(query *pb.StrQuery, depth int) (rowSet, error) {
+	switch v := query.GetBool().(type) {
+	case *pb.StrQuery_Or:
+		if err := q.enterGroup(depth, len(v.Or.Queries)); err != nil {
+			return nil, err
+		}
+
+		rows := rowSet{}
+		for _, sub := range v.Or.Queries {
+			subRows, err := q.text(sub, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			for row, tf := range subRows {
+				rows[row] = tf
+			}
+		}
+		return rows, nil
+
+	case *pb.StrQuery_Not:
+		if err := q.enterGroup(depth, 1); err != nil {
+			return nil, err
+		}
+
+		matchersCount := len(q.matchers)
+		excluded, err := q.text(v.Not.Expressions, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		q.matchers = q.matchers[:matchersCount]
+
+		excludedDocs := map[docKey]bool{}
+		for row := range excluded {
+			excludedDocs[docKey{row.seg, row.doc}] = true
+		}
+
+		rows := rowSet{}
+		for row, tf := range q.allRows() {
+			if !excludedDocs[docKey{row.seg, row.doc}] {
+				rows[row] = tf
+			}
+		}
+		return rows, nil
+
+	case *pb.StrQuery_Contains:
+		return q.termCondition(v.Contains.Value, func(term string) (*termLookup, tokenMatcher, error) {
+			return &termLookup{match: func(token string) bool { return strings.Contains(token, term) }}, containsMatcher(term), nil
+		})
+
+	case *pb.StrQuery_StartsWith:
+		return q.termCondition(v.StartsWith.Value, func(term string) (*termLookup, tokenMatcher, error) {
+			return &termLookup{prefix: term}, startsWithMatcher(term), nil
+		})
+
+	case *pb.StrQuery_EndsWith:
+		return q.termCondition(v.EndsWith.Value, func(term string) (*termLookup, tokenMatcher, error) {
+			return &termLookup{match: func(token string) bool { return strings.HasSuffix(token, term) }}, endsWithMatcher(term), nil
+		})
+
+	case *pb.StrQuery_Eq:
+		return q.termCondition(v.Eq.Value, func(term string) (*termLookup, tokenMatcher, error) {
+			return &termLookup{tokens: []string{term}}, equalsMatcher(term), nil
+		})
+
+	case *pb.StrQuery_Phrase:
+		return q.analyzed(v.Phrase.Value, func(terms []string, _ synonymDict) (rowSet, []tokenMatcher, error) {
+			return q.sequence(terms, true, 0)
+		})
+
+	case *pb.StrQuery_Near:
+		if v.Near.Distance > MaxNearDistance {
+			return nil, errors.BadRequest("proximity distance is too high", errors.Details{Key: "max-distance", Value: MaxNearDistance})
+		}
+		return q.analyzed(v.Near.Value, func(terms []string, _ synonymDict) (rowSet, []tokenMatcher, error) {
+			return q.sequence(terms, false, int(v.Near.Distance))
+		})
+
+	case *pb.StrQuery_Fuzzy:
+		if v.Fuzzy.MaxDistance > MaxFuzzyDistance {
+			return nil, errors.BadRequest("fuzzy condition distance is too high", errors.Details{Key: "max-distance", Value: MaxFuzzyDistance})
+		}
+		return q.termCondition(v.Fuzzy.Value, func(term string) (*termLookup, tokenMatcher, error) {
+			return q.fuzzyLookup(term, int(v.Fuzzy.MaxDistance))
+		})
+	}
+
+	return nil, errors.BadRequest("unsupported text condition")
+}
+
+// termLookup selects terms of the dictionaries: the listed tokens, the terms starting with prefix or the terms
+// accepted by match, from the fastest lookup to the slowest
+type termLookup struct {
+	tokens []string
+	prefix string
+	match  func(token string) bool
+}
+
+// rows returns the postings of the terms l selects
+func (q *diskQuery) rows(l *termLookup) (rowSet, error) {
+	rows := rowSet{}
+	for seg, s := range q.segments {
+		var terms []int
+		switch {
+		case l.tokens != nil:
+			for _, token := range l.tokens {
+				if term, found := s.term(token); found {
+					terms = append(terms, term)
+				}
+			}
+
+		case l.match != nil:
+			for term, token := range s.terms {
+				if l.match(token) {
+					terms = append(terms, term)
+				}
+			}
+
+		default:
+			start, end := s.prefixRange(l.prefix)
+			for term := start; term < end; term++ {
+				terms = append(terms, term)
+			}
+		}
+
+		for _, term := range terms {
+			err := s.postingsOf(term, func(doc, field int, tf int64, _ []int) {
+				rows[rowKey{seg: seg, doc: doc, term: term, field: field}] = tf
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return rows, nil
+}
+
+// allRows returns every posting. Negations select the postings of all the documents they do not exclude
+func (q *diskQuery) allRows() rowSet {
+	if q.all == nil {
+		q.all, _ = q.rows(&termLookup{})
+	}
+	return q.all
+}
+
+// termCondition evaluates a condition on the distinct terms the analyzers extract from value and on their synonyms.
+// A posting matches when its term satisfies the condition of one of the terms. Synonyms of several words match as
+// phrases. A nil lookup means that no term can match
+func (q *diskQuery) termCondition(value string, condition func(term string) (*termLookup, tokenMatcher, error)) (rowSet, error) {
+	return q.analyzed(value, func(analyzed []string, synonyms synonymDict) (rowSet, []tokenMatcher, error) {
+		terms, phrases := synonyms.expand(analyzed)
+
+		var (
+			rows     rowSet
+			matchers []tokenMatcher
+		)
+		for _, term := range terms {
+			lookup, matcher, err := condition(term)
+			if err != nil {
+				return nil, nil, err
+			}
+			if lookup == nil {
+				continue
+			}
+
+			if err := q.count(); err != nil {
+				return nil, nil, err
+			}
+
+			termRows, err := q.rows(lookup)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if rows == nil {
+				rows = rowSet{}
+			}
+			for row, tf := range termRows {
+				rows[row] = tf
+			}
+			matchers = append(matchers, matcher)
+		}
+
+		for _, phrase := range phrases {
+			phraseRows, phraseMatchers, err := q.sequence(phrase, true, 0)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if rows == nil {
+				rows = rowSet{}
+			}
+			for row, tf := range phraseRows {
+				rows[row] = tf
+			}
+			matchers = append(matchers, phraseMatchers...)
+		}
+		return rows, matchers, nil
+	})
+}
+
+// analyzed evaluates, for each analyzer group, the condition build returns for the terms the group analyzer
+// extracts from value, restricted to the fields of the group. Nil rows mean that the condition is empty
+func (q *diskQuery) analyzed(value string, build func(terms []string, synonyms synonymDict) (rowSet, []tokenMatcher, error)) (rowSet, error) {
+	rows := rowSet{}
+
+	for _, group := range q.groups {
+		terms := group.analyzer.Analyze(value)
+		if len(terms) == 0 {
+			continue
+		}
+
+		groupRows, matchers, err := build(terms, group.synonyms)
+		if err != nil {
+			return nil, err
+		}
+		if groupRows == nil {
+			continue
+		}
+
+		fields := map[string]bool{}
+		for _, field := range group.fields {
+			fields[field] = true
+		}
+
+		for row, tf := range groupRows {
+			if len(fields) == 0 || fields[q.segments[row.seg].fields[row.field]] != group.exclude {
+				rows[row] = tf
+			}
+		}
+		q.matchers = append(q.matchers, fieldsMatcher(fields, group.exclude, matchers))
+	}
+	return rows, nil
+}
+
+// sequence selects the postings of terms in the texts where all terms occur, either as a phrase when ordered is
+// set, or with at most distance other words between the first and the last of them otherwise
+func (q *diskQuery) sequence(terms []string, ordered bool, distance int) (rowSet, []tokenMatcher, error) {
+	if len(terms) > MaxSequenceTerms {
+		return nil, nil, errors.BadRequest("phrase has too many words", errors.Details{Key: "max-words", Value: MaxSequenceTerms})
+	}
+	if err := q.count(); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		unique   []string
+		indexes  = map[string]int{}
+		matchers []tokenMatcher
+	)
+	for _, term := range terms {
+		if _, found := indexes[term]; !found {
+			indexes[term] = len(unique)
+			unique = append(unique, term)
+			matchers = append(matchers, equalsMatcher(term))
+		}
+	}
+
+	type docField struct{ doc, field int }
+
+	rows := rowSet{}
+	for seg, s := range q.segments {
+		termNumbers := make([]int, len(unique))
+		occurrences := make([]map[docField][]int, len(unique))
+		tfs := make([]map[docField]int64, len(unique))
+
+		found := true
+		for ind, term := range unique {
+			termNumber, ok := s.term(term)
+			if !ok {
+				found = false
+				break
+			}
+			termNumbers[ind] = termNumber
+			occurrences[ind] = map[docField][]int{}
+			tfs[ind] = map[docField]int64{}
+
+			err := s.postingsOf(termNumber, func(doc, field int, tf int64, positions []int) {
+				key := docField{doc, field}
+				occurrences[ind][key] = append([]int{}, positions...)
+				tfs[ind][key] = tf
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		if !found {
+			continue
+		}
+
+		for key := range occurrences[0] {
+			lists := make([][]int, len(terms))
+			complete := true
+			for i, term := range terms {
+				lists[i] = occurrences[indexes[term]][key]
+				if len(lists[i]) == 0 {
+					complete = false
+					break
+				}
+			}
+
+			if complete && matchSequence(lists, ordered, distance+len(terms)-1) {
+				for ind := range unique {
+					rows[rowKey{seg: seg, doc: key.doc, term: termNumbers[ind], field: key.field}] = tfs[ind][key]
+				}
+			}
+		}
+	}
+	return rows, matchers, nil
+}
+
+// matchSequence tells whether distinct positions can be picked in lists, one per list, that follow each other when
+// ordered is set, or that span at most span positions otherwise
+func matchSequence(lists [][]int, ordered bool, span int) bool {
+	if ordered {
+		sets := make([]map[int]bool, len(lists))
+		for i, list := range lists {
+			sets[i] = map[int]bool{}
+			for _, pos := range list {
+				sets[i][pos] = true
+			}
+		}
+
+		for _, start := range lists[0] {
+			matched := true
+			for i := 1; i < len(lists) && matched; i++ {
+				matched = sets[i][start+i]
+			}
+			if matched {
+				return true
+			}
+		}
+		return false
+	}
+
+	used := map[int]bool{}
+	var pick func(i, min, max int) bool
+	pick = func(i, min, max int) bool {
+		if i == len(lists) {
+			return true
+		}
+		for _, pos := range lists[i] {
+			if used[pos] {
+				continue
+			}
+
+			lo, hi := min, max
+			if i == 0 || pos < lo {
+				lo = pos
+			}
+			if i == 0 || pos > hi {
+				hi = pos
+			}
+			if hi-lo > span {
+				continue
+			}
+
+			used[pos] = true
+			if pick(i+1, lo, hi) {
+				return true
+			}
+			delete(used, pos)
+		}
+		return false
+	}
+	return pick(0, 0, 0)
+}
+
+// fuzzyLookup selects the terms of the dictionaries that are at most maxDistance edits away from term. A zero
+// maxDistance is chosen from the length of term. Beyond MaxFuzzyExpansions terms, the closest then most frequent
+// ones are kept, each of them counts as a condition
+func (q *diskQuery) fuzzyLookup(term string, maxDistance int) (*termLookup, tokenMatcher, error) {
+	if maxDistance == 0 {
+		maxDistance = autoFuzzyDistance(term)
+	}
+
+	n := len([]rune(term))
+	variants := map[string]int{}
+	for _, s := range q.segments {
+		for _, token := range s.terms {
+			if _, found := variants[token]; found {
+				continue
+			}
+
+			length := len([]rune(token))
+			if length < n-maxDistance || length > n+maxDistance {
+				continue
+			}
+			if distance := editDistance(term, token); distance <= maxDistance {
+				variants[token] = distance
+			}
+		}
+	}
+
+	if len(variants) == 0 {
+		return nil, nil, nil
+	}
+
+	tokens := make([]string, 0, len(variants))
+	for token := range variants {
+		tokens = append(tokens, token)
+	}
+
+	if len(tokens) > MaxFuzzyExpansions {
+		var err error
+		tokens, err = q.closestTerms(variants)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(tokens) == 0 {
+			return nil, nil, nil
+		}
+
+		kept := map[string]int{}
+		for _, token := range tokens {
+			kept[token] = variants[token]
+		}
+		variants = kept
+	}
+
+	// the term itself is counted by the condition
+	for range tokens[1:] {
+		if err := q.count(); err != nil {
+			return nil, nil, err
+		}
+	}
+	return &termLookup{tokens: tokens}, fuzzyMatcher(term, variants), nil
+}
+
+// closestTerms returns the tokens closestVariants keeps among variants, counting their live documents in the segments
+func (q *diskQuery) closestTerms(variants map[string]int) ([]string, error) {
+	docs := map[string]int64{}
+	for _, s := range q.segments {
+		for token := range variants {
+			term, found := s.term(token)
+			if !found {
+				continue
+			}
+
+			seen := map[int]bool{}
+			err := s.postingsOf(term, func(doc, _ int, _ int64, _ []int) {
+				seen[doc] = true
+			})
+			if err != nil {
+				return nil, err
+			}
+			docs[token] += int64(len(seen))
+		}
+	}
+
+	list := make([]*fuzzyVariant, 0, len(variants))
+	for token, distance := range variants {
+		list = append(list, &fuzzyVariant{token: token, distance: distance, docs: docs[token]})
+	}
+	return closestVariants(list), nil
+}
+
+// number compiles query into a function telling whether a number satisfies it
+func (q *diskQuery) number(query *pb.NumQuery, depth int) (func(num float64) bool, error) {
+	var (
+		compare func(a, b float64) bool
+		value   float64
+		and     bool
+		queries []*pb.NumQuery
+	)
+
+	switch v := query.GetBool().(type) {
+	case *pb.NumQuery_And:
+		and, queries = true, v.And.Queries
+	case *pb.NumQuery_Or:
+		queries = v.Or.Queries
+	case *pb.NumQuery_Not:
+		if err := q.enterGroup(depth, 1); err != nil {
+			return nil, err
+		}
+
+		match, err := q.number(v.Not.Expressions, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		return func(num float64) bool { return !match(num) }, nil
+	case *pb.NumQuery_Eq:
+		compare, value = func(a, b float64) bool { return a == b }, v.Eq.Value
+	case *pb.NumQuery_Gt:
+		compare, value = func(a, b float64) bool { return a > b }, v.Gt.Value
+	case *pb.NumQuery_Gte:
+		compare, value = func(a, b float64) bool { return a >= b }, v.Gte.Value
+	case *pb.NumQuery_Lt:
+		compare, value = func(a, b float64) bool { return a < b }, v.Lt.Value
+	case *pb.NumQuery_Lte:
+		compare, value = func(a, b float64) bool { return a <= b }, v.Lte.Value
+	default:
+		return nil, errors.BadRequest("unsupported number condition")
+	}
+
+	if compare != nil {
+		if err := q.count(); err != nil {
+			return nil, err
+		}
+		return func(num float64) bool { return compare(num, value) }, nil
+	}
+
+	if err := q.enterGroup(depth, len(queries)); err != nil {
+		return nil, err
+	}
+
+	matches := make([]func(float64) bool, len(queries))
+	for ind, sub := range queries {
+		match, err := q.number(sub, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		matches[ind] = match
+	}
+	return func(num float64) bool {
+		for _, match := range matches {
+			if match(num) != and {
+				return !and
+			}
+		}
+		return and
+	}, nil
+}
+
+// properties compiles query into a function telling whether decoded properties satisfy it. String conditions apply
+// to strings and to the text of numbers, numeric conditions to numbers and booleans, counted as 1 and 0. Conditions
+// on missing values are false, null values only satisfy null and existence conditions
+func (q *diskQuery) geo(query *pb.GeoQuery, depth int) (func(p geoPoint) bool, error) {
+	var (
+		and     bool
+		queries []*pb.GeoQuery
+	)
+
+	switch v := query.GetBool().(type) {
+	case *pb.GeoQuery_And:
+		and, queries = true, v.And.Queries
+	case *pb.GeoQuery_Or:
+		queries = v.Or.Queries
+	case *pb.GeoQuery_Not:
+		if err := q.enterGroup(depth, 1); err != nil {
+			return nil, err
+		}
+
+		match, err := q.geo(v.Not.Expressions, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		return func(p geoPoint) bool { return !match(p) }, nil
+
+	case *pb.GeoQuery_WithinRadius:
+		radius, err := newRadiusCondition(v.WithinRadius)
+		if err != nil {
+			return nil, err
+		}
+		if err = q.count(); err != nil {
+			return nil, err
+		}
+		return func(p geoPoint) bool { return radius.match(p.lat, p.lon) }, nil
+
+	case *pb.GeoQuery_WithinBox:
+		box, err := newBoxCondition(v.WithinBox)
+		if err != nil {
+			return nil, err
+		}
+		if err = q.count(); err != nil {
+			return nil, err
+		}
+		return func(p geoPoint) bool { return box.match(p.lat, p.lon) }, nil
+
+	default:
+		return nil, errors.BadRequest("unsupported geo condition")
+	}
+
+	if err := q.enterGroup(depth, len(queries)); err != nil {
+		return nil, err
+	}
+
+	matches := make([]func(geoPoint) bool, len(queries))
+	for ind, sub := range queries {
+		match, err := q.geo(sub, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		matches[ind] = match
+	}
+	return func(p geoPoint) bool {
+		for _, match := range matches {
+			if match(p) != and {
+				return !and
+			}
+		}
+		return and
+	}, nil
+}
+
+func (q *diskQuery) properties(query *pb.FieldQuery, depth int) (func(props map[string]interface{}) bool, error) {
+	normalize := propsMappingNormalizer()
+
+	var (
+		and     bool
+		queries []*pb.FieldQuery
+	)
+
+	switch v := query.GetBool().(type) {
+	case *pb.FieldQuery_And:
+		and, queries = true, v.And.Queries
+	case *pb.FieldQuery_Or:
+		queries = v.Or.Queries
+	case *pb.FieldQuery_Not:
+		if err := q.enterGroup(depth, 1); err != nil {
+			return nil, err
+		}
+
+		match, err := q.properties(v.Not.Expressions, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		return func(props map[string]interface{}) bool { return !match(props) }, nil
+
+	case *pb.FieldQuery_Contains:
+		pattern := normalize(v.Contains.Value)
+		return q.textProperty(v.Contains.Field, true, func(s string) bool { return strings.Contains(s, pattern) })
+	case *pb.FieldQuery_StartsWith:
+		pattern := normalize(v.StartsWith.Value)
+		return q.textProperty(v.StartsWith.Field, true, func(s string) bool { return strings.HasPrefix(s, pattern) })
+	case *pb.FieldQuery_EndsWith:
+		pattern := normalize(v.EndsWith.Value)
+		return q.textProperty(v.EndsWith.Field, true, func(s string) bool { return strings.HasSuffix(s, pattern) })
+	case *pb.FieldQuery_StrEqual:
+		value := normalize(v.StrEqual.Value)
+		return q.textProperty(v.StrEqual.Field, false, func(s string) bool { return s == value })
+
+	case *pb.FieldQuery_Lt:
+		return q.numericProperty(v.Lt.Field, func(f float64) bool { return f < v.Lt.Value })
+	case *pb.FieldQuery_Lte:
+		return q.numericProperty(v.Lte.Field, func(f float64) bool { return f <= v.Lte.Value })
+	case *pb.FieldQuery_Gt:
+		return q.numericProperty(v.Gt.Field, func(f float64) bool { return f > v.Gt.Value })
+	case *pb.FieldQuery_Gte:
+		return q.numericProperty(v.Gte.Field, func(f float64) bool { return f >= v.Gte.Value })
+	case *pb.FieldQuery_NumbEq:
+		return q.numericProperty(v.NumbEq.Field, func(f float64) bool { return f == v.NumbEq.Value })
+
+	case *pb.FieldQuery_BoolEq:
+		return q.elementProperty(v.BoolEq.Field, func(value interface{}) bool { return value == v.BoolEq.Value })
+	case *pb.FieldQuery_IsNull:
+		return q.elementProperty(v.IsNull.Field, func(value interface{}) bool { return value == nil })
+	case *pb.FieldQuery_Exists:
+		if err := q.property(v.Exists.Field); err != nil {
+			return nil, err
+		}
+		return func(props map[string]interface{}) bool {
+			_, found := props[v.Exists.Field]
+			return found
+		}, nil
+
+	case *pb.FieldQuery_In:
+		return q.listProperty(v.In.Field, v.In.Values, listIn)
+	case *pb.FieldQuery_AnyOf:
+		return q.listProperty(v.AnyOf.Field, v.AnyOf.Values, listAnyOf)
+	case *pb.FieldQuery_AllOf:
+		return q.listProperty(v.AllOf.Field, v.AllOf.Values, listAllOf)
+
+	default:
+		return nil, errors.BadRequest("unsupported field condition")
+	}
+
+	if err := q.enterGroup(depth, len(queries)); err != nil {
+		return nil, err
+	}
+
+	matches := make([]func(map[string]interface{}) bool, len(queries))
+	for ind, sub := range queries {
+		match, err := q.properties(sub, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		matches[ind] = match
+	}
+	return func(props map[string]interface{}) bool {
+		for _, match := range matches {
+			if match(props) != and {
+				return !and
+			}
+		}
+		return and
+	}, nil
+}
+
+func (q *diskQuery) property(field string) error {
+	if !q.fields[field] {
+		return errors.BadRequest("search query references a field that is not indexed", errors.Details{Key: "field", Value: field})
+	}
+	return q.count()
+}
+
+// textProperty compiles a condition on string property values. When numbers is set, the text of numbers is also compared
+func (q *diskQuery) textProperty(field string, numbers bool, match func(s string) bool) (func(map[string]interface{}) bool, error) {
+	return q.elementProperty(field, func(value interface{}) bool {
+		switch v := value.(type) {
+		case string:
+			return match(v)
+		case json.Number:
+			return numbers && match(v.String())
+		}
+		return false
+	})
+}
+
+func (q *diskQuery) numericProperty(field string, match func(f float64) bool) (func(map[string]interface{}) bool, error) {
+	return q.elementProperty(field, func(value interface{}) bool {
+		f, ok := numericValue(value)
+		return ok && match(f)
+	})
+}
+
+// elementProperty compiles a condition that matches when one of the values of a property matches
+func (q *diskQuery) elementProperty(field string, match func(value interface{}) bool) (func(map[string]interface{}) bool, error) {
+	if err := q.property(field); err != nil {
+		return nil, err
+	}
+
+	return func(props map[string]interface{}) bool {
+		for _, value := range propertyValues(props, field) {
+			if match(value) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// listProperty compiles an in, any_of or all_of condition on the values of a property
+func (q *diskQuery) listProperty(field string, values []*pb.Value, match listMatch) (func(map[string]interface{}) bool, error) {
+	if err := q.property(field); err != nil {
+		return nil, err
+	}
+
+	if err := checkListValues(field, values); err != nil {
+		return nil, err
+	}
+
+	normalize := propsMappingNormalizer()
+	equals := make([]func(value interface{}) bool, len(values))
+	for ind, value := range values {
+		switch v := value.Value.(type) {
+		case *pb.Value_Text:
+			text := normalize(v.Text)
+			equals[ind] = func(value interface{}) bool { return value == text }
+		case *pb.Value_Number:
+			equals[ind] = func(value interface{}) bool {
+				f, ok := numericValue(value)
+				return ok && f == v.Number
+			}
+		case *pb.Value_Boolean:
+			equals[ind] = func(value interface{}) bool { return value == v.Boolean }
+		}
+	}
+
+	listed := func(value interface{}) bool {
+		for _, equal := range equals {
+			if equal(value) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(props map[string]interface{}) bool {
+		elements := propertyValues(props, field)
+		switch match {
+		case listIn:
+			for _, element := range elements {
+				if !listed(element) {
+					return false
+				}
+			}
+			return len(elements) > 0
+
+		case listAnyOf:
+			for _, element := range elements {
+				if listed(element) {
+					return true
+				}
+			}
+			return false
+
+		default:
+			for _, equal := range equals {
+				found := false
+				for _, element := range elements {
+					if found = equal(element); found {
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+			return true
+		}
+	}, nil
+}
+
+// propertyValues returns the values of a property: the elements of arrays or the value itself
+func propertyValues(props map[string]interface{}, field string) []interface{} {
+	value, found := props[field]
+	if !found {
+		return nil
+	}
+
+	if elements, ok := value.([]interface{}); ok {
+		return elements
+	}
+	return []interface{}{value}
+}
+
+// numericValue returns the number a property value counts as
+func numericValue(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// decodedProps returns the decoded properties of the documents, nil for documents without properties. Numbers are
+// kept as written, so that facets count them under their original text
+func (s *segment) decodedProps() []map[string]interface{} {
+	s.propsOnce.Do(func() {
+		s.props = make([]map[string]interface{}, len(s.docs))
+		for n, doc := range s.docs {
+			if doc.props == "" {
+				continue
+			}
+
+			decoder := json.NewDecoder(bytes.NewBufferString(doc.props))
+			decoder.UseNumber()
+
+			var props map[string]interface{}
+			if decoder.Decode(&props) == nil {
+				s.props[n] = props
+			}
+		}
+	})
+	return s.props
+}
+
+// facetValue is a value counted by a facet
+type facetValue struct {
+	key     string
+	number  float64
+	numeric bool
+}
+
+// facetValues returns the values of field of docs: their numbers for $number, their property value otherwise
+func (q *diskQuery) facetValues(docs map[docKey]bool, field string) []facetValue {
+	var values []facetValue
+	for key := range docs {
+		s := q.segments[key.seg]
+		if field == NumberField {
+			for _, num := range s.docs[key.doc].numbers {
+				values = append(values, facetValue{key: formatNumber(num), number: num, numeric: true})
+			}
+			continue
+		}
+
+		for _, element := range propertyValues(s.decodedProps()[key.doc], field) {
+			switch value := element.(type) {
+			case nil:
+			case string:
+				values = append(values, facetValue{key: value})
+			case json.Number:
+				f, err := value.Float64()
+				if err == nil {
+					values = append(values, facetValue{key: value.String(), number: f, numeric: true})
+				}
+			case bool:
+				f, _ := numericValue(value)
+				values = append(values, facetValue{key: formatNumber(f), number: f})
+			default:
+				encoded, _ := json.Marshal(value)
+				values = append(values, facetValue{key: string(encoded)})
+			}
+		}
+	}
+	return values
+}
+
+// termBuckets counts the size most frequent values. Values with the same count are ordered by value, numbers first
+func termBuckets(values []facetValue, size int) []*pb.FacetBucket {
+	if size == 0 {
+		size = DefaultFacetSize
+	}
+
+	counts := map[string]int64{}
+	var distinct []facetValue
+	for _, value := range values {
+		if _, found := counts[value.key]; !found {
+			distinct = append(distinct, value)
+		}
+		counts[value.key]++
+	}
+
+	sort.Slice(distinct, func(i, j int) bool {
+		a, b := distinct[i], distinct[j]
+		if counts[a.key] != counts[b.key] {
+			return counts[a.key] > counts[b.key]
+		}
+		if a.numeric != b.numeric {
+			return a.numeric
+		}
+		if a.numeric && a.number != b.number {
+			return a.number < b.number
+		}
+		return a.key < b.key
+	})
+
+	if len(distinct) > size {
+		distinct = distinct[:size]
+	}
+
+	var buckets []*pb.FacetBucket
+	for _, value := range distinct {
+		buckets = append(buckets, &pb.FacetBucket{Key: value.key, Count: counts[value.key]})
+	}
+	return buckets
+}
+
+// rangeBuckets counts the numeric values in each range
+func rangeBuckets(values []facetValue, ranges []*pb.NumRange) []*pb.FacetBucket {
+	var buckets []*pb.FacetBucket
+	for _, r := range ranges {
+		bucket := &pb.FacetBucket{Key: rangeKey(r)}
+		for _, value := range values {
+			if !value.numeric {
+				continue
+			}
+			if r.From != nil && value.number < r.From.Value || r.To != nil && value.number >= r.To.Value {
+				continue
+			}
+			bucket.Count++
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
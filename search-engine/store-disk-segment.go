@@ -0,0 +1,587 @@
+package se
+
+import (
+	"encoding/binary"
+	"github.com/omecodes/errors"
+	"hash/crc32"
+	"math"
+	"math/bits"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// segmentMagic starts and ends segment files
+const segmentMagic = "seidx003"
+
+// segmentMagicV2 starts and ends the segment files written before points were indexed. They are still read
+const segmentMagicV2 = "seidx002"
+
+// segmentMagicV1 starts and ends the segment files written when numbers were integers. They are still read
+const segmentMagicV1 = "seidx001"
+
+// deletionsMagic starts deletions files
+const deletionsMagic = "sedel001"
+
+// storedDoc holds everything indexed for an object. Segments keep it so that an object can be indexed again when
+// one of its mappings changes, or when its segment is merged
+type storedDoc struct {
+	id      string
+	fields  map[string]*storedField
+	numbers []float64
+	points  []geoPoint
+	props   string
+}
+
+// geoPoint is an indexed point, in degrees
+type geoPoint struct {
+	lat, lon float64
+}
+
+// storedField holds the tokens of an indexed text, in text order, and the terms indexed without position
+type storedField struct {
+	tokens []string
+	terms  []string
+}
+
+func newStoredDoc(id string) *storedDoc {
+	return &storedDoc{id: id, fields: map[string]*storedField{}}
+}
+
+func (d *storedDoc) empty() bool {
+	return len(d.fields) == 0 && len(d.numbers) == 0 && len(d.points) == 0 && d.props == ""
+}
+
+// segmentDoc is the part of a segment document that is loaded in memory
+type segmentDoc struct {
+	id      string
+	stored  int
+	numbers []float64
+	points  []geoPoint
+	props   string
+	lengths []fieldLength
+}
+
+type fieldLength struct {
+	field  int
+	length int64
+}
+
+// length returns the length of the text indexed for field, 0 when none was
+func (d *segmentDoc) length(field int) int64 {
+	for _, l := range d.lengths {
+		if l.field == field {
+			return l.length
+		}
+	}
+	return 0
+}
+
+// segment is an immutable inverted index of a set of documents. Documents are numbered in the order of their ids.
+// Deleting a document only flags it in the segment deletions set, it is dropped when the segment is merged
+type segment struct {
+	name   string
+	data   []byte
+	fields []string
+	docs   []*segmentDoc
+	terms  []string
+
+	// postings holds the offset of the postings of each term in data
+	postings []int
+
+	deleted *bitset
+
+	// deletions is the name of the file the deletions are saved in, changed is set when deletions were changed since
+	deletions string
+	changed   bool
+
+	propsOnce sync.Once
+	props     []map[string]interface{}
+}
+
+// live tells whether the document numbered doc is not deleted
+func (s *segment) live(doc int) bool {
+	return s.deleted == nil || !s.deleted.has(doc)
+}
+
+// liveDocs returns the number of documents that are not deleted
+func (s *segment) liveDocs() int {
+	if s.deleted == nil {
+		return len(s.docs)
+	}
+	return len(s.docs) - s.deleted.count()
+}
+
+func (s *segment) delete(doc int) {
+	if s.deleted == nil {
+		s.deleted = newBitset(len(s.docs))
+	}
+	s.deleted.set(doc)
+	s.changed = true
+}
+
+// term returns the index of token in the term dictionary
+func (s *segment) term(token string) (int, bool) {
+	ind := sort.SearchStrings(s.terms, token)
+	return ind, ind < len(s.terms) && s.terms[ind] == token
+}
+
+// prefixRange returns the range of the term dictionary holding the terms that start with prefix
+func (s *segment) prefixRange(prefix string) (int, int) {
+	start := sort.SearchStrings(s.terms, prefix)
+	end := start
+	for end < len(s.terms) && strings.HasPrefix(s.terms[end], prefix) {
+		end++
+	}
+	return start, end
+}
+
+// postingsOf calls visit with each posting of the term numbered term whose document is live. Positions are only
+// valid during the call
+func (s *segment) postingsOf(term int, visit func(doc, field int, tf int64, positions []int)) error {
+	d := &decoder{data: s.data, pos: s.postings[term]}
+	count := d.uvarint()
+
+	var (
+		doc       int
+		positions []int
+	)
+	for i := 0; i < int(count) && d.err == nil; i++ {
+		doc += int(d.uvarint())
+		field := int(d.uvarint())
+		tf := int64(d.uvarint())
+
+		positions = positions[:0]
+		pos := 0
+		for j, n := 0, int(d.uvarint()); j < n; j++ {
+			pos += int(d.uvarint())
+			positions = append(positions, pos)
+		}
+
+		if d.err == nil && doc < len(s.docs) && field < len(s.fields) && s.live(doc) {
+			visit(doc, field, tf, positions)
+		}
+	}
+	return d.err
+}
+
+// storedDoc decodes the stored fields of the document numbered doc
+func (s *segment) storedDoc(doc int) (*storedDoc, error) {
+	sd := s.docs[doc]
+	stored := newStoredDoc(sd.id)
+	stored.props = sd.props
+	stored.numbers = append([]float64{}, sd.numbers...)
+	stored.points = append([]geoPoint{}, sd.points...)
+
+	d := &decoder{data: s.data, pos: sd.stored}
+	for i, n := 0, int(d.uvarint()); i < n && d.err == nil; i++ {
+		field := int(d.uvarint())
+		f := &storedField{tokens: d.strings(), terms: d.strings()}
+		if field < len(s.fields) {
+			stored.fields[s.fields[field]] = f
+		}
+	}
+	return stored, d.err
+}
+
+// encodeSegment encodes docs as a segment. Sections are written in the order they are decoded, and a footer holds
+// their offsets and the checksum of the file
+func encodeSegment(docs []*storedDoc) []byte {
+	sorted := append([]*storedDoc{}, docs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].id < sorted[j].id })
+
+	fieldOrds := map[string]int{}
+	var fields []string
+	for _, doc := range sorted {
+		for name := range doc.fields {
+			if _, found := fieldOrds[name]; !found {
+				fieldOrds[name] = 0
+				fields = append(fields, name)
+			}
+		}
+	}
+	sort.Strings(fields)
+	for ord, name := range fields {
+		fieldOrds[name] = ord
+	}
+
+	type entry struct {
+		doc, field int
+		tf         int64
+		positions  []int
+	}
+	postings := map[string][]*entry{}
+
+	e := &encoder{}
+	e.raw([]byte(segmentMagic))
+
+	e.uvarint(uint64(len(fields)))
+	for _, name := range fields {
+		e.string(name)
+	}
+
+	// stored fields
+	storedOffsets := make([]int, len(sorted))
+	for n, doc := range sorted {
+		storedOffsets[n] = len(e.buf)
+
+		names := make([]string, 0, len(doc.fields))
+		for name := range doc.fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		e.uvarint(uint64(len(names)))
+		for _, name := range names {
+			f := doc.fields[name]
+			e.uvarint(uint64(fieldOrds[name]))
+			e.strings(f.tokens)
+			e.strings(f.terms)
+
+			entries := map[string]*entry{}
+			add := func(token string, pos int) {
+				en, found := entries[token]
+				if !found {
+					en = &entry{doc: n, field: fieldOrds[name]}
+					entries[token] = en
+					postings[token] = append(postings[token], en)
+				}
+				en.tf++
+				if pos >= 0 {
+					en.positions = append(en.positions, pos)
+				}
+			}
+			for pos, token := range f.tokens {
+				add(token, pos)
+			}
+			for _, term := range f.terms {
+				add(term, -1)
+			}
+		}
+	}
+
+	// documents
+	docsOffset := len(e.buf)
+	e.uvarint(uint64(len(sorted)))
+	for n, doc := range sorted {
+		e.string(doc.id)
+		e.uvarint(uint64(storedOffsets[n]))
+
+		e.uvarint(uint64(len(doc.numbers)))
+		for _, num := range doc.numbers {
+			e.fixed(math.Float64bits(num))
+		}
+		e.uvarint(uint64(len(doc.points)))
+		for _, p := range doc.points {
+			e.fixed(math.Float64bits(p.lat))
+			e.fixed(math.Float64bits(p.lon))
+		}
+		e.string(doc.props)
+
+		e.uvarint(uint64(len(doc.fields)))
+		for _, name := range fields {
+			if f, found := doc.fields[name]; found {
+				e.uvarint(uint64(fieldOrds[name]))
+				e.uvarint(uint64(len(f.tokens) + len(f.terms)))
+			}
+		}
+	}
+
+	// postings, in term order
+	terms := make([]string, 0, len(postings))
+	for term := range postings {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+
+	postingsOffsets := make([]int, len(terms))
+	for ind, term := range terms {
+		postingsOffsets[ind] = len(e.buf)
+		entries := postings[term]
+		sort.Slice(entries, func(i, j int) bool {
+			if entries[i].doc != entries[j].doc {
+				return entries[i].doc < entries[j].doc
+			}
+			return entries[i].field < entries[j].field
+		})
+
+		e.uvarint(uint64(len(entries)))
+		previous := 0
+		for _, en := range entries {
+			e.uvarint(uint64(en.doc - previous))
+			previous = en.doc
+			e.uvarint(uint64(en.field))
+			e.uvarint(uint64(en.tf))
+			e.uvarint(uint64(len(en.positions)))
+			pos := 0
+			for _, p := range en.positions {
+				e.uvarint(uint64(p - pos))
+				pos = p
+			}
+		}
+	}
+
+	// term dictionary
+	dictionaryOffset := len(e.buf)
+	e.uvarint(uint64(len(terms)))
+	for ind, term := range terms {
+		e.string(term)
+		e.uvarint(uint64(postingsOffsets[ind]))
+	}
+
+	e.fixed(uint64(docsOffset))
+	e.fixed(uint64(dictionaryOffset))
+	e.fixed(uint64(crc32.ChecksumIEEE(e.buf)))
+	e.raw([]byte(segmentMagic))
+	return e.buf
+}
+
+// segmentFooterSize is the size of the offsets, checksum and magic that end segment files
+const segmentFooterSize = 3*8 + len(segmentMagic)
+
+// decodeSegment loads the segment encoded in data, after checking its checksum
+func decodeSegment(name string, data []byte) (*segment, error) {
+	corrupted := errors.Internal("corrupted index segment", errors.Details{Key: "segment", Value: name})
+
+	if len(data) < len(segmentMagic)+segmentFooterSize {
+		return nil, corrupted
+	}
+
+	magic := string(data[:len(segmentMagic)])
+	if magic != segmentMagic && magic != segmentMagicV2 && magic != segmentMagicV1 || string(data[len(data)-len(segmentMagic):]) != magic {
+		return nil, corrupted
+	}
+
+	footer := len(data) - segmentFooterSize
+	docsOffset := int(binary.LittleEndian.Uint64(data[footer:]))
+	dictionaryOffset := int(binary.LittleEndian.Uint64(data[footer+8:]))
+	checksum := uint32(binary.LittleEndian.Uint64(data[footer+16:]))
+	if crc32.ChecksumIEEE(data[:footer+16]) != checksum || docsOffset > footer || dictionaryOffset > footer {
+		return nil, corrupted
+	}
+
+	s := &segment{name: name, data: data}
+
+	d := &decoder{data: data, pos: len(segmentMagic)}
+	s.fields = d.strings()
+
+	d.pos = docsOffset
+	s.docs = make([]*segmentDoc, d.count())
+	for n := range s.docs {
+		doc := &segmentDoc{id: d.string(), stored: int(d.uvarint())}
+		for i, count := 0, d.count(); i < count; i++ {
+			if magic == segmentMagicV1 {
+				doc.numbers = append(doc.numbers, float64(d.varint()))
+			} else {
+				doc.numbers = append(doc.numbers, math.Float64frombits(d.fixed()))
+			}
+		}
+		if magic == segmentMagic {
+			for i, count := 0, d.count(); i < count; i++ {
+				doc.points = append(doc.points, geoPoint{lat: math.Float64frombits(d.fixed()), lon: math.Float64frombits(d.fixed())})
+			}
+		}
+		doc.props = d.string()
+		for i, count := 0, d.count(); i < count; i++ {
+			doc.lengths = append(doc.lengths, fieldLength{field: int(d.uvarint()), length: int64(d.uvarint())})
+		}
+		s.docs[n] = doc
+	}
+
+	d.pos = dictionaryOffset
+	count := d.count()
+	s.terms = make([]string, count)
+	s.postings = make([]int, count)
+	for ind := 0; ind < count; ind++ {
+		s.terms[ind] = d.string()
+		s.postings[ind] = int(d.uvarint())
+	}
+
+	if d.err != nil {
+		return nil, corrupted
+	}
+	return s, nil
+}
+
+// encodeDeletions encodes the deletions set of a segment
+func encodeDeletions(deleted *bitset) []byte {
+	e := &encoder{}
+	e.raw([]byte(deletionsMagic))
+	e.uvarint(uint64(deleted.size))
+	for _, word := range deleted.words {
+		e.fixed(word)
+	}
+	e.fixed(uint64(crc32.ChecksumIEEE(e.buf)))
+	return e.buf
+}
+
+func decodeDeletions(name string, data []byte, docs int) (*bitset, error) {
+	corrupted := errors.Internal("corrupted index deletions", errors.Details{Key: "deletions", Value: name})
+	if len(data) < len(deletionsMagic)+8 || string(data[:len(deletionsMagic)]) != deletionsMagic {
+		return nil, corrupted
+	}
+
+	end := len(data) - 8
+	if crc32.ChecksumIEEE(data[:end]) != uint32(binary.LittleEndian.Uint64(data[end:])) {
+		return nil, corrupted
+	}
+
+	d := &decoder{data: data[:end], pos: len(deletionsMagic)}
+	size := d.count()
+	if d.err != nil || size != docs {
+		return nil, corrupted
+	}
+
+	b := newBitset(size)
+	for ind := range b.words {
+		b.words[ind] = d.fixed()
+	}
+	if d.err != nil {
+		return nil, corrupted
+	}
+	return b, nil
+}
+
+type bitset struct {
+	size  int
+	words []uint64
+}
+
+func newBitset(size int) *bitset {
+	return &bitset{size: size, words: make([]uint64, (size+63)/64)}
+}
+
+func (b *bitset) set(i int) {
+	b.words[i/64] |= 1 << (uint(i) % 64)
+}
+
+func (b *bitset) has(i int) bool {
+	return b.words[i/64]&(1<<(uint(i)%64)) != 0
+}
+
+func (b *bitset) count() int {
+	count := 0
+	for _, word := range b.words {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+func (b *bitset) clone() *bitset {
+	if b == nil {
+		return nil
+	}
+	return &bitset{size: b.size, words: append([]uint64{}, b.words...)}
+}
+
+type encoder struct {
+	buf     []byte
+	scratch [binary.MaxVarintLen64]byte
+}
+
+func (e *encoder) raw(b []byte) {
+	e.buf = append(e.buf, b...)
+}
+
+func (e *encoder) uvarint(v uint64) {
+	n := binary.PutUvarint(e.scratch[:], v)
+	e.buf = append(e.buf, e.scratch[:n]...)
+}
+
+func (e *encoder) fixed(v uint64) {
+	binary.LittleEndian.PutUint64(e.scratch[:8], v)
+	e.buf = append(e.buf, e.scratch[:8]...)
+}
+
+func (e *encoder) string(s string) {
+	e.uvarint(uint64(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+func (e *encoder) strings(list []string) {
+	e.uvarint(uint64(len(list)))
+	for _, s := range list {
+		e.string(s)
+	}
+}
+
+// decoder reads what an encoder wrote. Reading past the end of data or a malformed value sets err, after which
+// every read returns a zero value
+type decoder struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (d *decoder) fail() {
+	if d.err == nil {
+		d.err = errors.Internal("malformed index data")
+	}
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil || d.pos >= len(d.data) {
+		d.fail()
+		return 0
+	}
+	v, n := binary.Uvarint(d.data[d.pos:])
+	if n <= 0 {
+		d.fail()
+		return 0
+	}
+	d.pos += n
+	return v
+}
+
+func (d *decoder) varint() int64 {
+	if d.err != nil || d.pos >= len(d.data) {
+		d.fail()
+		return 0
+	}
+	v, n := binary.Varint(d.data[d.pos:])
+	if n <= 0 {
+		d.fail()
+		return 0
+	}
+	d.pos += n
+	return v
+}
+
+func (d *decoder) fixed() uint64 {
+	if d.err != nil || d.pos+8 > len(d.data) {
+		d.fail()
+		return 0
+	}
+	v := binary.LittleEndian.Uint64(d.data[d.pos:])
+	d.pos += 8
+	return v
+}
+
+// count reads a number of items, each taking at least a byte
+func (d *decoder) count() int {
+	n := d.uvarint()
+	if n > uint64(len(d.data)-d.pos) {
+		d.fail()
+		return 0
+	}
+	return int(n)
+}
+
+func (d *decoder) string() string {
+	n := d.count()
+	if d.err != nil {
+		return ""
+	}
+	s := string(d.data[d.pos : d.pos+n])
+	d.pos += n
+	return s
+}
+
+func (d *decoder) strings() []string {
+	n := d.count()
+	var list []string
+	for i := 0; i < n && d.err == nil; i++ {
+		list = append(list, d.string())
+	}
+	return list
+}
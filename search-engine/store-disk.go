@@ -0,0 +1,873 @@
+package se
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/omecodes/errors"
+	"github.com/omecodes/libome/logs"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxBufferedDocs is the number of changed objects a disk store keeps in memory before writing them in a
+	// new segment
+	DefaultMaxBufferedDocs = 10000
+
+	// DefaultCommitInterval is the maximum time changes stay in memory before a disk store commits them
+	DefaultCommitInterval = time.Second
+
+	// DefaultMergeFactor is the number of segments that makes a disk store merge the smallest of them
+	DefaultMergeFactor = 10
+)
+
+// manifestFileName is the name of the file recording the last commit of a disk store
+const manifestFileName = "manifest"
+
+// DiskStoreOptions tunes a disk store. Zero values select the defaults
+type DiskStoreOptions struct {
+	// MaxBufferedDocs is the number of changed objects kept in memory before they are written to a new segment
+	MaxBufferedDocs int
+
+	// CommitInterval is the maximum time changes stay in memory before they are committed
+	CommitInterval time.Duration
+
+	// MergeFactor is the number of segments from which that many of the smallest ones are merged into a single one
+	MergeFactor int
+}
+
+// manifest lists the files of the segments of a commit
+type manifest struct {
+	Generation  int64              `json:"generation"`
+	NextSegment int64              `json:"next_segment"`
+	Segments    []*manifestSegment `json:"segments"`
+}
+
+type manifestSegment struct {
+	Name      string `json:"name"`
+	Deletions string `json:"deletions,omitempty"`
+}
+
+// docRef locates the live version of an object: a document of a segment, or the buffer when seg is nil
+type docRef struct {
+	seg *segment
+	doc int
+}
+
+// fieldTotals accumulates the number of documents and the length of the texts indexed for a field
+type fieldTotals struct {
+	docs   int64
+	length int64
+}
+
+// NewDiskIndexStore opens the segment based inverted index stored in dir, creating it when dir holds none.
+// Changes are searchable as soon as they are made. They are kept in memory until they are committed: every
+// CommitInterval, when MaxBufferedDocs objects changed, or when Commit or Close are called. A commit writes the
+// changed objects in a new segment, then atomically replaces the manifest listing the segments: a crash loses the
+// changes made since the last commit but never leaves a partially written index. Segments are merged in the
+// background, and files no longer listed in the manifest are removed
+func NewDiskIndexStore(dir string, opts DiskStoreOptions) (*DiskStore, error) {
+	if opts.MaxBufferedDocs <= 0 {
+		opts.MaxBufferedDocs = DefaultMaxBufferedDocs
+	}
+	if opts.CommitInterval <= 0 {
+		opts.CommitInterval = DefaultCommitInterval
+	}
+	if opts.MergeFactor < 2 {
+		opts.MergeFactor = DefaultMergeFactor
+	}
+
+	err := os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &DiskStore{
+		dir:      dir,
+		opts:     opts,
+		buffer:   map[string]*storedDoc{},
+		docs:     map[string]docRef{},
+		stats:    map[string]*fieldTotals{},
+		reserved: map[string]bool{},
+		merges:   make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+
+	err = s.load()
+	if err != nil {
+		return nil, err
+	}
+	s.removeUnreferencedFiles()
+
+	s.wg.Add(1)
+	go s.run()
+	return s, nil
+}
+
+// DiskStore is an index store that keeps the index of a collection in segment files on the local disk
+type DiskStore struct {
+	sync.RWMutex
+	dir  string
+	opts DiskStoreOptions
+
+	generation  int64
+	nextSegment int64
+	segments    []*segment
+
+	// buffer holds the objects changed since the last commit. bufferSegment is the buffer encoded as a segment
+	// for searches, it is reset when the buffer changes
+	buffer        map[string]*storedDoc
+	bufferMutex   sync.Mutex
+	bufferSegment *segment
+
+	docs  map[string]docRef
+	stats map[string]*fieldTotals
+	dirty bool
+
+	// reserved holds the names of the segment files being written by merges
+	reserved map[string]bool
+
+	// suggestions is built from the segments by the first suggestion request, then kept up to date by the changes
+	// of objects. suggestMutex guards it, it is acquired after the store lock
+	suggestMutex sync.Mutex
+	suggestions  *suggestTree
+
+	merges    chan struct{}
+	done      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+	closed    bool
+}
+
+// load reads the segments of the last commit
+func (s *DiskStore) load() error {
+	data, err := ioutil.ReadFile(filepath.Join(s.dir, manifestFileName))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var m *manifest
+	err = json.Unmarshal(data, &m)
+	if err != nil {
+		return errors.Internal("corrupted index manifest", errors.Details{Key: "dir", Value: s.dir})
+	}
+	s.generation, s.nextSegment = m.Generation, m.NextSegment
+
+	for _, ms := range m.Segments {
+		data, err := ioutil.ReadFile(filepath.Join(s.dir, ms.Name))
+		if err != nil {
+			return err
+		}
+
+		seg, err := decodeSegment(ms.Name, data)
+		if err != nil {
+			return err
+		}
+
+		if ms.Deletions != "" {
+			data, err := ioutil.ReadFile(filepath.Join(s.dir, ms.Deletions))
+			if err != nil {
+				return err
+			}
+
+			seg.deleted, err = decodeDeletions(ms.Deletions, data, len(seg.docs))
+			if err != nil {
+				return err
+			}
+			seg.deletions = ms.Deletions
+		}
+		s.segments = append(s.segments, seg)
+	}
+
+	for _, seg := range s.segments {
+		for n, doc := range seg.docs {
+			if !seg.live(n) {
+				continue
+			}
+
+			if previous, found := s.docs[doc.id]; found {
+				previous.seg.delete(previous.doc)
+				s.addStats(previous.seg.docs[previous.doc], -1, previous.seg)
+				s.dirty = true
+			}
+			s.docs[doc.id] = docRef{seg: seg, doc: n}
+			s.addStats(doc, 1, seg)
+		}
+	}
+	return nil
+}
+
+func (s *DiskStore) SaveTextMapping(id string, field string, tokens []string, terms ...string) error {
+	return s.update(id, func(doc *storedDoc) {
+		doc.fields[field] = &storedField{tokens: tokens, terms: terms}
+	})
+}
+
+func (s *DiskStore) SaveNumberMapping(num float64, id string) error {
+	return s.update(id, func(doc *storedDoc) {
+		for _, n := range doc.numbers {
+			if n == num {
+				return
+			}
+		}
+		doc.numbers = append(doc.numbers, num)
+	})
+}
+
+func (s *DiskStore) SaveGeoMapping(id string, point *pb.GeoPoint) error {
+	return s.update(id, func(doc *storedDoc) {
+		p := geoPoint{lat: point.Lat, lon: point.Lon}
+		for _, previous := range doc.points {
+			if previous == p {
+				return
+			}
+		}
+		doc.points = append(doc.points, p)
+	})
+}
+
+func (s *DiskStore) GeoPoints(ids []string) (map[string][]*pb.GeoPoint, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	points := map[string][]*pb.GeoPoint{}
+	for _, id := range ids {
+		ref, found := s.docs[id]
+		if !found {
+			continue
+		}
+
+		var docPoints []geoPoint
+		if ref.seg == nil {
+			docPoints = s.buffer[id].points
+		} else {
+			docPoints = ref.seg.docs[ref.doc].points
+		}
+		for _, p := range docPoints {
+			points[id] = append(points[id], &pb.GeoPoint{Lat: p.lat, Lon: p.lon})
+		}
+	}
+	return points, nil
+}
+
+func (s *DiskStore) MappedIDs(after string, count int) ([]string, error) {
+	s.RLock()
+	var ids []string
+	for id := range s.docs {
+		if id > after {
+			ids = append(ids, id)
+		}
+	}
+	s.RUnlock()
+
+	sort.Strings(ids)
+	if len(ids) > count {
+		ids = ids[:count]
+	}
+	return ids, nil
+}
+
+func (s *DiskStore) UnmappedIDs(ids []string) ([]string, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	var unmapped []string
+	for _, id := range ids {
+		if _, found := s.docs[id]; !found {
+			unmapped = append(unmapped, id)
+		}
+	}
+	return unmapped, nil
+}
+
+func (s *DiskStore) ObjectTokens(id string) ([]*pb.FieldTokens, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	ref, found := s.docs[id]
+	if !found {
+		return nil, nil
+	}
+
+	doc := s.buffer[id]
+	if ref.seg != nil {
+		var err error
+		doc, err = ref.seg.storedDoc(ref.doc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var fields []*pb.FieldTokens
+	for name, f := range doc.fields {
+		fields = append(fields, fieldTokens(name, f.tokens, f.terms))
+	}
+	sortFieldTokens(fields)
+	return fields, nil
+}
+
+func (s *DiskStore) TokenDocuments(token string, field string, after string, count int) ([]*pb.TokenPosting, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	segments, _, err := s.view()
+	if err != nil {
+		return nil, err
+	}
+
+	var postings []*pb.TokenPosting
+	for _, seg := range segments {
+		term, found := seg.term(token)
+		if !found {
+			continue
+		}
+
+		err = seg.postingsOf(term, func(doc, f int, tf int64, _ []int) {
+			id := seg.docs[doc].id
+			if id > after && (field == "" || seg.fields[f] == field) {
+				postings = append(postings, &pb.TokenPosting{Id: id, Field: seg.fields[f], Tf: tf})
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sortTokenPostings(postings, count), nil
+}
+
+// SavePropertiesMapping replaces the properties indexed for the object
+func (s *DiskStore) SavePropertiesMapping(id string, value string) error {
+	return s.update(id, func(doc *storedDoc) {
+		doc.props = value
+	})
+}
+
+func (s *DiskStore) DeleteObjectMappings(id string) error {
+	return s.update(id, nil)
+}
+
+// update replaces the live version of the object id by the one change makes of it. A nil change deletes the object
+func (s *DiskStore) update(id string, change func(doc *storedDoc)) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.closed {
+		return errors.ServiceUnavailable("index store is closed")
+	}
+
+	doc, err := s.take(id)
+	if err != nil {
+		return err
+	}
+	s.addSuggestions(doc, -1)
+
+	if change != nil {
+		if doc == nil {
+			doc = newStoredDoc(id)
+		}
+		change(doc)
+		s.buffer[id] = doc
+		s.docs[id] = docRef{}
+		s.addStoredStats(doc, 1)
+		s.addSuggestions(doc, 1)
+	}
+
+	s.bufferMutex.Lock()
+	s.bufferSegment = nil
+	s.bufferMutex.Unlock()
+	s.dirty = true
+
+	if len(s.buffer) >= s.opts.MaxBufferedDocs {
+		return s.commit()
+	}
+	return nil
+}
+
+// take removes the live version of the object id from the index and returns it, or nil when the object is not indexed
+func (s *DiskStore) take(id string) (*storedDoc, error) {
+	ref, found := s.docs[id]
+	if !found {
+		return nil, nil
+	}
+
+	if ref.seg == nil {
+		doc := s.buffer[id]
+		delete(s.buffer, id)
+		delete(s.docs, id)
+		s.addStoredStats(doc, -1)
+		return doc, nil
+	}
+
+	doc, err := ref.seg.storedDoc(ref.doc)
+	if err != nil {
+		return nil, err
+	}
+	ref.seg.delete(ref.doc)
+	delete(s.docs, id)
+	s.addStats(ref.seg.docs[ref.doc], -1, ref.seg)
+	return doc, nil
+}
+
+func (s *DiskStore) addStats(doc *segmentDoc, sign int64, seg *segment) {
+	for _, l := range doc.lengths {
+		s.addFieldStats(seg.fields[l.field], l.length, sign)
+	}
+}
+
+func (s *DiskStore) addStoredStats(doc *storedDoc, sign int64) {
+	for name, f := range doc.fields {
+		s.addFieldStats(name, int64(len(f.tokens)+len(f.terms)), sign)
+	}
+}
+
+func (s *DiskStore) addFieldStats(field string, length int64, sign int64) {
+	totals := s.stats[field]
+	if totals == nil {
+		totals = &fieldTotals{}
+		s.stats[field] = totals
+	}
+	totals.docs += sign
+	totals.length += sign * length
+	if totals.docs == 0 {
+		delete(s.stats, field)
+	}
+}
+
+// addSuggestions adds delta to the number of objects of the terms of doc in the prefix tree, when it is built
+func (s *DiskStore) addSuggestions(doc *storedDoc, delta int64) {
+	if doc == nil {
+		return
+	}
+
+	s.suggestMutex.Lock()
+	defer s.suggestMutex.Unlock()
+
+	if s.suggestions == nil {
+		return
+	}
+
+	terms := map[string][]string{}
+	for name, f := range doc.fields {
+		terms[name] = append(append([]string{}, f.tokens...), f.terms...)
+	}
+	s.suggestions.addFields(terms, delta)
+}
+
+// Suggest returns the terms of field that complete prefix from a prefix tree of the indexed terms, built from the
+// postings of the segments the first time
+func (s *DiskStore) Suggest(field string, prefix string, size int, maxDistance int) ([]*pb.Suggestion, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	s.suggestMutex.Lock()
+	defer s.suggestMutex.Unlock()
+
+	if s.suggestions == nil {
+		segments, _, err := s.view()
+		if err != nil {
+			return nil, err
+		}
+
+		tree := newSuggestTree()
+		for _, seg := range segments {
+			for term := range seg.terms {
+				docs := make([]int64, len(seg.fields))
+				err = seg.postingsOf(term, func(_, field int, _ int64, _ []int) {
+					docs[field]++
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				for f, n := range docs {
+					if n > 0 {
+						tree.add(seg.fields[f], seg.terms[term], n)
+					}
+				}
+			}
+		}
+		s.suggestions = tree
+	}
+	return s.suggestions.suggest(field, prefix, size, maxDistance), nil
+}
+
+// view returns the segments searches read, the buffer included, and the statistics of the indexed fields. The read
+// lock must be held while the view is used
+func (s *DiskStore) view() ([]*segment, map[string]*fieldStats, error) {
+	segments := append([]*segment{}, s.segments...)
+
+	if len(s.buffer) > 0 {
+		buffer, err := s.encodedBuffer()
+		if err != nil {
+			return nil, nil, err
+		}
+		segments = append(segments, buffer)
+	}
+
+	stats := map[string]*fieldStats{}
+	for field, totals := range s.stats {
+		stats[field] = &fieldStats{docs: totals.docs, avgLength: float64(totals.length) / float64(totals.docs)}
+	}
+	return segments, stats, nil
+}
+
+// encodedBuffer returns the buffer encoded as a segment, encoding it when it changed since it was last encoded
+func (s *DiskStore) encodedBuffer() (*segment, error) {
+	s.bufferMutex.Lock()
+	defer s.bufferMutex.Unlock()
+
+	if s.bufferSegment != nil {
+		return s.bufferSegment, nil
+	}
+
+	docs := make([]*storedDoc, 0, len(s.buffer))
+	for _, doc := range s.buffer {
+		docs = append(docs, doc)
+	}
+
+	seg, err := decodeSegment("", encodeSegment(docs))
+	if err != nil {
+		return nil, err
+	}
+	s.bufferSegment = seg
+	return seg, nil
+}
+
+// Commit writes the changes made since the last commit to disk
+func (s *DiskStore) Commit() error {
+	s.Lock()
+	defer s.Unlock()
+	return s.commit()
+}
+
+// commit writes the buffer in a new segment and the changed deletions of the other segments, then replaces the
+// manifest. Files are synced before the manifest references them. The write lock must be held
+func (s *DiskStore) commit() error {
+	if !s.dirty {
+		return nil
+	}
+	generation := s.generation + 1
+	nextSegment := s.nextSegment
+
+	var flushed *segment
+	if len(s.buffer) > 0 {
+		var err error
+		flushed, err = s.encodedBuffer()
+		if err != nil {
+			return err
+		}
+
+		name := segmentFileName(nextSegment)
+		nextSegment++
+		err = writeFileSync(filepath.Join(s.dir, name), flushed.data)
+		if err != nil {
+			return err
+		}
+	}
+
+	m := &manifest{Generation: generation, NextSegment: nextSegment}
+	deletions := map[*segment]string{}
+	for _, seg := range s.segments {
+		ms := &manifestSegment{Name: seg.name, Deletions: seg.deletions}
+		if seg.changed {
+			ms.Deletions = deletionsFileName(seg.name, generation)
+			err := writeFileSync(filepath.Join(s.dir, ms.Deletions), encodeDeletions(seg.deleted))
+			if err != nil {
+				return err
+			}
+			deletions[seg] = ms.Deletions
+		}
+		m.Segments = append(m.Segments, ms)
+	}
+	if flushed != nil {
+		m.Segments = append(m.Segments, &manifestSegment{Name: segmentFileName(s.nextSegment)})
+	}
+
+	err := s.writeManifest(m)
+	if err != nil {
+		return err
+	}
+
+	for seg, name := range deletions {
+		seg.deletions, seg.changed = name, false
+	}
+
+	if flushed != nil {
+		flushed.name = segmentFileName(s.nextSegment)
+		s.segments = append(s.segments, flushed)
+		for n, doc := range flushed.docs {
+			s.docs[doc.id] = docRef{seg: flushed, doc: n}
+		}
+		s.buffer = map[string]*storedDoc{}
+		s.bufferSegment = nil
+	}
+	s.generation, s.nextSegment, s.dirty = generation, nextSegment, false
+
+	s.removeUnreferencedFiles()
+	if len(s.segments) >= s.opts.MergeFactor {
+		select {
+		case s.merges <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// writeManifest atomically replaces the manifest with m
+func (s *DiskStore) writeManifest(m *manifest) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	tmp := filepath.Join(s.dir, manifestFileName+".tmp")
+	err = writeFileSync(tmp, data)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(tmp, filepath.Join(s.dir, manifestFileName))
+	if err != nil {
+		return err
+	}
+	return syncDir(s.dir)
+}
+
+// removeUnreferencedFiles removes the files that are neither referenced by the last commit nor being written.
+// They are left by merged segments, replaced deletions and interrupted commits
+func (s *DiskStore) removeUnreferencedFiles() {
+	referenced := map[string]bool{manifestFileName: true}
+	for _, seg := range s.segments {
+		referenced[seg.name] = true
+		referenced[seg.deletions] = true
+	}
+	for name := range s.reserved {
+		referenced[name] = true
+	}
+
+	entries, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		logs.Error("could not list index files", logs.Details("dir", s.dir), logs.Err(err))
+		return
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if referenced[name] || entry.IsDir() || !isIndexFile(name) {
+			continue
+		}
+
+		if err := os.Remove(filepath.Join(s.dir, name)); err != nil {
+			logs.Error("could not remove index file", logs.Details("file", name), logs.Err(err))
+		}
+	}
+}
+
+// run commits changes and merges segments in the background until the store is closed
+func (s *DiskStore) run() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.opts.CommitInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+
+		case <-ticker.C:
+			if err := s.Commit(); err != nil {
+				logs.Error("index commit failed", logs.Details("dir", s.dir), logs.Err(err))
+			}
+
+		case <-s.merges:
+			for {
+				merged, err := s.merge()
+				if err != nil {
+					logs.Error("index segments merge failed", logs.Details("dir", s.dir), logs.Err(err))
+				}
+				if !merged || err != nil {
+					break
+				}
+			}
+		}
+	}
+}
+
+// merge merges the MergeFactor smallest segments into one, when there are at least that many segments. The new
+// segment is written without holding the lock. Documents deleted from the merged segments meanwhile are then
+// deleted from it, before it replaces them in a commit. It is dropped if one of them was merged meanwhile
+func (s *DiskStore) merge() (bool, error) {
+	s.Lock()
+	if s.closed || len(s.segments) < s.opts.MergeFactor {
+		s.Unlock()
+		return false, nil
+	}
+
+	candidates := append([]*segment{}, s.segments...)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].liveDocs() < candidates[j].liveDocs()
+	})
+	merged := candidates[:s.opts.MergeFactor]
+
+	snapshots := make([]*bitset, len(merged))
+	for ind, seg := range merged {
+		snapshots[ind] = seg.deleted.clone()
+	}
+
+	name := segmentFileName(s.nextSegment)
+	s.nextSegment++
+	s.reserved[name] = true
+	s.Unlock()
+
+	defer func() {
+		s.Lock()
+		delete(s.reserved, name)
+		s.Unlock()
+	}()
+
+	var docs []*storedDoc
+	for ind, seg := range merged {
+		for n := range seg.docs {
+			if snapshots[ind] != nil && snapshots[ind].has(n) {
+				continue
+			}
+
+			doc, err := seg.storedDoc(n)
+			if err != nil {
+				return false, err
+			}
+			docs = append(docs, doc)
+		}
+	}
+
+	result, err := decodeSegment(name, encodeSegment(docs))
+	if err != nil {
+		return false, err
+	}
+
+	err = writeFileSync(filepath.Join(s.dir, name), result.data)
+	if err != nil {
+		return false, err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	current := map[*segment]bool{}
+	for _, seg := range s.segments {
+		current[seg] = true
+	}
+	for _, seg := range merged {
+		if !current[seg] {
+			return false, os.Remove(filepath.Join(s.dir, name))
+		}
+	}
+
+	numbers := make(map[string]int, len(result.docs))
+	for n, doc := range result.docs {
+		numbers[doc.id] = n
+	}
+
+	isMerged := map[*segment]bool{}
+	for ind, seg := range merged {
+		isMerged[seg] = true
+		for n, doc := range seg.docs {
+			if !seg.live(n) && (snapshots[ind] == nil || !snapshots[ind].has(n)) {
+				result.delete(numbers[doc.id])
+			}
+		}
+	}
+
+	segments := []*segment{}
+	for _, seg := range s.segments {
+		if !isMerged[seg] {
+			segments = append(segments, seg)
+		}
+	}
+	s.segments = append(segments, result)
+
+	for n, doc := range result.docs {
+		if result.live(n) {
+			s.docs[doc.id] = docRef{seg: result, doc: n}
+		}
+	}
+
+	s.dirty = true
+	return true, s.commit()
+}
+
+// Flush commits the changes made since the last commit
+func (s *DiskStore) Flush() error {
+	return s.Commit()
+}
+
+// Close commits the pending changes and stops the background work. The store cannot be used afterwards
+func (s *DiskStore) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.wg.Wait()
+
+		s.Lock()
+		defer s.Unlock()
+		err = s.commit()
+		s.closed = true
+	})
+	return err
+}
+
+func segmentFileName(number int64) string {
+	return fmt.Sprintf("seg_%d.idx", number)
+}
+
+func deletionsFileName(segment string, generation int64) string {
+	return fmt.Sprintf("%s_%d.del", strings.TrimSuffix(segment, ".idx"), generation)
+}
+
+// isIndexFile tells whether name is the name of a file a disk store writes
+func isIndexFile(name string) bool {
+	return strings.HasPrefix(name, "seg_") && (strings.HasSuffix(name, ".idx") || strings.HasSuffix(name, ".del")) ||
+		name == manifestFileName+".tmp"
+}
+
+// writeFileSync writes data to the file name and flushes it to the disk
+func writeFileSync(name string, data []byte) error {
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+
+	if cer := f.Close(); err == nil {
+		err = cer
+	}
+	return err
+}
+
+// syncDir flushes the entries of dir to the disk, so that renamed files survive a crash
+func syncDir(dir string) error {
+	f, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+
+	err = f.Sync()
+	if cer := f.Close(); err == nil {
+		err = cer
+	}
+	return err
+}
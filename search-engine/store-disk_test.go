@@ -0,0 +1,593 @@
+package se
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/omecodes/bome"
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func newTestDiskStore(dir string, opts DiskStoreOptions) *DiskStore {
+	store, err := NewDiskIndexStore(dir, opts)
+	So(err, ShouldBeNil)
+	return store
+}
+
+// feedTestFixture indexes the same texts, numbers and properties in each engine
+func feedTestFixture(engines ...*Engine) {
+	texts := []*pb.TextMapping{
+		{ObjectId: "p1", Name: "bio", Analyzer: EnglishAnalyzer, Text: "Paulo plays for Juventus and runs fast in the rain"},
+		{ObjectId: "p1", Name: "club", Text: "juventus turin"},
+		{ObjectId: "p2", Name: "bio", Analyzer: EnglishAnalyzer, Text: "Cristiano played for Juventus before running back to Manchester"},
+		{ObjectId: "p2", Name: "club", Text: "manchester united"},
+		{ObjectId: "p3", Name: "bio", Analyzer: EnglishAnalyzer, Text: "Kylian runs faster than the wind, the fastest player of Paris"},
+		{ObjectId: "p3", Name: "club", Text: "paris saint germain"},
+		{ObjectId: "p3", Name: "notes", Text: "paris paris paris"},
+		{ObjectId: "p4", Name: "resume", Analyzer: FrenchAnalyzer, Text: "Le joueur a quitté Paris pour Madrid"},
+		{ObjectId: "p4", Name: "club", Text: "real madrid"},
+		{ObjectId: "p5", Name: "notes", Text: "retired"},
+	}
+	props := map[string]string{
+		"p1": `{"name": "paulo", "age": 27, "club": "juventus", "active": true, "tags": ["Fast", "captain"], "scores": [7, 10.5]}`,
+		"p2": `{"name": "cristiano", "age": 36, "club": "man_utd", "active": true, "tags": ["captain", "veteran", null], "scores": [9]}`,
+		"p3": `{"name": "kylian", "age": 22, "club": "psg", "active": false, "tags": ["fast", "young"], "scores": 8}`,
+		"p4": `{"name": "karim", "age": 33.5, "club": "real", "tags": []}`,
+		"p5": `{"name": "zinedine", "club": null}`,
+	}
+	numbers := map[string][]float64{"p1": {27, 10.5}, "p2": {36, 7}, "p3": {22, 7}, "p4": {-3}}
+	points := map[string][]*pb.GeoPoint{
+		"p1": {{Lat: 45.0705, Lon: 7.6868}},
+		"p2": {{Lat: 53.4631, Lon: -2.2913}, {Lat: 32.6669, Lon: -16.9241}},
+		"p3": {{Lat: 48.8414, Lon: 2.253}},
+		"p4": {{Lat: 40.4531, Lon: -3.6883}},
+		"p5": {{Lat: -17.7134, Lon: 178.065}},
+	}
+
+	for _, engine := range engines {
+		for _, m := range texts {
+			So(engine.CreateTextMapping(m), ShouldBeNil)
+		}
+		for id, value := range props {
+			So(engine.CreatePropertiesMapping(&pb.PropertiesMapping{ObjectId: id, Json: value}), ShouldBeNil)
+		}
+		for id, nums := range numbers {
+			for _, num := range nums {
+				So(engine.CreateNumberMapping(&pb.NumberMapping{ObjectId: id, Number: num}), ShouldBeNil)
+			}
+		}
+		for id, list := range points {
+			for _, point := range list {
+				So(engine.CreateGeoMapping(&pb.GeoMapping{ObjectId: id, Point: point}), ShouldBeNil)
+			}
+		}
+	}
+}
+
+var testDiskStoreQueries = []string{
+	`$text = "juventus"`,
+	`$text contains "ar"`,
+	`$text startswith "par"`,
+	`$text endswith "ing"`,
+	`$text = "runs"`,
+	`$text = "the"`,
+	`$text = "joueurs"`,
+	`$text = "paris" or $text = "madrid"`,
+	`not $text = "paris"`,
+	`not ($text = "paris" or $text contains "ventu")`,
+	`$text = "fast" or not $text startswith "man"`,
+	`$text ~ "juventas"`,
+	`$text ~2 "cristano"`,
+	`$text ~ "zzzzzz"`,
+	`$text = "paris saint"`,
+	`$text phrase "saint paris"`,
+	`$text phrase "paris saint germain"`,
+	`$text phrase "paris paris"`,
+	`$text near 1 "paris germain"`,
+	`$text near 0 "germain paris"`,
+	`$text near 0 "paris paris paris"`,
+	`$number = 7`,
+	`$number > 20 and $number < 30`,
+	`not $number = 7`,
+	`not ($number > 0 or $number < -10)`,
+	`$number = 10.5`,
+	`$number >= 7.5`,
+	`age > 25`,
+	`age >= 22 and age < 33`,
+	`age = 33`,
+	`not age > 30`,
+	`active = 1`,
+	`active <= 0`,
+	`age > 33.2`,
+	`active = true`,
+	`active = false`,
+	`club = null`,
+	`club != null`,
+	`club exists`,
+	`not active exists`,
+	`club != "psg"`,
+	`club endswith "us" or name = "kylian"`,
+	`name startswith "k" and not age > 30`,
+	`name contains "i"`,
+	`age contains "3"`,
+	`club = "null"`,
+	`salary > 3`,
+	`tags = "fast"`,
+	`tags != "captain"`,
+	`tags startswith "v"`,
+	`tags = null`,
+	`tags exists`,
+	`scores > 9`,
+	`scores = 8 and tags = "young"`,
+	`tags in ("fast", "young")`,
+	`not tags in ("fast", "captain")`,
+	`tags any_of ("young", "veteran")`,
+	`tags all_of ("fast", "captain")`,
+	`tags all_of ("captain")`,
+	`scores in (7, 10.5, 9)`,
+	`scores any_of (8, 10.5)`,
+	`club in ("psg", "real")`,
+	`age in (27, 36)`,
+	`active any_of (false)`,
+	`active in (1)`,
+	`name in (true, "karim", 3)`,
+	`$geo within_radius (48.8566, 2.3522, 10000)`,
+	`$geo within_radius (48.8566, 2.3522, 1300000)`,
+	`$geo within_radius (0, 0, 20100000)`,
+	`$geo within_radius (-17, -179.5, 300000)`,
+	`$geo within_radius (89.9, 0, 100)`,
+	`$geo within_box (40, -5, 50, 10)`,
+	`$geo within_box (-20, 170, 0, -170)`,
+	`$geo within_box (30, -20, 60, 3) and not $geo within_radius (53.4808, -2.2426, 50000)`,
+	`$geo within_box (45.0705, 7.6868, 45.0705, 7.6868) or $geo within_radius (40.4168, -3.7038, 5000)`,
+	`not $geo within_box (-90, -180, 90, 180)`,
+	`$geo within_box (50, 0, 40, 10)`,
+	`$geo within_radius (48.8566, 2.3522, 0)`,
+}
+
+func searchHits(engine *Engine, query *pb.SearchQuery, opts SearchOptions) ([]string, error) {
+	hits, err := engine.Search(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []string
+	for _, hit := range hits {
+		results = append(results, fmt.Sprintf("%s:%.6f", hit.Id, hit.Score))
+	}
+	sort.Strings(results)
+	return results, nil
+}
+
+func TestDiskStore_Search(t *testing.T) {
+	Convey("Disk stores return the same results and scores as SQL stores", t, func() {
+		sqlEngine := NewEngine(newTestSQLStore())
+		disk := newTestDiskStore(t.TempDir(), DiskStoreOptions{MaxBufferedDocs: 2})
+		defer func() {
+			So(disk.Close(), ShouldBeNil)
+		}()
+		diskEngine := NewEngine(disk)
+		feedTestFixture(sqlEngine, diskEngine)
+
+		opts := SearchOptions{
+			Fields:    []string{"name", "age", "club", "active", "tags", "scores"},
+			Analyzers: map[string]string{"bio": EnglishAnalyzer, "resume": FrenchAnalyzer},
+			Boosts:    map[string]float64{"club": 2},
+		}
+
+		compare := func(query *pb.SearchQuery) bool {
+			expected, expectedErr := searchHits(sqlEngine, query, opts)
+			results, err := searchHits(diskEngine, query, opts)
+			So(err == nil, ShouldEqual, expectedErr == nil)
+			if err != nil {
+				So(errors.HTTPStatus(err), ShouldEqual, errors.HTTPStatus(expectedErr))
+			}
+			So(results, ShouldResemble, expected)
+			return err == nil && len(results) > 0
+		}
+
+		for _, text := range testDiskStoreQueries {
+			query, err := ParseQuery(text)
+			So(err, ShouldBeNil)
+			compare(query)
+		}
+
+		Convey("Random queries", func() {
+			fuzzer := &queryFuzzer{rand: rand.New(rand.NewSource(7))}
+			for i := 0; i < 500; i++ {
+				compare(&pb.SearchQuery{Query: &pb.SearchQuery_Text{Text: fuzzer.textQuery(1)}})
+				compare(&pb.SearchQuery{Query: &pb.SearchQuery_Number{Number: fuzzer.numberQuery(1)}})
+				compare(&pb.SearchQuery{Query: &pb.SearchQuery_Geo{Geo: fuzzer.geoQuery(1)}})
+			}
+		})
+
+		Convey("Facets", func() {
+			for _, text := range []string{`$number > -100`, `not $text = "paris"`, `age > 0 or name contains "z"`} {
+				query, err := ParseQuery(text)
+				So(err, ShouldBeNil)
+
+				for _, requests := range [][]string{{"club", "age", "active", "name:2", "$number", "tags", "scores"}, {"age:..25,25..", "$number:..8,8..", "scores:..8,8.."}} {
+					var facets []*pb.Facet
+					for _, f := range requests {
+						facet, err := ParseFacet(f)
+						So(err, ShouldBeNil)
+						facets = append(facets, facet)
+					}
+
+					expected, err := sqlEngine.Facets(query, opts, facets)
+					So(err, ShouldBeNil)
+					results, err := diskEngine.Facets(query, opts, facets)
+					So(err, ShouldBeNil)
+					So(results, ShouldResemble, expected)
+				}
+			}
+		})
+
+		Convey("Indexed tokens, postings and explanations are the same", func() {
+			tokens, err := sqlEngine.ObjectTokens("p3")
+			So(err, ShouldBeNil)
+			So(tokens, ShouldHaveLength, 3)
+			So(tokens[2].Field, ShouldEqual, "notes")
+			So(tokens[2].Length, ShouldEqual, 3)
+			So(tokens[2].Tokens, ShouldHaveLength, 1)
+			So(tokens[2].Tokens[0].Token, ShouldEqual, "paris")
+			So(tokens[2].Tokens[0].Tf, ShouldEqual, 3)
+			So(tokens[2].Tokens[0].Positions, ShouldResemble, []int64{0, 1, 2})
+
+			diskTokens, err := diskEngine.ObjectTokens("p3")
+			So(err, ShouldBeNil)
+			So(fmt.Sprint(diskTokens), ShouldEqual, fmt.Sprint(tokens))
+
+			tokens, err = diskEngine.ObjectTokens("p0")
+			So(err, ShouldBeNil)
+			So(tokens, ShouldBeEmpty)
+
+			for _, opts := range []TokenDocumentsOptions{{}, {Field: "club"}, {Count: 1}, {After: "p1"}} {
+				postings, err := sqlEngine.TokenDocuments("juventus", opts)
+				So(err, ShouldBeNil)
+				So(postings, ShouldNotBeEmpty)
+
+				diskPostings, err := diskEngine.TokenDocuments("juventus", opts)
+				So(err, ShouldBeNil)
+				So(fmt.Sprint(diskPostings), ShouldEqual, fmt.Sprint(postings))
+			}
+
+			postings, err := diskEngine.TokenDocuments("juventus", TokenDocumentsOptions{Count: 1})
+			So(err, ShouldBeNil)
+			So(postings, ShouldHaveLength, 2)
+			So(postings[0].Id, ShouldEqual, "p1")
+			So(postings[0].Field, ShouldEqual, "bio")
+			So(postings[1].Field, ShouldEqual, "club")
+
+			_, err = diskEngine.TokenDocuments("juventus", TokenDocumentsOptions{Count: MaxTokenDocuments + 1})
+			So(errors.HTTPStatus(err), ShouldEqual, http.StatusBadRequest)
+
+			for _, text := range []string{`$text = "paris" or $text contains "juv"`, `not $text = "paris"`, `$number > 20`} {
+				query, err := ParseQuery(text)
+				So(err, ShouldBeNil)
+
+				hits, err := sqlEngine.Search(query, opts)
+				So(err, ShouldBeNil)
+
+				explanation, err := sqlEngine.Explain(query, opts)
+				So(err, ShouldBeNil)
+				So(explanation.Sql, ShouldNotBeEmpty)
+				So(explanation.Sql, ShouldNotContainSubstring, "$prefix$")
+				So(int(explanation.HitsCount), ShouldEqual, len(hits))
+
+				diskExplanation, err := diskEngine.Explain(query, opts)
+				So(err, ShouldBeNil)
+				So(diskExplanation.Sql, ShouldBeEmpty)
+				So(fmt.Sprint(diskExplanation.Conditions), ShouldEqual, fmt.Sprint(explanation.Conditions))
+				So(diskExplanation.Hits, ShouldHaveLength, len(explanation.Hits))
+
+				for ind, hit := range explanation.Hits {
+					So(hit.Id, ShouldEqual, hits[ind].Id)
+					So(hit.Score, ShouldAlmostEqual, hits[ind].Score)
+					So(diskExplanation.Hits[ind].Id, ShouldEqual, hit.Id)
+					So(diskExplanation.Hits[ind].Score, ShouldAlmostEqual, hit.Score)
+					So(diskExplanation.Hits[ind].Terms, ShouldHaveLength, len(hit.Terms))
+				}
+			}
+		})
+
+		Convey("Mapped ids are listed by batches", func() {
+			for _, engine := range []*Engine{sqlEngine, diskEngine} {
+				var all []string
+				for after := ""; ; {
+					ids, err := engine.MappedIDs(after, 2)
+					So(err, ShouldBeNil)
+					if len(ids) == 0 {
+						break
+					}
+					all = append(all, ids...)
+					after = ids[len(ids)-1]
+				}
+				So(all, ShouldResemble, []string{"p1", "p2", "p3", "p4", "p5"})
+
+				unmapped, err := engine.UnmappedIDs([]string{"p6", "p2", "p0"})
+				So(err, ShouldBeNil)
+				So(unmapped, ShouldResemble, []string{"p6", "p0"})
+
+				So(engine.DeleteObjectMappings("p4"), ShouldBeNil)
+				ids, err := engine.MappedIDs("p2", 10)
+				So(err, ShouldBeNil)
+				So(ids, ShouldResemble, []string{"p3", "p5"})
+			}
+		})
+
+		Convey("Hits are sorted by distance to an origin", func() {
+			query, err := ParseQuery(`$text = "paris" or $text = "madrid" or $text = "juventus" or $text = "retired"`)
+			So(err, ShouldBeNil)
+
+			sorted := opts
+			sorted.Origin = &pb.GeoPoint{Lat: 48.8566, Lon: 2.3522}
+			for _, engine := range []*Engine{sqlEngine, diskEngine} {
+				hits, err := engine.Search(query, sorted)
+				So(err, ShouldBeNil)
+
+				var ids []string
+				for _, hit := range hits {
+					ids = append(ids, hit.Id)
+				}
+				So(ids, ShouldResemble, []string{"p3", "p1", "p2", "p4", "p5"})
+				So(hits[0].Distance, ShouldAlmostEqual, 7453, 10)
+				So(hits[2].Distance, ShouldBeLessThan, hits[3].Distance)
+			}
+
+			sorted.Origin = &pb.GeoPoint{Lat: 91}
+			_, err = sqlEngine.Search(query, sorted)
+			So(errors.HTTPStatus(err), ShouldEqual, http.StatusBadRequest)
+		})
+
+		Convey("Updates and deletions are visible as soon as they are made", func() {
+			So(diskEngine.DeleteObjectMappings("p3"), ShouldBeNil)
+			So(sqlEngine.DeleteObjectMappings("p3"), ShouldBeNil)
+			So(diskEngine.CreateTextMapping(&pb.TextMapping{ObjectId: "p5", Name: "club", Text: "bordeaux"}), ShouldBeNil)
+			So(sqlEngine.CreateTextMapping(&pb.TextMapping{ObjectId: "p5", Name: "club", Text: "bordeaux"}), ShouldBeNil)
+
+			for _, text := range testDiskStoreQueries {
+				query, err := ParseQuery(text)
+				So(err, ShouldBeNil)
+				compare(query)
+			}
+		})
+	})
+}
+
+func TestDiskStore_Persistence(t *testing.T) {
+	Convey("Disk stores reopen the state of their last commit", t, func() {
+		dir := t.TempDir()
+		opts := DiskStoreOptions{MaxBufferedDocs: 2}
+
+		search := func(store Store, text string) []string {
+			query, err := ParseQuery(text)
+			So(err, ShouldBeNil)
+
+			hits, err := NewEngine(store).Search(query, SearchOptions{Fields: []string{"name", "age"}})
+			So(err, ShouldBeNil)
+
+			ids := hitIDs(hits)
+			sort.Strings(ids)
+			return ids
+		}
+
+		store := newTestDiskStore(dir, opts)
+		feedTestFixture(NewEngine(store))
+		So(store.DeleteObjectMappings("p2"), ShouldBeNil)
+		So(store.SavePropertiesMapping("p1", `{"name": "paulo", "age": 28}`), ShouldBeNil)
+		So(store.Close(), ShouldBeNil)
+
+		store = newTestDiskStore(dir, opts)
+		So(search(store, `$text = "juventus"`), ShouldResemble, []string{"p1"})
+		So(search(store, `age = 28`), ShouldResemble, []string{"p1"})
+		So(search(store, `$number = 7`), ShouldResemble, []string{"p3"})
+
+		Convey("Merged segments keep the live documents only", func() {
+			store.Lock()
+			store.opts.MergeFactor = 2
+			store.Unlock()
+			for {
+				_, err := store.merge()
+				So(err, ShouldBeNil)
+
+				// the background worker merges segments as well
+				store.Lock()
+				done := len(store.segments) < 2 && len(store.reserved) == 0
+				store.Unlock()
+				if done {
+					break
+				}
+			}
+			So(store.segments, ShouldHaveLength, 1)
+			So(search(store, `$text = "juventus"`), ShouldResemble, []string{"p1"})
+			So(search(store, `$number = 7`), ShouldResemble, []string{"p3"})
+			So(store.Close(), ShouldBeNil)
+
+			files, err := ioutil.ReadDir(dir)
+			So(err, ShouldBeNil)
+			var names []string
+			for _, file := range files {
+				names = append(names, file.Name())
+			}
+			So(names, ShouldHaveLength, 2)
+			So(names, ShouldContain, manifestFileName)
+
+			store = newTestDiskStore(dir, opts)
+			So(search(store, `age > 0`), ShouldResemble, []string{"p1", "p3", "p4"})
+			So(store.Close(), ShouldBeNil)
+		})
+
+		Convey("Files written by interrupted commits are ignored and removed", func() {
+			So(store.Close(), ShouldBeNil)
+
+			orphans := []string{segmentFileName(1000), manifestFileName + ".tmp"}
+			for _, name := range orphans {
+				So(ioutil.WriteFile(filepath.Join(dir, name), []byte("partial"), 0600), ShouldBeNil)
+			}
+
+			store = newTestDiskStore(dir, opts)
+			So(search(store, `$text = "juventus"`), ShouldResemble, []string{"p1"})
+			So(store.Close(), ShouldBeNil)
+
+			for _, name := range orphans {
+				_, err := os.Stat(filepath.Join(dir, name))
+				So(os.IsNotExist(err), ShouldBeTrue)
+			}
+		})
+
+		Convey("Corrupted segments are detected", func() {
+			So(store.Close(), ShouldBeNil)
+
+			files, err := ioutil.ReadDir(dir)
+			So(err, ShouldBeNil)
+			for _, file := range files {
+				if strings.HasSuffix(file.Name(), ".idx") {
+					name := filepath.Join(dir, file.Name())
+					data, err := ioutil.ReadFile(name)
+					So(err, ShouldBeNil)
+					data[len(data)/2] ^= 0xff
+					So(ioutil.WriteFile(name, data, 0600), ShouldBeNil)
+					break
+				}
+			}
+
+			_, err = NewDiskIndexStore(dir, opts)
+			So(errors.HTTPStatus(err) == http.StatusInternalServerError, ShouldBeTrue)
+		})
+	})
+}
+
+// benchmarkCorpus generates objects with a text, a number and properties drawn from a small vocabulary
+func benchmarkCorpus(size int) []*pb.TextMapping {
+	words := []string{"sea", "old", "man", "river", "league", "travel", "night", "city", "storm", "garden", "island", "winter", "letter", "house", "war", "peace"}
+	r := rand.New(rand.NewSource(1))
+
+	corpus := make([]*pb.TextMapping, size)
+	for i := range corpus {
+		text := make([]string, 10+r.Intn(20))
+		for j := range text {
+			text[j] = words[r.Intn(len(words))]
+		}
+		corpus[i] = &pb.TextMapping{ObjectId: fmt.Sprintf("o%d", i), Name: "title", Text: strings.Join(text, " ")}
+	}
+	return corpus
+}
+
+func benchmarkIndexing(b *testing.B, store Store) {
+	engine := NewEngine(store)
+	corpus := benchmarkCorpus(b.N)
+
+	b.ResetTimer()
+	for i, m := range corpus {
+		if err := engine.CreateTextMapping(m); err != nil {
+			b.Fatal(err)
+		}
+		if err := engine.CreateNumberMapping(&pb.NumberMapping{ObjectId: m.ObjectId, Number: float64(i % 100)}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkSearch(b *testing.B, store Store, text string) {
+	engine := NewEngine(store)
+	for i, m := range benchmarkCorpus(5000) {
+		if err := engine.CreateTextMapping(m); err != nil {
+			b.Fatal(err)
+		}
+		if err := engine.CreateNumberMapping(&pb.NumberMapping{ObjectId: m.ObjectId, Number: float64(i % 100)}); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	query, err := ParseQuery(text)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := engine.Search(query, SearchOptions{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func newBenchmarkSQLStore(b *testing.B) Store {
+	conn, err := sql.Open(bome.SQLite3, ":memory:")
+	if err != nil {
+		b.Fatal(err)
+	}
+	conn.SetMaxOpenConns(1)
+
+	store, err := NewSQLIndexStore(conn, bome.SQLite3, "bench_index")
+	if err != nil {
+		b.Fatal(err)
+	}
+	return store
+}
+
+func newBenchmarkDiskStore(b *testing.B) *DiskStore {
+	store, err := NewDiskIndexStore(b.TempDir(), DiskStoreOptions{})
+	if err != nil {
+		b.Fatal(err)
+	}
+	return store
+}
+
+func BenchmarkSQLStore_Indexing(b *testing.B) {
+	benchmarkIndexing(b, newBenchmarkSQLStore(b))
+}
+
+func BenchmarkDiskStore_Indexing(b *testing.B) {
+	store := newBenchmarkDiskStore(b)
+	defer func() {
+		_ = store.Close()
+	}()
+	benchmarkIndexing(b, store)
+}
+
+func BenchmarkSQLStore_SearchTerm(b *testing.B) {
+	benchmarkSearch(b, newBenchmarkSQLStore(b), `$text = "storm"`)
+}
+
+func BenchmarkDiskStore_SearchTerm(b *testing.B) {
+	store := newBenchmarkDiskStore(b)
+	defer func() {
+		_ = store.Close()
+	}()
+	benchmarkSearch(b, store, `$text = "storm"`)
+}
+
+func BenchmarkSQLStore_SearchPrefix(b *testing.B) {
+	benchmarkSearch(b, newBenchmarkSQLStore(b), `$text startswith "wi"`)
+}
+
+func BenchmarkDiskStore_SearchPrefix(b *testing.B) {
+	store := newBenchmarkDiskStore(b)
+	defer func() {
+		_ = store.Close()
+	}()
+	benchmarkSearch(b, store, `$text startswith "wi"`)
+}
+
+func BenchmarkSQLStore_SearchNumber(b *testing.B) {
+	benchmarkSearch(b, newBenchmarkSQLStore(b), `$number < 10`)
+}
+
+func BenchmarkDiskStore_SearchNumber(b *testing.B) {
+	store := newBenchmarkDiskStore(b)
+	defer func() {
+		_ = store.Close()
+	}()
+	benchmarkSearch(b, store, `$number < 10`)
+}
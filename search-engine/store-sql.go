@@ -4,19 +4,45 @@ import (
 	"database/sql"
 	"fmt"
 	"github.com/omecodes/errors"
+	"github.com/omecodes/libome/logs"
 	pb "github.com/omecodes/store/gen/go/proto"
-	"io"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/omecodes/bome"
 )
 
 const wordsTableName = "$prefix$_words"
 
+// legacyWordsTableName is the name the words table created before words were indexed by field is renamed to. Its
+// rows cannot be converted: the table is kept until the objects it indexed are indexed again
+const legacyWordsTableName = "$prefix$_words_legacy"
+
+const docsTableName = "$prefix$_docs"
+
+const gramsTableName = "$prefix$_grams"
+
+const positionsTableName = "$prefix$_positions"
+
 const numbersTableName = "$prefix$_numbers"
 
 const propsTableName = "$prefix$_props"
 
+const geoTableName = "$prefix$_geo"
+
+const (
+	postingScanner      = "posting"
+	fieldStatsScanner   = "field_stats"
+	facetBucketScanner  = "facet_bucket"
+	termDocsScanner     = "term_docs"
+	geoPointScanner     = "geo_point"
+	tokenValueScanner   = "token_value"
+	fieldLengthScanner  = "field_length"
+	tokenPostingScanner = "token_posting"
+)
+
 const propsTablesDef = `
 create table if not exists $prefix$_props (
   	object varchar(255) not null,
@@ -29,20 +55,157 @@ const wordsTablesDef = `
 create table if not exists $prefix$_words (
   	token varchar(255) not null,
     id varchar(255) not null,
-    primary key(token, id)
+    field varchar(255) not null,
+    tf int not null,
+    primary key(token, id, field)
+);
+`
+
+const docsTablesDef = `
+create table if not exists $prefix$_docs (
+  	id varchar(255) not null,
+    field varchar(255) not null,
+    length int not null,
+    primary key(id, field)
+);
+`
+
+// positionsTablesDef holds the position of every token in the indexed texts, used to evaluate phrase and proximity conditions
+const positionsTablesDef = `
+create table if not exists $prefix$_positions (
+  	id varchar(255) not null,
+    field varchar(255) not null,
+    pos int not null,
+    token varchar(255) not null,
+    primary key(id, field, pos)
+);
+`
+
+// gramsTablesDef holds the trigrams of the indexed tokens. It is the vocabulary fuzzy conditions look for candidate
+// tokens in: rows are never deleted with objects, candidates that are no longer indexed simply match no word
+const gramsTablesDef = `
+create table if not exists $prefix$_grams (
+  	gram varchar(16) not null,
+    token varchar(255) not null,
+    primary key(gram, token)
 );
 `
 
 const numbersTablesDef = `
 create table if not exists $prefix$_numbers (
-  	num bigInt not null,
+  	num double not null,
     id varchar(255) not null,
     primary key(num, id)
 );
 `
 
+// geoTablesDef holds the indexed points. Radius conditions select the candidate points by their geohash cell, first
+// in the primary key, then compare their position on the unit sphere to the one of the center
+const geoTablesDef = `
+create table if not exists $prefix$_geo (
+  	cell varchar(12) not null,
+    id varchar(255) not null,
+    lat double not null,
+    lon double not null,
+    x double not null,
+    y double not null,
+    z double not null,
+    primary key(cell, id, lat, lon)
+);
+`
+
+// widenNumbersColumn converts the integer numbers column of the mysql tables created before numbers were doubles. Sqlite
+// columns store doubles whatever their declared type
+const widenNumbersColumn = `
+alter table $prefix$_numbers modify num double not null;
+`
+
+const countWords = `
+select count(*) from $prefix$_words;
+`
+
+const countFieldWords = `
+select count(*) from $prefix$_words where field='';
+`
+
+const countLegacyWords = `
+select count(*) from $prefix$_words_legacy;
+`
+
+const renameLegacyWords = `
+alter table $prefix$_words rename to $prefix$_words_legacy;
+`
+
+const dropLegacyWords = `
+drop table if exists $prefix$_words_legacy;
+`
+
 const insertWord = `
-insert into $prefix$_words values(?, ?);
+insert into $prefix$_words values(?, ?, ?, ?);
+`
+
+const deleteFieldWords = `
+delete from $prefix$_words where id=? and field=?;
+`
+
+const deleteObjectWords = `
+delete from $prefix$_words where id=?;
+`
+
+const insertDoc = `
+insert into $prefix$_docs values(?, ?, ?);
+`
+
+const deleteFieldDoc = `
+delete from $prefix$_docs where id=? and field=?;
+`
+
+const deleteObjectDocs = `
+delete from $prefix$_docs where id=?;
+`
+
+const insertPosition = `
+insert into $prefix$_positions values(?, ?, ?, ?);
+`
+
+const deleteFieldPositions = `
+delete from $prefix$_positions where id=? and field=?;
+`
+
+const deleteObjectPositions = `
+delete from $prefix$_positions where id=?;
+`
+
+const insertGram = `
+insert into $prefix$_grams values(?, ?);
+`
+
+const selectFieldTermsDocs = `
+select field, token, count(*) from $prefix$_words where field=? group by field, token;
+`
+
+const selectFieldTerms = `
+select field, token, 1 from $prefix$_words where id=? and field=?;
+`
+
+const selectObjectTerms = `
+select field, token, 1 from $prefix$_words where id=?;
+`
+
+const selectObjectTokens = `
+select field, token, tf from $prefix$_words where id=?;
+`
+
+const selectObjectPositions = `
+select field, token, pos from $prefix$_positions where id=? order by field, pos;
+`
+
+const selectObjectLengths = `
+select field, length from $prefix$_docs where id=?;
+`
+
+const selectFieldStats = `
+select field, count(*), avg(length) from $prefix$_docs group by field;
 `
 
 const insertNumber = `
@@ -53,16 +216,24 @@ const deleteObjectNumberMapping = `
 delete from $prefix$_numbers where id=?;
 `
 
+const insertGeoPoint = `
+insert into $prefix$_geo values(?, ?, ?, ?, ?, ?, ?);
+`
+
+const deleteObjectGeoPoints = `
+delete from $prefix$_geo where id=?;
+`
+
 const insertProps = `
 insert into $prefix$_props values(?, ?);
 `
 
 const deleteProps = `
-delete from $prefix$_props where id=?;
+delete from $prefix$_props where object=?;
 `
 
 func NewSQLIndexStore(db *sql.DB, dialect string, tablePrefix string) (Store, error) {
-	s := new(sqlStore)
+	s := &sqlStore{dialect: dialect, tablePrefix: tablePrefix}
 	var err error
 
 	if dialect == bome.SQLite3 {
@@ -86,31 +257,430 @@ func NewSQLIndexStore(db *sql.DB, dialect string, tablePrefix string) (Store, er
 
 	s.db.SetTablePrefix(tablePrefix)
 
-	err = s.db.Exec(wordsTablesDef).Error
-	if err == nil {
-		err = s.db.Exec(numbersTablesDef).Error
-		if err == nil {
-			err = s.db.Exec(propsTablesDef).Error
+	s.db.RegisterScanner(postingScanner, bome.NewScannerFunc(func(row bome.Row) (interface{}, error) {
+		p := new(posting)
+		err := row.Scan(&p.token, &p.id, &p.field, &p.tf, &p.length)
+		return p, err
+	}))
+	s.db.RegisterScanner(fieldStatsScanner, bome.NewScannerFunc(func(row bome.Row) (interface{}, error) {
+		var field string
+		st := new(fieldStats)
+		err := row.Scan(&field, &st.docs, &st.avgLength)
+		return &fieldStatsEntry{field: field, stats: st}, err
+	}))
+
+	s.db.RegisterScanner(facetBucketScanner, bome.NewScannerFunc(func(row bome.Row) (interface{}, error) {
+		var key interface{}
+		b := new(pb.FacetBucket)
+		err := row.Scan(&key, &b.Count)
+		b.Key = bucketKey(key)
+		return b, err
+	}))
+
+	s.db.RegisterScanner(termDocsScanner, bome.NewScannerFunc(func(row bome.Row) (interface{}, error) {
+		t := new(termDocs)
+		err := row.Scan(&t.field, &t.term, &t.docs)
+		return t, err
+	}))
+
+	s.db.RegisterScanner(geoPointScanner, bome.NewScannerFunc(func(row bome.Row) (interface{}, error) {
+		p := &idGeoPoint{point: new(pb.GeoPoint)}
+		err := row.Scan(&p.id, &p.point.Lat, &p.point.Lon)
+		return p, err
+	}))
+
+	s.db.RegisterScanner(tokenValueScanner, bome.NewScannerFunc(func(row bome.Row) (interface{}, error) {
+		v := new(tokenValue)
+		err := row.Scan(&v.field, &v.token, &v.value)
+		return v, err
+	}))
+
+	s.db.RegisterScanner(fieldLengthScanner, bome.NewScannerFunc(func(row bome.Row) (interface{}, error) {
+		l := new(docLength)
+		err := row.Scan(&l.field, &l.length)
+		return l, err
+	}))
+
+	s.db.RegisterScanner(tokenPostingScanner, bome.NewScannerFunc(func(row bome.Row) (interface{}, error) {
+		p := new(pb.TokenPosting)
+		err := row.Scan(&p.Id, &p.Field, &p.Tf)
+		return p, err
+	}))
+
+	err = s.migrateWords()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, def := range []string{wordsTablesDef, docsTablesDef, positionsTablesDef, gramsTablesDef, numbersTablesDef, propsTablesDef, geoTablesDef} {
+		err = s.db.Exec(def).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if dialect == bome.MySQL {
+		err = s.db.Exec(widenNumbersColumn).Error
+		if err != nil {
+			return nil, err
 		}
 	}
-	return s, err
+	return s, nil
+}
+
+// migrateWords renames the words table created before words were indexed by field, which has no field column. The
+// current table is then created in its place and the objects must be indexed again
+func (s *sqlStore) migrateWords() error {
+	if _, err := s.db.QueryFirst(countLegacyWords, bome.IntScanner); err == nil {
+		s.legacyWords = true
+		return nil
+	}
+
+	if _, err := s.db.QueryFirst(countWords, bome.IntScanner); err != nil {
+		// no words table yet
+		return nil
+	}
+
+	if _, err := s.db.QueryFirst(countFieldWords, bome.IntScanner); err == nil {
+		return nil
+	}
+
+	err := s.db.Exec(renameLegacyWords).Error
+	if err != nil {
+		return err
+	}
+	logs.Info("search index words table must be rebuilt", logs.Details("index", s.tablePrefix))
+	s.legacyWords = true
+	return nil
+}
+
+// PendingReindex tells whether the words table was migrated from a layout whose rows could not be converted
+func (s *sqlStore) PendingReindex() bool {
+	return s.legacyWords
+}
+
+// Reindexed drops the words table renamed by the migration
+func (s *sqlStore) Reindexed() error {
+	err := s.db.Exec(dropLegacyWords).Error
+	if err != nil {
+		return err
+	}
+	s.legacyWords = false
+	return nil
+}
+
+// bucketKey returns the text of a counted value. Numbers are written without exponent, so that timestamps and
+// integers stored as doubles read as integers
+func bucketKey(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		return formatNumber(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case []byte:
+		return string(v)
+	case nil:
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+type fieldStatsEntry struct {
+	field string
+	stats *fieldStats
+}
+
+// termDocs is the number of objects a term is indexed for in a field
+type termDocs struct {
+	field string
+	term  string
+	docs  int64
+}
+
+// tokenValue is a value attached to a token of a text field: its frequency or one of its positions
+type tokenValue struct {
+	field string
+	token string
+	value int64
+}
+
+// docLength is the number of tokens a text field of an object counts for
+type docLength struct {
+	field  string
+	length int64
+}
+
+// sqlExecutor runs the statements of a change, on the database or in a transaction
+type sqlExecutor interface {
+	Exec(query string, args ...interface{}) bome.Result
 }
 
 type sqlStore struct {
-	db *bome.DB
+	db          *bome.DB
+	dialect     string
+	tablePrefix string
+
+	// legacyWords is set while the objects indexed in the words table renamed by migrateWords are not indexed again
+	legacyWords bool
+
+	// suggestions holds the terms of the fields listed in suggestLoaded with the time they were loaded from the words
+	// table. A field is loaded by the first suggestion request on it, kept up to date by the changes of text mappings,
+	// and loaded again once older than SuggestTermsMaxAge. suggestMutex guards them and serializes these changes
+	suggestMutex  sync.Mutex
+	suggestions   *suggestTree
+	suggestLoaded map[string]time.Time
 }
 
-func (s *sqlStore) SaveWordMapping(word string, id string) error {
-	err := s.db.Exec(insertWord, word, id).Error
+func (s *sqlStore) SaveTextMapping(id string, field string, tokens []string, terms ...string) (err error) {
+	s.suggestMutex.Lock()
+	defer s.suggestMutex.Unlock()
+	defer s.resetSuggestionsOnError(&err)
+
+	if _, loaded := s.suggestLoaded[field]; loaded {
+		err = s.updateSuggestions(-1, selectFieldTerms, id, field)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the mapping is replaced in a single transaction: a failure in the middle would leave the field partially indexed
+	tx, err := s.db.BeginTx()
 	if err != nil {
-		if errors.IsConflict(err) {
+		return err
+	}
+
+	err = s.replaceTextMapping(tx, id, field, tokens, terms)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			logs.Error("SaveTextMapping: rollback failed", logs.Err(rerr))
+		}
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	if _, loaded := s.suggestLoaded[field]; loaded {
+		s.suggestions.addFields(map[string][]string{field: append(append([]string{}, tokens...), terms...)}, 1)
+	}
+	return nil
+}
+
+// replaceTextMapping deletes the mapping of the field of the object then saves the new one with exec
+func (s *sqlStore) replaceTextMapping(exec sqlExecutor, id string, field string, tokens []string, terms []string) error {
+	for _, statement := range []string{deleteFieldWords, deleteFieldDoc, deleteFieldPositions} {
+		err := exec.Exec(statement, id, field).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	frequencies := map[string]int64{}
+	for pos, token := range tokens {
+		err := exec.Exec(insertPosition, id, field, pos, token).Error
+		if err != nil {
+			return err
+		}
+		frequencies[token]++
+	}
+	for _, term := range terms {
+		frequencies[term]++
+	}
+
+	for token, tf := range frequencies {
+		err := exec.Exec(insertWord, token, id, field, tf).Error
+		if err != nil {
+			return err
+		}
+
+		err = saveTrigrams(exec, token)
+		if err != nil {
+			return err
+		}
+	}
+	return exec.Exec(insertDoc, id, field, len(tokens)+len(terms)).Error
+}
+
+// resetSuggestionsOnError drops the prefix tree when a change failed, as it may no longer match the words table. The
+// fields are loaded again by the next suggestion requests
+func (s *sqlStore) resetSuggestionsOnError(err *error) {
+	if *err != nil {
+		s.suggestions = nil
+		s.suggestLoaded = nil
+	}
+}
+
+// updateSuggestions adds delta to the number of objects of the terms query selects, in the loaded fields
+func (s *sqlStore) updateSuggestions(delta int64, query string, params ...interface{}) error {
+	c, err := s.db.Query(query, termDocsScanner, params...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = c.Close()
+	}()
+
+	for c.HasNext() {
+		o, err := c.Next()
+		if err != nil {
+			return err
+		}
+		t := o.(*termDocs)
+		if _, loaded := s.suggestLoaded[t.field]; loaded {
+			s.suggestions.add(t.field, t.term, delta*t.docs)
+		}
+	}
+	return nil
+}
+
+// Suggest returns the terms of field that complete prefix from a prefix tree of the indexed terms. The terms of
+// field are loaded from the words table the first time, and again once older than SuggestTermsMaxAge, so that the
+// changes made by other processes sharing the tables are suggested
+func (s *sqlStore) Suggest(field string, prefix string, size int, maxDistance int) ([]*pb.Suggestion, error) {
+	s.suggestMutex.Lock()
+	defer s.suggestMutex.Unlock()
+
+	if s.suggestions == nil {
+		s.suggestions = newSuggestTree()
+		s.suggestLoaded = map[string]time.Time{}
+	}
+
+	loadedAt, loaded := s.suggestLoaded[field]
+	if !loaded || time.Since(loadedAt) > SuggestTermsMaxAge {
+		s.suggestions.clear(field)
+		s.suggestLoaded[field] = time.Now()
+
+		err := s.updateSuggestions(1, selectFieldTermsDocs, field)
+		if err != nil {
+			s.suggestions.clear(field)
+			delete(s.suggestLoaded, field)
+			return nil, err
+		}
+	}
+	return s.suggestions.suggest(field, prefix, size, maxDistance), nil
+}
+
+// saveTrigrams records the trigrams of token with exec. Trigrams shared with already indexed tokens are kept
+func saveTrigrams(exec sqlExecutor, token string) error {
+	for _, gram := range trigrams(token) {
+		err := exec.Exec(insertGram, gram, token).Error
+		if err != nil && !errors.IsConflict(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// fuzzyTerms returns the indexed tokens that are at most maxDistance edits away from term. Candidates sharing
+// enough trigrams with term, or of a close enough length when trigrams cannot discard any token, are verified
+// with the edit distance. Beyond MaxFuzzyExpansions tokens, the closest then most frequent ones are kept
+func (s *sqlStore) fuzzyTerms(term string, maxDistance int) ([]string, error) {
+	var (
+		query  string
+		params []interface{}
+	)
+
+	if shared := minSharedTrigrams(term, maxDistance); shared > 0 {
+		grams := trigrams(term)
+		query = "select token from " + gramsTableName + " where gram in (" + placeholders(len(grams)) + ") group by token having count(*) >= ?"
+		for _, gram := range grams {
+			params = append(params, gram)
+		}
+		params = append(params, shared)
+	} else {
+		length := "length"
+		if s.dialect == bome.MySQL {
+			length = "char_length"
+		}
+		n := len([]rune(term))
+		query = "select distinct token from " + wordsTableName + " where " + length + "(token) between ? and ?"
+		params = append(params, n-maxDistance, n+maxDistance)
+	}
+
+	c, err := s.db.Query(query, bome.StringScanner, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = c.Close()
+	}()
+
+	var variants []*fuzzyVariant
+	for c.HasNext() {
+		o, err := c.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		token := o.(string)
+		if distance := editDistance(term, token); distance <= maxDistance {
+			variants = append(variants, &fuzzyVariant{token: token, distance: distance})
+		}
+	}
+
+	if len(variants) <= MaxFuzzyExpansions {
+		tokens := make([]string, len(variants))
+		for ind, v := range variants {
+			tokens[ind] = v.token
+		}
+		return tokens, nil
+	}
+
+	err = s.countVariantDocs(variants)
+	if err != nil {
+		return nil, err
+	}
+	return closestVariants(variants), nil
+}
+
+// countVariantDocs sets the number of objects each variant is indexed for, looked up by batches of MaxListValues
+func (s *sqlStore) countVariantDocs(variants []*fuzzyVariant) error {
+	byToken := map[string]*fuzzyVariant{}
+	for _, v := range variants {
+		byToken[v.token] = v
+	}
+
+	for start := 0; start < len(variants); start += MaxListValues {
+		end := start + MaxListValues
+		if end > len(variants) {
+			end = len(variants)
+		}
+
+		params := make([]interface{}, 0, end-start)
+		for _, v := range variants[start:end] {
+			params = append(params, v.token)
+		}
+
+		query := "select '', token, count(distinct id) from " + wordsTableName + " where token in (" + placeholders(len(params)) + ") group by token"
+		err := func() error {
+			c, err := s.db.Query(query, termDocsScanner, params...)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = c.Close()
+			}()
+
+			for c.HasNext() {
+				o, err := c.Next()
+				if err != nil {
+					return err
+				}
+				t := o.(*termDocs)
+				byToken[t.term].docs = t.docs
+			}
 			return nil
+		}()
+		if err != nil {
+			return err
 		}
 	}
-	return err
+	return nil
 }
 
-func (s *sqlStore) SaveNumberMapping(num int64, id string) error {
+func (s *sqlStore) SaveNumberMapping(num float64, id string) error {
 	err := s.db.Exec(insertNumber, num, id).Error
 	if err != nil {
 		if errors.IsConflict(err) {
@@ -120,8 +690,9 @@ func (s *sqlStore) SaveNumberMapping(num int64, id string) error {
 	return err
 }
 
-func (s *sqlStore) SavePropertiesMapping(id string, value string) error {
-	err := s.db.Exec(insertProps, id, value).Error
+func (s *sqlStore) SaveGeoMapping(id string, point *pb.GeoPoint) error {
+	x, y, z := geoVector(point.Lat, point.Lon)
+	err := s.db.Exec(insertGeoPoint, geohash(point.Lat, point.Lon, geohashPrecision), id, point.Lat, point.Lon, x, y, z).Error
 	if err != nil {
 		if errors.IsConflict(err) {
 			return nil
@@ -130,198 +701,487 @@ func (s *sqlStore) SavePropertiesMapping(id string, value string) error {
 	return err
 }
 
-func (s *sqlStore) DeleteObjectMappings(id string) error {
-	err := s.db.Exec(deleteObjectNumberMapping, id).Error
-	if err == nil {
-		err = s.db.Exec(deleteObjectNumberMapping, id).Error
-		if err == nil {
-			s.db.Exec(deleteProps, id)
+// idGeoPoint is a point indexed for the object id
+type idGeoPoint struct {
+	id    string
+	point *pb.GeoPoint
+}
+
+// geoPointsBatchSize is the number of objects whose points are loaded by a single query
+const geoPointsBatchSize = 500
+
+func (s *sqlStore) GeoPoints(ids []string) (map[string][]*pb.GeoPoint, error) {
+	points := map[string][]*pb.GeoPoint{}
+	for start := 0; start < len(ids); start += geoPointsBatchSize {
+		batch := ids[start:]
+		if len(batch) > geoPointsBatchSize {
+			batch = batch[:geoPointsBatchSize]
+		}
+
+		params := make([]interface{}, len(batch))
+		for ind, id := range batch {
+			params[ind] = id
+		}
+
+		c, err := s.db.Query("select id, lat, lon from "+geoTableName+" where id in ("+placeholders(len(batch))+")", geoPointScanner, params...)
+		if err != nil {
+			return nil, err
+		}
+
+		for c.HasNext() {
+			o, err := c.Next()
+			if err != nil {
+				_ = c.Close()
+				return nil, err
+			}
+			p := o.(*idGeoPoint)
+			points[p.id] = append(points[p.id], p.point)
+		}
+		if err = c.Close(); err != nil {
+			return nil, err
 		}
 	}
-	return err
+	return points, nil
 }
 
-func (s *sqlStore) Search(query *pb.SearchQuery) (Cursor, error) {
-	return s.performSearch(query)
+// mappingTables are the tables that hold object mappings, with the column of their object ids
+var mappingTables = [][2]string{
+	{wordsTableName, "id"},
+	{docsTableName, "id"},
+	{positionsTableName, "id"},
+	{numbersTableName, "id"},
+	{propsTableName, "object"},
+	{geoTableName, "id"},
 }
 
-func (s *sqlStore) performSearch(query *pb.SearchQuery) (Cursor, error) {
-	switch q := query.Query.(type) {
+func (s *sqlStore) MappedIDs(after string, count int) ([]string, error) {
+	var (
+		selects []string
+		params  []interface{}
+	)
+	for _, table := range mappingTables {
+		selects = append(selects, "select "+table[1]+" as id from "+table[0]+" where "+table[1]+" > ?")
+		params = append(params, after)
+	}
+	params = append(params, count)
 
-	case *pb.SearchQuery_Text:
-		expr, scorers := evaluateWordSearchingQuery(q.Text)
-		sqlQuery := "select * from " + wordsTableName + " where " + expr
-		c, err := s.db.Query(sqlQuery, bome.MapEntryScanner)
+	c, err := s.db.Query("select id from ("+strings.Join(selects, " union ")+") as m order by id limit ?", bome.StringScanner, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = c.Close()
+	}()
+
+	var ids []string
+	for c.HasNext() {
+		o, err := c.Next()
 		if err != nil {
 			return nil, err
 		}
+		ids = append(ids, o.(string))
+	}
+	return ids, nil
+}
 
-		if len(scorers) <= 1 {
-			return &dbMapEntryCursorWrapper{cursor: c}, nil
+// UnmappedIDs looks for the ids in each mapping table, by batches of MappingScanBatchSize ids
+func (s *sqlStore) UnmappedIDs(ids []string) ([]string, error) {
+	mapped := map[string]bool{}
+	for start := 0; start < len(ids); start += MappingScanBatchSize {
+		batch := ids[start:]
+		if len(batch) > MappingScanBatchSize {
+			batch = batch[:MappingScanBatchSize]
 		}
 
-		defer func() {
-			_ = c.Close()
-		}()
-
-		scorers = append(scorers, presenceScorer)
-		records := &scoreRecords{}
+		params := make([]interface{}, len(batch))
+		for ind, id := range batch {
+			params[ind] = id
+		}
 
-		for c.HasNext() {
-			o, err := c.Next()
+		for _, table := range mappingTables {
+			c, err := s.db.Query("select distinct "+table[1]+" from "+table[0]+" where "+table[1]+" in ("+placeholders(len(batch))+")", bome.StringScanner, params...)
 			if err != nil {
-				if err == io.EOF {
-					break
-				}
 				return nil, err
 			}
 
-			entry := o.(*bome.MapEntry)
-			for _, scorer := range scorers {
-				scorer(entry.Key, entry.Value, records)
+			for c.HasNext() {
+				o, err := c.Next()
+				if err != nil {
+					_ = c.Close()
+					return nil, err
+				}
+				mapped[o.(string)] = true
+			}
+			if err = c.Close(); err != nil {
+				return nil, err
 			}
 		}
-
-		return &idListCursor{ids: records.sorted(), pos: 0}, err
-
-	case *pb.SearchQuery_Number:
-		sqlQuery := "select id from " + numbersTableName + " where " + evaluateNumberSearchingQuery(q.Number)
-		c, err := s.db.Query(sqlQuery, bome.StringScanner)
-		return &aggregatedStrIdsCursor{cursor: c}, err
-
-	case *pb.SearchQuery_Fields:
-		sqlQuery := "select id from " + propsTableName + " where " + evaluatePropertiesSearchingQuery(q.Fields)
-		c, err := s.db.Query(sqlQuery, bome.StringScanner)
-		return &dbStringCursorWrapper{cursor: c}, err
 	}
 
-	return nil, errors.Unsupported("sql dialect not supported", errors.Details{Key: "type", Value: "query"}, errors.Details{Key: "name", Value: query.Query})
+	var unmapped []string
+	for _, id := range ids {
+		if !mapped[id] {
+			unmapped = append(unmapped, id)
+		}
+	}
+	return unmapped, nil
 }
 
-func evaluateWordSearchingQuery(query *pb.StrQuery) (string, []tokenMatchScorer) {
-	var matchScorers []tokenMatchScorer
-	textAnalyzer := getQueryTextAnalyzer()
+func (s *sqlStore) ObjectTokens(id string) ([]*pb.FieldTokens, error) {
+	lengths, err := s.list(selectObjectLengths, fieldLengthScanner, id)
+	if err != nil {
+		return nil, err
+	}
 
-	switch v := query.Bool.(type) {
+	var fields []*pb.FieldTokens
+	byField := map[string]*pb.FieldTokens{}
+	for _, o := range lengths {
+		l := o.(*docLength)
+		byField[l.field] = &pb.FieldTokens{Field: l.field, Length: l.length}
+		fields = append(fields, byField[l.field])
+	}
 
-	case *pb.StrQuery_Or:
-		var evaluatedExpression []string
-		for _, ox := range v.Or.Queries {
-			expr, scorers := evaluateWordSearchingQuery(ox)
-			evaluatedExpression = append(evaluatedExpression, expr)
-			matchScorers = append(matchScorers, scorers...)
+	tokens := map[string]map[string]*pb.IndexedToken{}
+	frequencies, err := s.list(selectObjectTokens, tokenValueScanner, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range frequencies {
+		v := o.(*tokenValue)
+		ft := byField[v.field]
+		if ft == nil {
+			continue
 		}
-		return fmt.Sprintf("(%s)", strings.Join(evaluatedExpression, " OR ")), matchScorers
+		if tokens[v.field] == nil {
+			tokens[v.field] = map[string]*pb.IndexedToken{}
+		}
+		t := &pb.IndexedToken{Token: v.token, Tf: v.value}
+		tokens[v.field][v.token] = t
+		ft.Tokens = append(ft.Tokens, t)
+	}
 
-	case *pb.StrQuery_Contains:
-		value := textAnalyzer(v.Contains.Value)
-		matchScorers = append(matchScorers, containsScorer(value))
-		return "(token like '%" + value + "%')", matchScorers
+	positions, err := s.list(selectObjectPositions, tokenValueScanner, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range positions {
+		v := o.(*tokenValue)
+		if t := tokens[v.field][v.token]; t != nil {
+			t.Positions = append(t.Positions, v.value)
+		}
+	}
+
+	for _, ft := range fields {
+		sortIndexedTokens(ft.Tokens)
+	}
+	sortFieldTokens(fields)
+	return fields, nil
+}
+
+// TokenDocuments selects the ids of a page of the objects indexed with token in a derived table, as mysql does not
+// limit the rows of subqueries of in conditions
+func (s *sqlStore) TokenDocuments(token string, field string, after string, count int) ([]*pb.TokenPosting, error) {
+	condition, outerCondition := "token = ?", "w.token = ?"
+	params := []interface{}{token}
+	if field != "" {
+		condition += " and field = ?"
+		outerCondition += " and w.field = ?"
+		params = append(params, field)
+	}
 
-	case *pb.StrQuery_StartsWith:
-		value := textAnalyzer(v.StartsWith.Value)
-		matchScorers = append(matchScorers, startsWithScorer(value))
-		return "(token like '" + value + "%')", matchScorers
+	query := "select w.id, w.field, w.tf from " + wordsTableName + " as w join (select distinct id from " + wordsTableName + " where " + condition + " and id > ? order by id limit ?) as d on d.id = w.id where " + outerCondition + " order by w.id, w.field"
+	params = append(append(append([]interface{}{}, params...), after, count), params...)
 
-	case *pb.StrQuery_EndsWith:
-		value := textAnalyzer(v.EndsWith.Value)
-		matchScorers = append(matchScorers, endsWithScorer(value))
-		return "(token='%" + textAnalyzer(v.EndsWith.Value) + "')", matchScorers
+	rows, err := s.list(query, tokenPostingScanner, params...)
+	if err != nil {
+		return nil, err
+	}
 
-	case *pb.StrQuery_Eq:
-		value := textAnalyzer(v.Eq.Value)
-		matchScorers = append(matchScorers, equalsScorer(value))
-		return "(token='" + textAnalyzer(v.Eq.Value) + "')", matchScorers
+	postings := make([]*pb.TokenPosting, len(rows))
+	for ind, o := range rows {
+		postings[ind] = o.(*pb.TokenPosting)
 	}
-	return "", nil
+	return postings, nil
 }
 
-func evaluateNumberSearchingQuery(query *pb.NumQuery) string {
-	switch v := query.Bool.(type) {
+// Explain compiles query and evaluates it like Search. The explained SQL refers to the index tables by their name
+func (s *sqlStore) Explain(query *pb.SearchQuery, opts SearchOptions) (*pb.SearchExplanation, error) {
+	compiled, err := compileQuery(s.dialect, query, opts, s.fuzzyTerms)
+	if err != nil {
+		return nil, err
+	}
 
-	case *pb.NumQuery_And:
-		var evaluatedExpression []string
-		for _, ox := range v.And.Queries {
-			evaluatedExpression = append(evaluatedExpression, evaluateNumberSearchingQuery(ox))
+	explanation := &pb.SearchExplanation{Sql: strings.Replace(compiled.SQL, bome.VarPrefix, s.tablePrefix, -1)}
+	for _, param := range compiled.Params {
+		explanation.Params = append(explanation.Params, fmt.Sprint(param))
+	}
+
+	if _, ok := query.Query.(*pb.SearchQuery_Text); ok {
+		postings, err := s.loadPostings(compiled)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Sprintf("(%s)", strings.Join(evaluatedExpression, " AND "))
 
-	case *pb.NumQuery_Or:
-		var evaluatedExpression []string
-		for _, ox := range v.Or.Queries {
-			evaluatedExpression = append(evaluatedExpression, evaluateNumberSearchingQuery(ox))
+		stats, err := s.loadFieldStats()
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Sprintf("(%s)", strings.Join(evaluatedExpression, " OR "))
+		explanation.Hits, explanation.HitsCount = explainTerms(bm25Terms(postings, compiled.matchers, stats, opts.Boosts))
+		return explanation, nil
+	}
+
+	rows, err := s.list(compiled.ids, bome.StringScanner, compiled.Params...)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(rows))
+	for ind, o := range rows {
+		ids[ind] = o.(string)
+	}
+	explanation.Hits, explanation.HitsCount = explainIDs(ids)
+	return explanation, nil
+}
 
-	case *pb.NumQuery_Eq:
-		return fmt.Sprintf("(num=%d)", v.Eq.Value)
+// list returns all the rows query selects, scanned with scanner
+func (s *sqlStore) list(query string, scanner string, params ...interface{}) ([]interface{}, error) {
+	c, err := s.db.Query(query, scanner, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = c.Close()
+	}()
 
-	case *pb.NumQuery_Gt:
-		return fmt.Sprintf("(num>%d)", v.Gt.Value)
+	var rows []interface{}
+	for c.HasNext() {
+		o, err := c.Next()
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, o)
+	}
+	return rows, nil
+}
 
-	case *pb.NumQuery_Gte:
-		return fmt.Sprintf("(num>=%d)", v.Gte.Value)
+func (s *sqlStore) SavePropertiesMapping(id string, value string) error {
+	err := s.db.Exec(insertProps, id, value).Error
+	if err != nil {
+		if errors.IsConflict(err) {
+			return nil
+		}
+	}
+	return err
+}
 
-	case *pb.NumQuery_Lt:
-		return fmt.Sprintf("(num<%d)", v.Lt.Value)
+// Flush returns immediately: changes are committed to the database before the calls that made them return
+func (s *sqlStore) Flush() error {
+	return nil
+}
 
-	case *pb.NumQuery_Lte:
-		return fmt.Sprintf("(num<=%d)", v.Lte.Value)
+func (s *sqlStore) DeleteObjectMappings(id string) (err error) {
+	s.suggestMutex.Lock()
+	defer s.suggestMutex.Unlock()
+	defer s.resetSuggestionsOnError(&err)
+
+	if len(s.suggestLoaded) > 0 {
+		err = s.updateSuggestions(-1, selectObjectTerms, id)
+		if err != nil {
+			return err
+		}
 	}
 
-	return ""
+	for _, statement := range []string{deleteObjectWords, deleteObjectDocs, deleteObjectPositions, deleteObjectNumberMapping, deleteProps, deleteObjectGeoPoints} {
+		err := s.db.Exec(statement, id).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func evaluatePropertiesSearchingQuery(query *pb.FieldQuery) string {
-	textAnalyzer := propsMappingTextAnalyzer()
+func (s *sqlStore) Search(query *pb.SearchQuery, opts SearchOptions) (Cursor, error) {
+	compiled, err := compileQuery(s.dialect, query, opts, s.fuzzyTerms)
+	if err != nil {
+		return nil, err
+	}
 
-	switch v := query.Bool.(type) {
+	switch query.Query.(type) {
 
-	case *pb.FieldQuery_And:
-		var evaluatedExpression []string
-		for _, ox := range v.And.Queries {
-			evaluatedExpression = append(evaluatedExpression, evaluatePropertiesSearchingQuery(ox))
+	case *pb.SearchQuery_Text:
+		postings, err := s.loadPostings(compiled)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Sprintf("(%s)", strings.Join(evaluatedExpression, " AND "))
 
-	case *pb.FieldQuery_Or:
-		var evaluatedExpression []string
-		for _, ox := range v.Or.Queries {
-			evaluatedExpression = append(evaluatedExpression, evaluatePropertiesSearchingQuery(ox))
+		stats, err := s.loadFieldStats()
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Sprintf("(%s)", strings.Join(evaluatedExpression, " OR "))
+		return &hitListCursor{hits: bm25(postings, compiled.matchers, stats, opts.Boosts)}, nil
+
+	case *pb.SearchQuery_Number, *pb.SearchQuery_Geo:
+		c, err := s.db.Query(compiled.SQL, bome.StringScanner, compiled.Params...)
+		return &aggregatedStrIdsCursor{cursor: c}, err
+
+	default:
+		c, err := s.db.Query(compiled.SQL, bome.StringScanner, compiled.Params...)
+		return &dbStringCursorWrapper{cursor: c}, err
+	}
+}
+
+func (s *sqlStore) Facets(query *pb.SearchQuery, opts SearchOptions, facets []*pb.Facet) ([]*pb.FacetResult, error) {
+	err := validateFacets(facets, opts)
+	if err != nil {
+		return nil, err
+	}
 
-	case *pb.FieldQuery_Contains:
-		return fmt.Sprintf("(value->>'$.%s' like '%%%s%%')", v.Contains.Field, escape(textAnalyzer(v.Contains.Value)))
+	compiled, err := compileQuery(s.dialect, query, opts, s.fuzzyTerms)
+	if err != nil {
+		return nil, err
+	}
 
-	case *pb.FieldQuery_StartsWith:
-		return fmt.Sprintf("(value->>'$.%s' like '%s%%')", v.StartsWith.Field, escape(textAnalyzer(v.StartsWith.Value)))
+	var results []*pb.FacetResult
+	for _, facet := range facets {
+		result := &pb.FacetResult{Name: facetName(facet)}
+		if len(facet.Ranges) > 0 {
+			result.Buckets, err = s.rangeBuckets(compiled, facet)
+		} else {
+			result.Buckets, err = s.termBuckets(compiled, facet)
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
 
-	case *pb.FieldQuery_EndsWith:
-		return fmt.Sprintf("(value->>'$.%s' like '%s%%')", v.EndsWith.Field, escape(textAnalyzer(v.EndsWith.Value)))
+// termBuckets counts the most frequent values of the facet field among the objects selected by compiled
+func (s *sqlStore) termBuckets(compiled *CompiledQuery, facet *pb.Facet) ([]*pb.FacetBucket, error) {
+	size := int(facet.Size)
+	if size == 0 {
+		size = DefaultFacetSize
+	}
 
-	case *pb.FieldQuery_StrEqual:
-		return fmt.Sprintf("(value->>'$.%s'='%s')", v.StrEqual.Field, escape(textAnalyzer(v.StrEqual.Value)))
+	var (
+		query  string
+		params []interface{}
+	)
+	if facet.Field == NumberField {
+		query = "select num, count(*) from " + numbersTableName + " where id in (" + compiled.ids + ") group by num order by count(*) desc, num limit ?"
+	} else {
+		// each element of array values is counted
+		if s.dialect == bome.MySQL {
+			// json null values are not sql nulls in mysql
+			values := "select e.v as v from " + propsTableName + ", " + mysqlElementsTable + " where object in (" + compiled.ids + ")"
+			query = "select json_unquote(v) as k, count(*) from (" + values + ") as f where json_type(v) <> 'NULL' group by k order by count(*) desc, k limit ?"
+		} else {
+			values := "select e.value as v from " + propsTableName + " as p, json_each(p.value, ?) as e where object in (" + compiled.ids + ")"
+			query = "select v, count(*) from (" + values + ") as f where v is not null group by v order by count(*) desc, v limit ?"
+		}
+		params = append(params, jsonPath(facet.Field))
+	}
+	params = append(params, compiled.Params...)
+	params = append(params, size)
 
-	case *pb.FieldQuery_Lt:
-		return fmt.Sprintf("(value->>'$.%s'<%d)", v.Lt.Field, v.Lt.Value)
+	c, err := s.db.Query(query, facetBucketScanner, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = c.Close()
+	}()
 
-	case *pb.FieldQuery_Lte:
-		return fmt.Sprintf("(value->>'$.%s'<=%d)", v.Lte.Field, v.Lte.Value)
+	var buckets []*pb.FacetBucket
+	for c.HasNext() {
+		o, err := c.Next()
+		if err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, o.(*pb.FacetBucket))
+	}
+	return buckets, nil
+}
 
-	case *pb.FieldQuery_Gt:
-		return fmt.Sprintf("(value->>'$.%s'>%d)", v.Gt.Field, v.Gt.Value)
+// rangeBuckets counts the numeric values of the facet field in each of its ranges among the objects selected by compiled
+func (s *sqlStore) rangeBuckets(compiled *CompiledQuery, facet *pb.Facet) ([]*pb.FacetBucket, error) {
+	var (
+		values string
+		params []interface{}
+	)
+	if facet.Field == NumberField {
+		values = "select num as v from " + numbersTableName + " where id in (" + compiled.ids + ")"
+		params = compiled.Params
+	} else {
+		if s.dialect == bome.MySQL {
+			values = "select e.v as v from " + propsTableName + ", " + mysqlElementsTable + " where object in (" + compiled.ids + ") and json_type(e.v) in ('INTEGER', 'UNSIGNED INTEGER', 'DOUBLE', 'DECIMAL')"
+		} else {
+			values = "select e.value as v from " + propsTableName + " as p, json_each(p.value, ?) as e where object in (" + compiled.ids + ") and e.type in ('integer', 'real')"
+		}
+		params = append(params, jsonPath(facet.Field))
+		params = append(params, compiled.Params...)
+	}
+
+	var buckets []*pb.FacetBucket
+	for _, r := range facet.Ranges {
+		query := "select count(*) from (" + values + ") as f where 1 = 1"
+		rangeParams := append([]interface{}{}, params...)
+		if r.From != nil {
+			query += " and v >= ?"
+			rangeParams = append(rangeParams, r.From.Value)
+		}
+		if r.To != nil {
+			query += " and v < ?"
+			rangeParams = append(rangeParams, r.To.Value)
+		}
 
-	case *pb.FieldQuery_Gte:
-		return fmt.Sprintf("(value->>'$.%s'>=%d)", v.Gte.Field, v.Gte.Value)
+		o, err := s.db.QueryFirst(query, bome.IntScanner, rangeParams...)
+		if err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, &pb.FacetBucket{Key: rangeKey(r), Count: o.(int64)})
+	}
+	return buckets, nil
+}
 
-	case *pb.FieldQuery_NumbEq:
-		return fmt.Sprintf("(value->>'$.%s'=%d)", v.NumbEq.Field, v.NumbEq.Value)
+func (s *sqlStore) loadPostings(compiled *CompiledQuery) ([]*posting, error) {
+	c, err := s.db.Query(compiled.SQL, postingScanner, compiled.Params...)
+	if err != nil {
+		return nil, err
 	}
+	defer func() {
+		_ = c.Close()
+	}()
 
-	return ""
+	var postings []*posting
+	for c.HasNext() {
+		o, err := c.Next()
+		if err != nil {
+			return nil, err
+		}
+		postings = append(postings, o.(*posting))
+	}
+	return postings, nil
 }
 
-func escape(value string) string {
-	return strings.Replace(value, "'", `\'`, -1)
+func (s *sqlStore) loadFieldStats() (map[string]*fieldStats, error) {
+	c, err := s.db.Query(selectFieldStats, fieldStatsScanner)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = c.Close()
+	}()
+
+	stats := map[string]*fieldStats{}
+	for c.HasNext() {
+		o, err := c.Next()
+		if err != nil {
+			return nil, err
+		}
+		entry := o.(*fieldStatsEntry)
+		stats[entry.field] = entry.stats
+	}
+	return stats, nil
 }
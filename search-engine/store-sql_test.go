@@ -0,0 +1,75 @@
+package se
+
+import (
+	"database/sql"
+	"github.com/omecodes/bome"
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestSQLStore_MigrateWords(t *testing.T) {
+	Convey("Words tables created before words were indexed by field are rebuilt", t, func() {
+		conn, err := sql.Open(bome.SQLite3, ":memory:")
+		So(err, ShouldBeNil)
+		conn.SetMaxOpenConns(1)
+
+		_, err = conn.Exec("create table test_index_words (token varchar(255) not null, id varchar(255) not null, primary key(token, id))")
+		So(err, ShouldBeNil)
+		_, err = conn.Exec("insert into test_index_words values('juventus', 'd1')")
+		So(err, ShouldBeNil)
+
+		store, err := NewSQLIndexStore(conn, bome.SQLite3, "test_index")
+		So(err, ShouldBeNil)
+
+		engine := NewEngine(store)
+		So(engine.PendingReindex(), ShouldBeTrue)
+
+		So(engine.CreateTextMapping(&pb.TextMapping{ObjectId: "d1", Name: "body", Text: "juventus won"}), ShouldBeNil)
+		q, err := ParseQuery(`$text = "juventus"`)
+		So(err, ShouldBeNil)
+		hits, err := engine.Search(q, SearchOptions{})
+		So(err, ShouldBeNil)
+		So(hitIDs(hits), ShouldResemble, []string{"d1"})
+
+		Convey("The migration is pending until the objects are indexed again", func() {
+			store, err := NewSQLIndexStore(conn, bome.SQLite3, "test_index")
+			So(err, ShouldBeNil)
+			So(NewEngine(store).PendingReindex(), ShouldBeTrue)
+		})
+
+		Convey("Reindexed drops the renamed table", func() {
+			So(engine.Reindexed(), ShouldBeNil)
+			So(engine.PendingReindex(), ShouldBeFalse)
+
+			_, err := conn.Exec("select count(*) from test_index_words_legacy")
+			So(err, ShouldNotBeNil)
+
+			store, err := NewSQLIndexStore(conn, bome.SQLite3, "test_index")
+			So(err, ShouldBeNil)
+			So(NewEngine(store).PendingReindex(), ShouldBeFalse)
+		})
+	})
+
+	Convey("Text mappings are replaced in a single transaction", t, func() {
+		conn, err := sql.Open(bome.SQLite3, ":memory:")
+		So(err, ShouldBeNil)
+		conn.SetMaxOpenConns(1)
+
+		store, err := NewSQLIndexStore(conn, bome.SQLite3, "test_index")
+		So(err, ShouldBeNil)
+		So(store.SaveTextMapping("d1", "body", []string{"juventus", "won"}), ShouldBeNil)
+
+		// the document length insert fails once the words of the new mapping are written
+		_, err = conn.Exec("create trigger fail_docs before insert on test_index_docs begin select raise(abort, 'failure'); end")
+		So(err, ShouldBeNil)
+		So(store.SaveTextMapping("d1", "body", []string{"roma", "lost"}), ShouldNotBeNil)
+
+		tokens, err := store.ObjectTokens("d1")
+		So(err, ShouldBeNil)
+		So(tokens, ShouldHaveLength, 1)
+		So(tokens[0].Tokens, ShouldHaveLength, 2)
+		So(tokens[0].Tokens[0].Token, ShouldEqual, "juventus")
+		So(tokens[0].Tokens[1].Token, ShouldEqual, "won")
+	})
+}
@@ -3,14 +3,46 @@ package se
 import pb "github.com/omecodes/store/gen/go/proto"
 
 type Cursor interface {
-	Next() (string, error)
+	Next() (*pb.SearchHit, error)
 	Close() error
 }
 
 type Store interface {
-	SaveWordMapping(word string, id string) error
-	SaveNumberMapping(num int64, id string) error
+	// SaveTextMapping replaces the tokens indexed for the field of the object. Tokens are given in text order, their
+	// positions are recorded for phrase and proximity conditions. Terms are indexed without position
+	SaveTextMapping(id string, field string, tokens []string, terms ...string) error
+	SaveNumberMapping(num float64, id string) error
 	SavePropertiesMapping(id string, value string) error
-	Search(query *pb.SearchQuery) (Cursor, error)
+	// SaveGeoMapping adds a point to the ones indexed for the object
+	SaveGeoMapping(id string, point *pb.GeoPoint) error
+	// GeoPoints returns the points indexed for the objects of ids
+	GeoPoints(ids []string) (map[string][]*pb.GeoPoint, error)
+	Search(query *pb.SearchQuery, opts SearchOptions) (Cursor, error)
+	// Facets counts the values of the facets fields over all the objects matching query
+	Facets(query *pb.SearchQuery, opts SearchOptions, facets []*pb.Facet) ([]*pb.FacetResult, error)
 	DeleteObjectMappings(id string) error
+	// Flush returns once the changes made before it are stored durably
+	Flush() error
+	// MappedIDs returns, in increasing order, at most count ids greater than after of the objects that have mappings
+	MappedIDs(after string, count int) ([]string, error)
+	// UnmappedIDs returns the ids among ids of the objects that have no mapping
+	UnmappedIDs(ids []string) ([]string, error)
+	// ObjectTokens returns the tokens indexed for the text fields of the object id, fields and tokens in
+	// alphabetical order
+	ObjectTokens(id string) ([]*pb.FieldTokens, error)
+	// TokenDocuments returns the postings of token for at most count objects whose id is greater than after, ordered
+	// by id then field. An empty field looks the token up in all the fields
+	TokenDocuments(token string, field string, after string, count int) ([]*pb.TokenPosting, error)
+	// Explain evaluates query like Search and describes how it was compiled and how the hits were scored
+	Explain(query *pb.SearchQuery, opts SearchOptions) (*pb.SearchExplanation, error)
+	// Suggest returns the size terms of field indexed for the most objects among the ones that start with prefix,
+	// or with a text at most maxDistance edits away from it
+	Suggest(field string, prefix string, size int, maxDistance int) ([]*pb.Suggestion, error)
+}
+
+// Migrator is implemented by the stores whose tables were migrated from a layout whose mappings could not be
+// converted. The objects they indexed must be indexed again, after what Reindexed drops the migrated data
+type Migrator interface {
+	PendingReindex() bool
+	Reindexed() error
 }
@@ -0,0 +1,284 @@
+package se
+
+import (
+	"container/heap"
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultSuggestSize is the number of suggestions returned when the options do not set one
+	DefaultSuggestSize = 10
+
+	// MaxSuggestSize is the maximum number of suggestions returned at once
+	MaxSuggestSize = 100
+
+	// SuggestTermsMaxAge is the time the terms of a field loaded from the SQL words table are used for suggestions.
+	// They are kept up to date with the changes made by the store, but not with the ones of other processes sharing
+	// the tables: they are loaded again once older
+	SuggestTermsMaxAge = time.Minute
+)
+
+// SuggestOptions tunes the completions of a prefix
+type SuggestOptions struct {
+	// Size is the number of suggestions to return. Zero selects DefaultSuggestSize
+	Size int
+
+	// MaxDistance is the number of edits tolerated between the typed prefix and the beginning of the suggested terms
+	MaxDistance int
+
+	// Analyzer is the name of the analyzer the texts of the field were indexed with. The typed prefix is analyzed
+	// with it
+	Analyzer string
+}
+
+// validateSuggestOptions checks opts and returns them with their defaults applied
+func validateSuggestOptions(opts SuggestOptions) (SuggestOptions, error) {
+	if opts.Size < 0 || opts.Size > MaxSuggestSize {
+		return opts, errors.BadRequest("suggestions size is out of range", errors.Details{Key: "max", Value: MaxSuggestSize})
+	}
+	if opts.Size == 0 {
+		opts.Size = DefaultSuggestSize
+	}
+
+	if opts.MaxDistance < 0 || opts.MaxDistance > MaxFuzzyDistance {
+		return opts, errors.BadRequest("fuzzy distance is out of range", errors.Details{Key: "max-distance", Value: MaxFuzzyDistance})
+	}
+	return opts, nil
+}
+
+// suggestTree is a prefix tree of the terms indexed for each text field, with the number of objects each term is
+// indexed for. Each node also holds the highest number of objects of the terms below it, so that the most frequent
+// completions of a prefix are found without visiting all of them
+type suggestTree struct {
+	fields map[string]*suggestNode
+}
+
+type suggestNode struct {
+	children map[rune]*suggestNode
+	docs     int64
+	max      int64
+}
+
+func newSuggestTree() *suggestTree {
+	return &suggestTree{fields: map[string]*suggestNode{}}
+}
+
+// add changes by delta the number of objects term is indexed for in field
+func (t *suggestTree) add(field string, term string, delta int64) {
+	root := t.fields[field]
+	if root == nil {
+		if delta <= 0 {
+			return
+		}
+		root = &suggestNode{}
+		t.fields[field] = root
+	}
+
+	runes := []rune(term)
+	path := make([]*suggestNode, 0, len(runes)+1)
+	path = append(path, root)
+
+	node := root
+	for _, r := range runes {
+		child := node.children[r]
+		if child == nil {
+			if delta <= 0 {
+				return
+			}
+			child = &suggestNode{}
+			if node.children == nil {
+				node.children = map[rune]*suggestNode{}
+			}
+			node.children[r] = child
+		}
+		node = child
+		path = append(path, node)
+	}
+
+	node.docs += delta
+	if node.docs < 0 {
+		node.docs = 0
+	}
+
+	for i := len(path) - 1; i >= 0; i-- {
+		n := path[i]
+		n.max = n.docs
+		for r, child := range n.children {
+			if child.max == 0 {
+				delete(n.children, r)
+				continue
+			}
+			if child.max > n.max {
+				n.max = child.max
+			}
+		}
+	}
+
+	if root.max == 0 {
+		delete(t.fields, field)
+	}
+}
+
+// clear removes the terms of field
+func (t *suggestTree) clear(field string) {
+	delete(t.fields, field)
+}
+
+// addFields changes by delta the number of objects of the distinct terms of each field of fields
+func (t *suggestTree) addFields(fields map[string][]string, delta int64) {
+	for field, terms := range fields {
+		seen := map[string]bool{}
+		for _, term := range terms {
+			if !seen[term] {
+				seen[term] = true
+				t.add(field, term, delta)
+			}
+		}
+	}
+}
+
+// suggest returns the size terms of field that are indexed for the most objects among the ones starting with
+// prefix, or with a text that is at most maxDistance edits away from prefix
+func (t *suggestTree) suggest(field string, prefix string, size int, maxDistance int) []*pb.Suggestion {
+	root := t.fields[field]
+	if root == nil {
+		return nil
+	}
+
+	queue := &suggestQueue{}
+	for _, c := range completedNodes(root, []rune(prefix), maxDistance) {
+		heap.Push(queue, c)
+	}
+
+	var suggestions []*pb.Suggestion
+	for queue.Len() > 0 && len(suggestions) < size {
+		item := heap.Pop(queue).(*suggestItem)
+		if item.node == nil {
+			suggestions = append(suggestions, &pb.Suggestion{Text: item.text, Docs: item.docs})
+			continue
+		}
+
+		if item.node.docs > 0 {
+			heap.Push(queue, &suggestItem{text: item.text, docs: item.node.docs})
+		}
+		for r, child := range item.node.children {
+			heap.Push(queue, &suggestItem{node: child, text: item.text + string(r), docs: child.max})
+		}
+	}
+	return suggestions
+}
+
+// completedNodes returns the highest nodes under root whose text is at most maxDistance edits away from prefix. All
+// the terms below them complete prefix. Rows of the edit distances between the text of the visited node and the
+// beginnings of prefix are carried down the tree, branches are left as soon as no distance is low enough
+func completedNodes(root *suggestNode, prefix []rune, maxDistance int) []*suggestItem {
+	row := make([]int, len(prefix)+1)
+	for i := range row {
+		row[i] = i
+	}
+
+	var (
+		items []*suggestItem
+		visit func(node *suggestNode, text string, row []int)
+	)
+	visit = func(node *suggestNode, text string, row []int) {
+		if row[len(prefix)] <= maxDistance {
+			items = append(items, &suggestItem{node: node, text: text, docs: node.max})
+			return
+		}
+
+		for r, child := range node.children {
+			next := make([]int, len(row))
+			next[0] = row[0] + 1
+			lowest := next[0]
+			for i := 1; i < len(row); i++ {
+				cost := 1
+				if prefix[i-1] == r {
+					cost = 0
+				}
+				next[i] = minInt(next[i-1]+1, row[i]+1, row[i-1]+cost)
+				if next[i] < lowest {
+					lowest = next[i]
+				}
+			}
+
+			if lowest <= maxDistance {
+				visit(child, text+string(r), next)
+			}
+		}
+	}
+	visit(root, "", row)
+	return items
+}
+
+// suggestItem is a suggestion, or a node of the tree when node is set. Docs is the number of objects of the
+// suggested term, or the highest number of objects of the terms below the node
+type suggestItem struct {
+	node *suggestNode
+	text string
+	docs int64
+}
+
+// suggestQueue orders items by decreasing number of objects, then by text. The text of a node starts the texts of
+// the terms below it: terms are found in that order, and a term comes before the nodes holding the same text
+type suggestQueue []*suggestItem
+
+func (q suggestQueue) Len() int { return len(q) }
+
+func (q suggestQueue) Less(i, j int) bool {
+	if q[i].docs != q[j].docs {
+		return q[i].docs > q[j].docs
+	}
+	if q[i].text != q[j].text {
+		return q[i].text < q[j].text
+	}
+	return q[i].node == nil && q[j].node != nil
+}
+
+func (q suggestQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *suggestQueue) Push(x interface{}) {
+	*q = append(*q, x.(*suggestItem))
+}
+
+func (q *suggestQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+// analyzePrefix analyzes a typed prefix like the texts it completes. The tokens are joined with spaces so that the
+// prefix mapping terms of texts, which hold several tokens, can be completed as well. The last word is being typed
+// unless the prefix ends with a space: when the filters drop it, like a stop word, it is kept lower cased without
+// accents, as it may be the beginning of a longer word
+func analyzePrefix(prefix string, analyzer string) (string, error) {
+	a, err := GetAnalyzer(analyzer)
+	if err != nil {
+		return "", err
+	}
+
+	tokens := a.tokenizer(prefix)
+	if len(tokens) == 0 {
+		return "", nil
+	}
+
+	complete := tokens
+	if !strings.HasSuffix(prefix, " ") {
+		complete = tokens[:len(tokens)-1]
+	}
+
+	terms := a.filter(append([]string{}, complete...))
+	if len(complete) < len(tokens) {
+		last := tokens[len(tokens)-1]
+		typed := a.filter([]string{last})
+		if len(typed) == 0 {
+			typed = []string{foldAccents(strings.ToLower(last))}
+		}
+		terms = append(terms, typed...)
+	}
+	return strings.Join(terms, " "), nil
+}
@@ -0,0 +1,118 @@
+package se
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/omecodes/bome"
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func suggestedTerms(engine *Engine, field string, prefix string, opts SuggestOptions) []string {
+	suggestions, err := engine.Suggest(field, prefix, opts)
+	So(err, ShouldBeNil)
+
+	var terms []string
+	for _, s := range suggestions {
+		terms = append(terms, fmt.Sprintf("%s:%d", s.Text, s.Docs))
+	}
+	return terms
+}
+
+func TestEngine_Suggest(t *testing.T) {
+	Convey("Suggestions are the indexed terms completing a prefix, the ones indexed for the most objects first", t, func() {
+		disk := newTestDiskStore(t.TempDir(), DiskStoreOptions{MaxBufferedDocs: 2})
+		defer func() {
+			So(disk.Close(), ShouldBeNil)
+		}()
+		loadedDisk := newTestDiskStore(t.TempDir(), DiskStoreOptions{MaxBufferedDocs: 2})
+		defer func() {
+			So(loadedDisk.Close(), ShouldBeNil)
+		}()
+
+		engines := []*Engine{NewEngine(newTestSQLStore()), NewEngine(disk)}
+
+		// these engines build their prefix tree before the index changes, the others after
+		loaded := []*Engine{NewEngine(newTestSQLStore()), NewEngine(loadedDisk)}
+		for _, engine := range loaded {
+			So(suggestedTerms(engine, "title", "", SuggestOptions{}), ShouldBeEmpty)
+		}
+
+		texts := map[string]string{
+			"o1": "Paris match",
+			"o2": "Paris Saint Germain",
+			"o3": "The parking lot",
+			"o4": "Party in Paris",
+			"o5": "Pasta",
+		}
+		for _, engine := range append(append([]*Engine{}, engines...), loaded...) {
+			for _, id := range []string{"o1", "o2", "o3", "o4", "o5"} {
+				So(engine.CreateTextMapping(&pb.TextMapping{ObjectId: id, Name: "title", Text: texts[id]}), ShouldBeNil)
+			}
+			So(engine.CreateTextMapping(&pb.TextMapping{ObjectId: "o1", Name: "notes", Text: "parade"}), ShouldBeNil)
+			So(engine.CreateTextMapping(&pb.TextMapping{ObjectId: "o2", Name: "full", Text: texts["o2"], PrefixMappingSize: 32}), ShouldBeNil)
+		}
+
+		for _, engine := range append(append([]*Engine{}, engines...), loaded...) {
+			So(suggestedTerms(engine, "title", "par", SuggestOptions{}), ShouldResemble, []string{"paris:3", "parking:1", "party:1"})
+			So(suggestedTerms(engine, "title", "pa", SuggestOptions{Size: 2}), ShouldResemble, []string{"paris:3", "parking:1"})
+			So(suggestedTerms(engine, "title", "PÂR", SuggestOptions{Size: 1}), ShouldResemble, []string{"paris:3"})
+			So(suggestedTerms(engine, "notes", "par", SuggestOptions{}), ShouldResemble, []string{"parade:1"})
+			So(suggestedTerms(engine, "other", "par", SuggestOptions{}), ShouldBeEmpty)
+			So(suggestedTerms(engine, "title", "the ", SuggestOptions{}), ShouldBeEmpty)
+			So(suggestedTerms(engine, "full", "Paris sa", SuggestOptions{}), ShouldResemble, []string{"paris saint germain:1"})
+
+			So(suggestedTerms(engine, "title", "pzr", SuggestOptions{}), ShouldBeEmpty)
+			So(suggestedTerms(engine, "title", "pzr", SuggestOptions{MaxDistance: 1}), ShouldResemble, []string{"paris:3", "parking:1", "party:1"})
+			So(suggestedTerms(engine, "title", "mtch", SuggestOptions{MaxDistance: 1}), ShouldResemble, []string{"match:1"})
+		}
+
+		Convey("Suggestions follow the changes of the indexed texts", func() {
+			for _, engine := range append(append([]*Engine{}, engines...), loaded...) {
+				So(engine.CreateTextMapping(&pb.TextMapping{ObjectId: "o4", Name: "title", Text: "Lyon"}), ShouldBeNil)
+				So(engine.DeleteObjectMappings("o1"), ShouldBeNil)
+				So(engine.DeleteObjectMappings("o5"), ShouldBeNil)
+
+				So(suggestedTerms(engine, "title", "pa", SuggestOptions{}), ShouldResemble, []string{"paris:1", "parking:1"})
+				So(suggestedTerms(engine, "notes", "par", SuggestOptions{}), ShouldBeEmpty)
+				So(suggestedTerms(engine, "title", "", SuggestOptions{Size: 3}), ShouldResemble, []string{"germain:1", "lot:1", "lyon:1"})
+			}
+		})
+
+		Convey("Suggestion options are checked", func() {
+			for _, opts := range []SuggestOptions{{Size: MaxSuggestSize + 1}, {Size: -1}, {MaxDistance: MaxFuzzyDistance + 1}} {
+				_, err := engines[0].Suggest("title", "par", opts)
+				So(errors.HTTPStatus(err), ShouldEqual, http.StatusBadRequest)
+			}
+		})
+	})
+}
+
+func TestSQLStore_SharedSuggestions(t *testing.T) {
+	Convey("Suggestions of SQL stores sharing tables follow the changes of the others once their terms are too old", t, func() {
+		conn, err := sql.Open(bome.SQLite3, ":memory:")
+		So(err, ShouldBeNil)
+		conn.SetMaxOpenConns(1)
+
+		var engines []*Engine
+		for i := 0; i < 2; i++ {
+			store, err := NewSQLIndexStore(conn, bome.SQLite3, "test_index")
+			So(err, ShouldBeNil)
+			engines = append(engines, NewEngine(store))
+		}
+
+		So(engines[0].CreateTextMapping(&pb.TextMapping{ObjectId: "o1", Name: "title", Text: "Paris"}), ShouldBeNil)
+		So(suggestedTerms(engines[1], "title", "par", SuggestOptions{}), ShouldResemble, []string{"paris:1"})
+
+		So(engines[0].CreateTextMapping(&pb.TextMapping{ObjectId: "o2", Name: "title", Text: "Parking"}), ShouldBeNil)
+		So(suggestedTerms(engines[1], "title", "par", SuggestOptions{}), ShouldResemble, []string{"paris:1"})
+
+		store := engines[1].store.(*sqlStore)
+		store.suggestLoaded["title"] = time.Now().Add(-SuggestTermsMaxAge - time.Second)
+		So(suggestedTerms(engines[1], "title", "par", SuggestOptions{}), ShouldResemble, []string{"paris:1", "parking:1"})
+	})
+}
@@ -0,0 +1,122 @@
+package se
+
+import (
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"sort"
+	"strings"
+)
+
+// MaxSynonyms is the maximum number of synonyms and inputs of a synonym set
+const MaxSynonyms = 64
+
+// ValidateSynonymSet checks that set has an id, a scope and enough words to declare synonyms. Its analyzer, when
+// set, must be registered
+func ValidateSynonymSet(set *pb.SynonymSet) error {
+	if set == nil || set.Id == "" {
+		return errors.BadRequest("synonym set requires an id")
+	}
+
+	if set.Collection == "" && set.Analyzer == "" {
+		return errors.BadRequest("synonym set requires a collection or an analyzer", errors.Details{Key: "id", Value: set.Id})
+	}
+
+	if set.Analyzer != "" {
+		if _, err := GetAnalyzer(set.Analyzer); err != nil {
+			return errors.BadRequest("synonym set references an unknown analyzer", errors.Details{Key: "analyzer", Value: set.Analyzer})
+		}
+	}
+
+	if len(set.Synonyms)+len(set.Inputs) > MaxSynonyms {
+		return errors.BadRequest("synonym set has too many words", errors.Details{Key: "max", Value: MaxSynonyms})
+	}
+
+	if len(set.Inputs) == 0 && len(set.Synonyms) < 2 {
+		return errors.BadRequest("equivalent synonym set requires at least two synonyms", errors.Details{Key: "id", Value: set.Id})
+	}
+
+	if len(set.Inputs) > 0 && len(set.Synonyms) == 0 {
+		return errors.BadRequest("one-way synonym set requires synonyms", errors.Details{Key: "id", Value: set.Id})
+	}
+	return nil
+}
+
+// synonymDict maps analyzed words to the analyzed synonyms text conditions also look for. Synonyms of several words
+// are looked for as phrases, and multi-word inputs are keyed by their words joined with spaces
+type synonymDict map[string][][]string
+
+// newSynonymDict analyzes the words of the sets that apply to analyzer. Words the analyzer drops entirely, like
+// stop words, are ignored
+func newSynonymDict(analyzer *Analyzer, sets []*pb.SynonymSet) synonymDict {
+	dict := synonymDict{}
+	for _, set := range sets {
+		if set.Analyzer != "" && set.Analyzer != analyzer.name {
+			continue
+		}
+
+		synonyms := analyzeWords(analyzer, set.Synonyms)
+		inputs := synonyms
+		if len(set.Inputs) > 0 {
+			inputs = analyzeWords(analyzer, set.Inputs)
+		}
+
+		for _, input := range inputs {
+			key := strings.Join(input, " ")
+			for _, synonym := range synonyms {
+				if strings.Join(synonym, " ") != key {
+					dict[key] = append(dict[key], synonym)
+				}
+			}
+		}
+	}
+	return dict
+}
+
+func analyzeWords(analyzer *Analyzer, words []string) [][]string {
+	var analyzed [][]string
+	for _, word := range words {
+		if terms := analyzer.Analyze(word); len(terms) > 0 {
+			analyzed = append(analyzed, terms)
+		}
+	}
+	return analyzed
+}
+
+// expand returns the sorted distinct terms and the synonyms of terms, or of all of them taken as a phrase. Synonyms
+// of several words are returned apart, as phrases
+func (d synonymDict) expand(terms []string) (words []string, phrases [][]string) {
+	seen := map[string]bool{}
+	add := func(alternative []string) {
+		key := strings.Join(alternative, " ")
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+
+		if len(alternative) == 1 {
+			words = append(words, alternative[0])
+		} else {
+			phrases = append(phrases, alternative)
+		}
+	}
+
+	for _, term := range terms {
+		add([]string{term})
+	}
+	for _, term := range terms {
+		for _, synonym := range d[term] {
+			add(synonym)
+		}
+	}
+	if len(terms) > 1 {
+		for _, synonym := range d[strings.Join(terms, " ")] {
+			add(synonym)
+		}
+	}
+
+	sort.Strings(words)
+	sort.Slice(phrases, func(i, j int) bool {
+		return strings.Join(phrases[i], " ") < strings.Join(phrases[j], " ")
+	})
+	return words, phrases
+}
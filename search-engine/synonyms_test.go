@@ -0,0 +1,94 @@
+package se
+
+import (
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"sort"
+	"testing"
+)
+
+func searchedIDs(engine *Engine, query string, opts SearchOptions) []string {
+	q, err := ParseQuery(query)
+	So(err, ShouldBeNil)
+
+	hits, err := engine.Search(q, opts)
+	So(err, ShouldBeNil)
+
+	var ids []string
+	for _, hit := range hits {
+		ids = append(ids, hit.Id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func TestEngine_SearchSynonyms(t *testing.T) {
+	Convey("Text conditions also look for the synonyms of their terms", t, func() {
+		disk := newTestDiskStore(t.TempDir(), DiskStoreOptions{})
+		defer func() {
+			So(disk.Close(), ShouldBeNil)
+		}()
+
+		texts := []*pb.TextMapping{
+			{ObjectId: "o1", Name: "title", Text: "Television with a large screen"},
+			{ObjectId: "o2", Name: "title", Text: "Old TV"},
+			{ObjectId: "o3", Name: "title", Text: "Trip to New York"},
+			{ObjectId: "o4", Name: "title", Text: "NYC by night"},
+			{ObjectId: "o5", Name: "notes", Analyzer: EnglishAnalyzer, Text: "Cheap phones"},
+			{ObjectId: "o6", Name: "notes", Analyzer: EnglishAnalyzer, Text: "Mobile for sale"},
+		}
+
+		opts := SearchOptions{
+			Analyzers: map[string]string{"notes": EnglishAnalyzer},
+			Synonyms: []*pb.SynonymSet{
+				{Id: "tv", Collection: "products", Synonyms: []string{"tv", "Télévision"}},
+				{Id: "nyc", Collection: "products", Inputs: []string{"nyc"}, Synonyms: []string{"new york"}},
+				{Id: "phones", Analyzer: EnglishAnalyzer, Synonyms: []string{"phone", "mobile"}},
+				{Id: "screens", Analyzer: FrenchAnalyzer, Synonyms: []string{"screen", "tv"}},
+			},
+		}
+
+		for _, engine := range []*Engine{NewEngine(newTestSQLStore()), NewEngine(disk)} {
+			for _, mapping := range texts {
+				So(engine.CreateTextMapping(mapping), ShouldBeNil)
+			}
+
+			So(searchedIDs(engine, `$text = "tv"`, opts), ShouldResemble, []string{"o1", "o2"})
+			So(searchedIDs(engine, `$text = "television"`, opts), ShouldResemble, []string{"o1", "o2"})
+			So(searchedIDs(engine, `$text = "tv"`, SearchOptions{}), ShouldResemble, []string{"o2"})
+
+			// one-way sets only expand their inputs, synonyms of several words match as phrases
+			So(searchedIDs(engine, `$text = "nyc"`, opts), ShouldResemble, []string{"o3", "o4"})
+			So(searchedIDs(engine, `$text = "york"`, opts), ShouldResemble, []string{"o3"})
+
+			// sets scoped to an analyzer only apply to the aliases using it
+			So(searchedIDs(engine, `$text = "phones"`, opts), ShouldResemble, []string{"o5", "o6"})
+			So(searchedIDs(engine, `$text = "screen"`, opts), ShouldResemble, []string{"o1"})
+
+			// phrases are not expanded
+			So(searchedIDs(engine, `$text = "old television"`, opts), ShouldResemble, []string{"o1", "o2"})
+			So(searchedIDs(engine, `$text phrase "old television"`, opts), ShouldBeEmpty)
+		}
+	})
+}
+
+func TestValidateSynonymSet(t *testing.T) {
+	Convey("Synonym sets require an id, a scope and enough words", t, func() {
+		So(ValidateSynonymSet(&pb.SynonymSet{Id: "tv", Collection: "products", Synonyms: []string{"tv", "television"}}), ShouldBeNil)
+		So(ValidateSynonymSet(&pb.SynonymSet{Id: "tv", Analyzer: EnglishAnalyzer, Inputs: []string{"tv"}, Synonyms: []string{"television"}}), ShouldBeNil)
+
+		for _, set := range []*pb.SynonymSet{
+			nil,
+			{Collection: "products", Synonyms: []string{"tv", "television"}},
+			{Id: "tv", Synonyms: []string{"tv", "television"}},
+			{Id: "tv", Analyzer: "unknown", Synonyms: []string{"tv", "television"}},
+			{Id: "tv", Collection: "products", Synonyms: []string{"tv"}},
+			{Id: "tv", Collection: "products", Inputs: []string{"tv"}},
+			{Id: "tv", Collection: "products", Synonyms: make([]string, MaxSynonyms+1)},
+		} {
+			So(errors.HTTPStatus(ValidateSynonymSet(set)), ShouldEqual, http.StatusBadRequest)
+		}
+	})
+}
@@ -1,44 +1,83 @@
 package se
 
-import "strings"
+import (
+	"golang.org/x/text/unicode/norm"
+	"strings"
+	"unicode"
+)
 
-type TextTokenizer interface {
-	TokenizeText(text string, originalMappedCount uint32) TokenStream
-}
+// Tokenizer splits a text into tokens
+type Tokenizer func(text string) []string
 
-type textTokenizer struct {
-	text string
-}
+// WordTokenizer splits the NFKC normalized text into words. A word is a run of letters, marks and numbers; periods
+// and commas between two digits belong to the number they are part of. Scripts that are written without spaces
+// between words (Han, Hiragana, Katakana and Hangul) cannot be segmented without a dictionary: their runs are split
+// into overlapping bigrams, so that searching a sequence of characters matches the texts that contain it
+func WordTokenizer(text string) []string {
+	characters := []rune(norm.NFKC.String(text))
+
+	var (
+		tokens []string
+		word   []rune
+		cjk    bool
+	)
 
-func (t *textTokenizer) TokenizeText(text string, originalMappedCount uint32) TokenStream {
-	tokens := strings.Split(text, " ")
-	if len(text) < int(originalMappedCount) || originalMappedCount == 0 {
-		tokens = append(tokens, text)
-	} else {
-		tokens = append(tokens, text[:originalMappedCount])
+	flush := func() {
+		switch {
+		case len(word) == 0:
+		case cjk && len(word) > 1:
+			for i := 0; i < len(word)-1; i++ {
+				tokens = append(tokens, string(word[i:i+2]))
+			}
+		default:
+			tokens = append(tokens, string(word))
+		}
+		word = word[:0]
+		cjk = false
 	}
-	return &tokenStream{tokens: tokens}
-}
 
-type TokenStream interface {
-	Flip()
-	Next() string
-}
+	for i, r := range characters {
+		switch {
+		case unicode.IsMark(r) && len(word) > 0:
+			word = append(word, r)
+
+		case isCJK(r):
+			if !cjk {
+				flush()
+				cjk = true
+			}
+			word = append(word, r)
+
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			if cjk {
+				flush()
+			}
+			word = append(word, r)
+
+		case (r == '.' || r == ',') && !cjk && len(word) > 0 && unicode.IsDigit(word[len(word)-1]) &&
+			i+1 < len(characters) && unicode.IsDigit(characters[i+1]):
+			word = append(word, r)
+
+		default:
+			flush()
+		}
+	}
+	flush()
 
-type tokenStream struct {
-	tokens []string
-	cursor int
+	return tokens
 }
 
-func (stream *tokenStream) Flip() {
-	stream.cursor = 0
+// isCJK tells whether r belongs to a script that is written without spaces between words
+func isCJK(r rune) bool {
+	// the prolonged sound mark and the iteration mark are shared by several scripts, they are only used within CJK words
+	return r == 'ー' || r == '々' || unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
 }
 
-func (stream *tokenStream) Next() string {
-	if stream.cursor < len(stream.tokens) {
-		item := stream.tokens[stream.cursor]
-		stream.cursor++
-		return item
+// KeywordTokenizer returns the whole trimmed text as a single token
+func KeywordTokenizer(text string) []string {
+	text = strings.TrimSpace(norm.NFKC.String(text))
+	if text == "" {
+		return nil
 	}
-	return ""
+	return []string{text}
 }
@@ -0,0 +1,103 @@
+package se
+
+import (
+	pb "github.com/omecodes/store/gen/go/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"sort"
+	"testing"
+)
+
+func TestWordTokenizer(t *testing.T) {
+	Convey("Words of any script are split on unicode word boundaries", t, func() {
+		for text, tokens := range map[string][]string{
+			"Hello, world!":           {"Hello", "world"},
+			"L'équipe a gagné 3-1":    {"L", "équipe", "a", "gagné", "3", "1"},
+			"Straße und Grüße":        {"Straße", "und", "Grüße"},
+			"Москва — столица России": {"Москва", "столица", "России"},
+			"Ἀθῆναι":                  {"Ἀθῆναι"},
+			"مرحبا بالعالم":           {"مرحبا", "بالعالم"},
+			"नमस्ते दुनिया":           {"नमस्ते", "दुनिया"},
+			"π ≈ 3.14, 1,000 items.":  {"π", "3.14", "1,000", "items"},
+			"snake_case and e-mail":   {"snake", "case", "and", "e", "mail"},
+			"":                        nil,
+		} {
+			So(WordTokenizer(text), ShouldResemble, tokens)
+		}
+	})
+
+	Convey("Compatibility forms are folded", t, func() {
+		So(WordTokenizer("ＡＢＣ１２３ ﬁnal ①"), ShouldResemble, []string{"ABC123", "final", "1"})
+	})
+
+	Convey("Scripts written without spaces are split into bigrams", t, func() {
+		So(WordTokenizer("北京大学"), ShouldResemble, []string{"北京", "京大", "大学"})
+		So(WordTokenizer("東京タワーに行く"), ShouldResemble, []string{"東京", "京タ", "タワ", "ワー", "ーに", "に行", "行く"})
+		So(WordTokenizer("서울 특별시"), ShouldResemble, []string{"서울", "특별", "별시"})
+		So(WordTokenizer("iPhone手机 和 Android"), ShouldResemble, []string{"iPhone", "手机", "和", "Android"})
+	})
+}
+
+func TestEngine_SearchMultilingual(t *testing.T) {
+	Convey("Multilingual documents are indexed and found with queries written in their language", t, func() {
+		engine := NewEngine(newTestSQLStore())
+
+		for _, m := range []*pb.TextMapping{
+			{ObjectId: "fr", Name: "body", Text: "Le café de la gare est fermé"},
+			{ObjectId: "de", Name: "body", Text: "Die Straße ist gesperrt"},
+			{ObjectId: "ru", Name: "body", Text: "Москва — столица России"},
+			{ObjectId: "ar", Name: "body", Text: "مرحبا بالعالم"},
+			{ObjectId: "zh", Name: "body", Text: "北京大学的图书馆"},
+			{ObjectId: "ja", Name: "body", Text: "東京タワーに行く"},
+			{ObjectId: "ko", Name: "body", Text: "서울 특별시"},
+			{ObjectId: "wide", Name: "body", Text: "ＴＯＫＹＯ２０２０"},
+		} {
+			So(engine.CreateTextMapping(m), ShouldBeNil)
+		}
+
+		search := func(text string) []string {
+			q, err := ParseQuery(text)
+			So(err, ShouldBeNil)
+
+			hits, err := engine.Search(q, SearchOptions{})
+			So(err, ShouldBeNil)
+
+			ids := hitIDs(hits)
+			sort.Strings(ids)
+			return ids
+		}
+
+		So(search(`$text = "cafe"`), ShouldResemble, []string{"fr"})
+		So(search(`$text = "CAFÉ"`), ShouldResemble, []string{"fr"})
+		So(search(`$text = "straße"`), ShouldResemble, []string{"de"})
+		So(search(`$text = "москва"`), ShouldResemble, []string{"ru"})
+		So(search(`$text startswith "Росс"`), ShouldResemble, []string{"ru"})
+		So(search(`$text = "بالعالم"`), ShouldResemble, []string{"ar"})
+		So(search(`$text = "大学"`), ShouldResemble, []string{"zh"})
+		So(search(`$text = "图书馆"`), ShouldResemble, []string{"zh"})
+		So(search(`$text = "タワー"`), ShouldResemble, []string{"ja"})
+		So(search(`$text = "서울"`), ShouldResemble, []string{"ko"})
+		So(search(`$text = "tokyo2020"`), ShouldResemble, []string{"wide"})
+	})
+
+	Convey("String properties of any script are normalized, not deleted", t, func() {
+		store := newTestSQLStore()
+		engine := NewEngine(store)
+
+		So(engine.CreatePropertiesMapping(&pb.PropertiesMapping{ObjectId: "p1", Json: `{"name": "Дмитрий", "city": "Zürich"}`}), ShouldBeNil)
+		So(engine.CreatePropertiesMapping(&pb.PropertiesMapping{ObjectId: "p2", Json: `{"name": "李小龍", "city": "香港"}`}), ShouldBeNil)
+
+		search := func(text string) []string {
+			q, err := ParseQuery(text)
+			So(err, ShouldBeNil)
+
+			hits, err := engine.Search(q, SearchOptions{Fields: []string{"name", "city"}})
+			So(err, ShouldBeNil)
+			return hitIDs(hits)
+		}
+
+		So(search(`name = "дмитрий"`), ShouldResemble, []string{"p1"})
+		So(search(`city = "zurich"`), ShouldResemble, []string{"p1"})
+		So(search(`name startswith "李"`), ShouldResemble, []string{"p2"})
+		So(search(`city = "香港"`), ShouldResemble, []string{"p2"})
+	})
+}
@@ -0,0 +1,123 @@
+package se
+
+import (
+	"github.com/omecodes/errors"
+	pb "github.com/omecodes/store/gen/go/proto"
+	"math"
+	"strconv"
+	"time"
+)
+
+// timestampLayouts are the ISO-8601 forms of timestamps, from the most to the least precise. Timestamps without zone
+// are UTC, dates without time are at midnight
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// ParseTimestamp returns the number of milliseconds since the unix epoch of the ISO-8601 timestamp s
+func ParseTimestamp(s string) (int64, bool) {
+	for _, layout := range timestampLayouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t.UnixNano() / int64(time.Millisecond), true
+		}
+	}
+	return 0, false
+}
+
+// parseNumber parses a finite number or an ISO-8601 timestamp, which counts as its number of milliseconds since the
+// unix epoch
+func parseNumber(s string) (float64, bool) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err == nil {
+		return f, !math.IsInf(f, 0) && !math.IsNaN(f)
+	}
+
+	ms, ok := ParseTimestamp(s)
+	return float64(ms), ok
+}
+
+// formatNumber returns the shortest text of f, without exponent
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// IndexedValue returns the value indexed for value, a decoded JSON value of a field of type typ, and the type of the
+// field. Untyped fields get the type detected from value: strings that are ISO-8601 timestamps are detected as
+// timestamps. Timestamps are indexed as numbers of milliseconds and nulls as they are. Arrays are indexed when all
+// their elements have the type of the field. Objects, nested arrays and values of another type than typ are rejected
+func IndexedValue(value interface{}, typ pb.ValueType) (interface{}, pb.ValueType, error) {
+	elements, isArray := value.([]interface{})
+	if !isArray {
+		return indexedScalar(value, typ)
+	}
+
+	indexed := make([]interface{}, len(elements))
+	for ind, element := range elements {
+		if _, nested := element.([]interface{}); nested {
+			return nil, typ, errors.BadRequest("arrays of arrays cannot be indexed")
+		}
+
+		var err error
+		indexed[ind], typ, err = indexedScalar(element, typ)
+		if err != nil {
+			return nil, typ, err
+		}
+	}
+	return indexed, typ, nil
+}
+
+func indexedScalar(value interface{}, typ pb.ValueType) (interface{}, pb.ValueType, error) {
+	var detected pb.ValueType
+	switch v := value.(type) {
+	case nil:
+		return nil, typ, nil
+
+	case bool:
+		detected = pb.ValueType_BooleanValue
+
+	case float64:
+		detected = pb.ValueType_NumberValue
+
+	case string:
+		ms, isTimestamp := ParseTimestamp(v)
+		switch {
+		case typ == pb.ValueType_StringValue || typ == pb.ValueType_UntypedValue && !isTimestamp:
+			return v, pb.ValueType_StringValue, nil
+		case isTimestamp && (typ == pb.ValueType_TimestampValue || typ == pb.ValueType_UntypedValue):
+			return ms, pb.ValueType_TimestampValue, nil
+		}
+		detected = pb.ValueType_StringValue
+
+	default:
+		return nil, typ, errors.BadRequest("only strings, numbers, booleans, null values and arrays of them can be indexed")
+	}
+
+	if typ != pb.ValueType_UntypedValue && detected != typ {
+		return nil, typ, errors.BadRequest("value does not have the type of the indexed field", errors.Details{Key: "expected", Value: typ.String()}, errors.Details{Key: "found", Value: detected.String()})
+	}
+	return value, detected, nil
+}
+
+// checkListValues validates the values of the in, any_of or all_of condition on field
+func checkListValues(field string, values []*pb.Value) error {
+	if len(values) == 0 || len(values) > MaxListValues {
+		return errors.BadRequest("list conditions require between 1 and "+strconv.Itoa(MaxListValues)+" values", errors.Details{Key: "field", Value: field})
+	}
+
+	for _, value := range values {
+		switch v := value.GetValue().(type) {
+		case *pb.Value_Text, *pb.Value_Boolean:
+		case *pb.Value_Number:
+			if math.IsInf(v.Number, 0) || math.IsNaN(v.Number) {
+				return errors.BadRequest("list conditions require finite numbers", errors.Details{Key: "field", Value: field})
+			}
+		default:
+			return errors.BadRequest("list conditions require strings, numbers or booleans", errors.Details{Key: "field", Value: field})
+		}
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/omecodes/store/session"
 	"github.com/omecodes/store/settings"
 	"github.com/omecodes/store/webapp"
+	"github.com/omecodes/store/webhooks"
 	"golang.org/x/crypto/acme/autocert"
 	"io/ioutil"
 	"net"
@@ -72,6 +73,9 @@ type Server struct {
 	sourceManager files.AccessManager
 	cookieStore   *sessions.CookieStore
 
+	webhooks           webhooks.Manager
+	webhooksDispatcher *webhooks.Dispatcher
+
 	listener net.Listener
 	Errors   chan error
 	server   *http.Server
@@ -145,7 +149,7 @@ func (s *Server) init() error {
 		return err
 	}
 
-	s.objects, err = objects.NewSqlDB(s.db, bome.MySQL, "store")
+	s.objects, err = objects.NewSqlDB(s.db, bome.MySQL, "store", objects.WithIndexDir(filepath.Join(s.config.WorkingDir, "indexes")))
 	if err != nil {
 		return err
 	}
@@ -160,6 +164,12 @@ func (s *Server) init() error {
 		return err
 	}
 
+	s.webhooks, err = webhooks.NewSQLManager(s.db, bome.MySQL, "store_webhooks")
+	if err != nil {
+		return err
+	}
+	s.webhooksDispatcher = webhooks.NewDispatcher(s.webhooks)
+
 	_, err = s.settings.Get(settings.DataMaxSizePath)
 	if err != nil {
 		if !errors.IsNotFound(err) {
@@ -364,6 +374,7 @@ func (s *Server) httpRouter() http.Handler {
 	r.PathPrefix(common.ApiAuthRoutePrefix).Name("ManageAuthentication").Handler(http.StripPrefix(common.ApiDefaultLocation, auth.MuxRouter()))
 	r.PathPrefix(common.ApiAccountsRoutePrefix).Name("ManageAccounts").Handler(http.StripPrefix(common.ApiDefaultLocation, accounts.MuxRouter()))
 	r.PathPrefix(common.ApiSettingsRoutePrefix).Name("ManageSettings").Handler(http.StripPrefix(common.ApiDefaultLocation, settings.MuxRouter()))
+	r.PathPrefix(common.ApiWebhooksRoutePrefix).Name("ManageWebhooks").Handler(http.StripPrefix(common.ApiDefaultLocation, webhooks.MuxRouter(webhooks.MiddlewareWithManager(s.webhooks))))
 
 	r.Handle(common.ApiLoginRoute, auth.UserSessionHandler()).Methods(http.MethodPost)
 
@@ -384,11 +395,15 @@ func (s *Server) objectsHandler() http.Handler {
 			objects.MiddlewareWithDB(s.objects),
 		),
 		settings.MiddlewareWithManager(s.settings),
+		webhooks.MiddlewareWithManager(s.webhooks),
 	)
 }
 
 func (s *Server) filesHandler() http.Handler {
-	return files.MuxRouter(files.Middleware(files.MiddlewareWithSourceManager(s.sourceManager)))
+	return files.MuxRouter(
+		files.Middleware(files.MiddlewareWithSourceManager(s.sourceManager)),
+		webhooks.MiddlewareWithManager(s.webhooks),
+	)
 }
 
 // Start starts API server
@@ -398,6 +413,8 @@ func (s *Server) Start() error {
 		return err
 	}
 
+	s.webhooksDispatcher.Start()
+
 	if s.config.Dev {
 		return s.startDevServer()
 	}
@@ -420,5 +437,13 @@ func (s *Server) Stop() {
 	if s.listener != nil {
 		_ = s.listener.Close()
 	}
+	if s.webhooksDispatcher != nil {
+		s.webhooksDispatcher.Stop()
+	}
+	if s.objects != nil {
+		if err := s.objects.Close(); err != nil {
+			logs.Error("could not close objects DB", logs.Err(err))
+		}
+	}
 	_ = s.db.Close()
 }
@@ -9,7 +9,9 @@ import (
 	"github.com/omecodes/store/auth"
 	"github.com/omecodes/store/common"
 	"github.com/omecodes/store/objects"
+	se "github.com/omecodes/store/search-engine"
 	"google.golang.org/grpc"
+	"path/filepath"
 )
 
 type ObjectsConfig struct {
@@ -23,12 +25,17 @@ type ObjectsConfig struct {
 	RegistryAddress string
 	WorkingDir      string
 	Database        string
+
+	// SearchService makes collections indexed and searched by the search engine services of the registry
+	SearchService bool
 }
 
 func NewObjects(config ObjectsConfig) *Objects {
 	return &Objects{config: &config}
 }
 
+// Objects runs the objects storage as a standalone service. It notifies no webhooks: hooks are managed and their
+// deliveries dispatched by the monolithic server only
 type Objects struct {
 	config *ObjectsConfig
 
@@ -40,12 +47,6 @@ type Objects struct {
 func (o *Objects) init() error {
 	o.db = common.GetDB(bome.MySQL, o.config.Database)
 
-	var err error
-	o.objectsDB, err = objects.NewSqlDB(o.db, bome.MySQL, "objects")
-	if err != nil {
-		return err
-	}
-
 	o.box = service.CreateBox(
 		service.Dir(o.config.WorkingDir),
 		service.Ip(o.config.IP),
@@ -57,7 +58,16 @@ func (o *Objects) init() error {
 		service.CAAddr(o.config.CAAddress),
 		service.CACertFile(o.config.CACertFilename),
 	)
-	return nil
+
+	opts := []objects.SqlDBOption{objects.WithIndexDir(filepath.Join(o.config.WorkingDir, "indexes"))}
+	if o.config.SearchService {
+		ctx := service.ContextWithBox(context.Background(), o.box)
+		opts = append(opts, objects.WithSearchEngineService(ctx, &se.ServiceClientProvider{ServiceType: common.ServiceTypeSearchEngine}))
+	}
+
+	var err error
+	o.objectsDB, err = objects.NewSqlDB(o.db, bome.MySQL, "objects", opts...)
+	return err
 }
 
 func (o *Objects) updatedGRPCIncomingContext(ctx context.Context) (context.Context, error) {
@@ -99,3 +109,13 @@ func (o *Objects) Start() error {
 	}
 	return o.box.StartNode(params, opts...)
 }
+
+// Stop commits the search indexes of the collections and closes the database
+func (o *Objects) Stop() error {
+	if o.objectsDB != nil {
+		if err := o.objectsDB.Close(); err != nil {
+			return err
+		}
+	}
+	return o.db.Close()
+}
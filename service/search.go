@@ -0,0 +1,73 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/omecodes/bome"
+	"github.com/omecodes/service"
+	"github.com/omecodes/store/common"
+	pb "github.com/omecodes/store/gen/go/proto"
+	se "github.com/omecodes/store/search-engine"
+	"google.golang.org/grpc"
+)
+
+type SearchConfig struct {
+	Name            string
+	Domain          string
+	IP              string
+	CAAddress       string
+	CAAccess        string
+	CASecret        string
+	CACertFilename  string
+	RegistryAddress string
+	WorkingDir      string
+	Database        string
+}
+
+func NewSearch(config SearchConfig) *Search {
+	return &Search{config: &config}
+}
+
+// Search runs a search engine that indexes the objects of the collections objects services feed it with
+type Search struct {
+	config *SearchConfig
+
+	box     *service.Box
+	db      *sql.DB
+	engines se.Engines
+}
+
+func (s *Search) init() error {
+	s.db = common.GetDB(bome.MySQL, s.config.Database)
+	s.engines = se.NewSQLEngines(s.db, bome.MySQL, "objects")
+
+	s.box = service.CreateBox(
+		service.Dir(s.config.WorkingDir),
+		service.Ip(s.config.IP),
+		service.Domain(s.config.Domain),
+		service.RegAddr(s.config.RegistryAddress),
+		service.Name(s.config.Name),
+		service.CAApiKey(s.config.CAAccess),
+		service.CAApiSecret(s.config.CASecret),
+		service.CAAddr(s.config.CAAddress),
+		service.CACertFile(s.config.CACertFilename),
+	)
+	return nil
+}
+
+func (s *Search) Start() error {
+	err := s.init()
+	if err != nil {
+		return err
+	}
+
+	params := &service.NodeParams{
+		RegisterHandlerFunc: func(server *grpc.Server) {
+			pb.RegisterSearchEngineServer(server, se.NewGRPCServerHandler(s.engines))
+		},
+		ServiceType: common.ServiceTypeSearchEngine,
+		ServiceID:   s.config.Name,
+		Name:        s.config.Name + "-grpc",
+		Meta:        nil,
+	}
+	return s.box.StartNode(params, service.Register(true))
+}
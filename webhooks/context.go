@@ -0,0 +1,17 @@
+package webhooks
+
+import "context"
+
+type ctxManager struct{}
+
+func ContextWithManager(parent context.Context, manager Manager) context.Context {
+	return context.WithValue(parent, ctxManager{}, manager)
+}
+
+func GetManager(ctx context.Context) Manager {
+	o := ctx.Value(ctxManager{})
+	if o == nil {
+		return nil
+	}
+	return o.(Manager)
+}
@@ -0,0 +1,230 @@
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/omecodes/errors"
+	"github.com/omecodes/libome/logs"
+	"github.com/omecodes/store/common"
+	"github.com/omecodes/store/common/utime"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultMaxAttempts  = 8
+	DefaultBaseDelay    = time.Second * 10
+	DefaultMaxDelay     = time.Hour
+	DefaultPollInterval = time.Second * 2
+	defaultBatchSize    = 50
+)
+
+var (
+	errHookDeleted  = errors.NotFound("hook is deleted")
+	errHookDisabled = errors.Forbidden("hook is disabled")
+)
+
+type DispatcherOption func(*Dispatcher)
+
+func DispatcherWithHTTPClient(client *http.Client) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.client = client
+	}
+}
+
+func DispatcherWithMaxAttempts(count int) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.maxAttempts = count
+	}
+}
+
+func DispatcherWithBackoff(base, max time.Duration) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.baseDelay = base
+		d.maxDelay = max
+	}
+}
+
+func DispatcherWithPollInterval(interval time.Duration) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.pollInterval = interval
+	}
+}
+
+// NewDispatcher creates a dispatcher that sends queued deliveries of manager
+func NewDispatcher(manager Manager, opts ...DispatcherOption) *Dispatcher {
+	d := &Dispatcher{
+		manager:      manager,
+		client:       &http.Client{Timeout: time.Second * 15},
+		maxAttempts:  DefaultMaxAttempts,
+		baseDelay:    DefaultBaseDelay,
+		maxDelay:     DefaultMaxDelay,
+		pollInterval: DefaultPollInterval,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Dispatcher periodically sends pending deliveries. Failed deliveries are retried with
+// an exponential backoff until max attempts is reached, then they are marked as dead
+type Dispatcher struct {
+	manager      Manager
+	client       *http.Client
+	maxAttempts  int
+	baseDelay    time.Duration
+	maxDelay     time.Duration
+	pollInterval time.Duration
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func (d *Dispatcher) Start() {
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	go d.run()
+}
+
+func (d *Dispatcher) Stop() {
+	if d.stop == nil {
+		return
+	}
+	close(d.stop)
+	d.wg.Wait()
+	d.stop = nil
+}
+
+func (d *Dispatcher) run() {
+	defer d.wg.Done()
+
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.stop:
+			return
+		case <-ticker.C:
+			d.DispatchPending()
+		}
+	}
+}
+
+// DispatchPending sends all deliveries that are due now
+func (d *Dispatcher) DispatchPending() {
+	for {
+		deliveries, err := d.manager.NextDeliveries(utime.Now(), defaultBatchSize)
+		if err != nil {
+			logs.Error("webhooks: could not load pending deliveries", logs.Err(err))
+			return
+		}
+
+		for _, delivery := range deliveries {
+			d.dispatch(delivery)
+		}
+
+		if len(deliveries) < defaultBatchSize {
+			return
+		}
+	}
+}
+
+func (d *Dispatcher) dispatch(delivery *Delivery) {
+	delivery.Attempts++
+
+	status, err := d.send(delivery)
+	delivery.ResponseStatus = status
+
+	if err == nil {
+		delivery.Status = DeliveryDelivered
+		delivery.LastError = ""
+
+	} else if err == errHookDeleted || err == errHookDisabled {
+		// a delivery to a hook that is gone is not retried
+		delivery.Status = DeliveryCanceled
+		delivery.LastError = err.Error()
+		logs.Info("webhooks: delivery canceled", logs.Details("id", delivery.ID), logs.Details("hook", delivery.HookID), logs.Details("reason", err.Error()))
+
+	} else {
+		delivery.LastError = err.Error()
+		if delivery.Attempts >= d.maxAttempts {
+			delivery.Status = DeliveryDead
+			logs.Info("webhooks: delivery moved to dead letters", logs.Details("id", delivery.ID), logs.Details("hook", delivery.HookID))
+		} else {
+			delivery.NextAttempt = utime.Now() + d.backoff(delivery.Attempts).Milliseconds()
+		}
+	}
+
+	err = d.manager.UpdateDelivery(delivery)
+	if err != nil {
+		logs.Error("webhooks: could not update delivery", logs.Details("id", delivery.ID), logs.Err(err))
+	}
+}
+
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.baseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= d.maxDelay {
+			return d.maxDelay
+		}
+	}
+	return delay
+}
+
+func (d *Dispatcher) send(delivery *Delivery) (int, error) {
+	hook, err := d.manager.GetHook(delivery.HookID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return 0, errHookDeleted
+		}
+		return 0, err
+	}
+
+	if hook.Disabled {
+		return 0, errHookDisabled
+	}
+
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequest(http.MethodPost, hook.URL, bytes.NewBuffer(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set(common.HttpHeaderContentType, common.ContentTypeJSON)
+	req.Header.Set(common.HttpHeaderWebhookEvent, delivery.Event)
+	req.Header.Set(common.HttpHeaderWebhookDelivery, delivery.ID)
+	if hook.Secret != "" {
+		req.Header.Set(common.HttpHeaderWebhookSignature, "sha256="+Sign(hook.Secret, body))
+	}
+
+	rsp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, rsp.Body)
+		_ = rsp.Body.Close()
+	}()
+
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return rsp.StatusCode, errors.Internal(fmt.Sprintf("hook endpoint responded with status %d", rsp.StatusCode))
+	}
+	return rsp.StatusCode, nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of payload computed with secret.
+// Receivers can compare it with the signature header value to authenticate deliveries
+func Sign(secret string, payload []byte) string {
+	m := hmac.New(sha256.New, []byte(secret))
+	_, _ = m.Write(payload)
+	return hex.EncodeToString(m.Sum(nil))
+}
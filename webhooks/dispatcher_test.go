@@ -0,0 +1,194 @@
+package webhooks
+
+import (
+	"context"
+	"database/sql"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/omecodes/bome"
+	"github.com/omecodes/store/common"
+	"github.com/omecodes/store/common/utime"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var (
+	manager  Manager
+	received []*http.Request
+	bodies   [][]byte
+)
+
+func initManager() {
+	if manager == nil {
+		db, err := sql.Open(bome.SQLite3, ":memory:")
+		So(err, ShouldBeNil)
+		db.SetMaxOpenConns(1)
+
+		manager, err = NewSQLManager(db, bome.SQLite3, "webhooks")
+		So(err, ShouldBeNil)
+	}
+}
+
+func TestHook_Matches(t *testing.T) {
+	Convey("Hook filters are applied to events", t, func() {
+		hook := &Hook{Events: []string{"object.*"}, Collections: []string{"players"}}
+		So(hook.Matches(&Event{Type: EventObjectPut, Collection: "players"}), ShouldBeTrue)
+		So(hook.Matches(&Event{Type: EventObjectPut, Collection: "teams"}), ShouldBeFalse)
+		So(hook.Matches(&Event{Type: EventObjectMove, Collection: "teams", Target: "players"}), ShouldBeTrue)
+		So(hook.Matches(&Event{Type: EventFileWrite, AccessID: "main"}), ShouldBeFalse)
+
+		hook = &Hook{Accesses: []string{"main"}}
+		So(hook.Matches(&Event{Type: EventFileWrite, AccessID: "main"}), ShouldBeTrue)
+		So(hook.Matches(&Event{Type: EventFileDelete, AccessID: "other"}), ShouldBeFalse)
+		So(hook.Matches(&Event{Type: EventObjectDelete, Collection: "players"}), ShouldBeTrue)
+
+		hook.Disabled = true
+		So(hook.Matches(&Event{Type: EventFileWrite, AccessID: "main"}), ShouldBeFalse)
+	})
+}
+
+func TestDispatcher_DispatchPending(t *testing.T) {
+	Convey("Matching events are delivered and signed", t, func() {
+		initManager()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			received = append(received, r)
+			bodies = append(bodies, body)
+		}))
+		defer server.Close()
+
+		err := manager.SaveHook(&Hook{
+			ID:          "players-hook",
+			URL:         server.URL,
+			Secret:      "secret",
+			Events:      []string{EventObjectPut, EventObjectDelete},
+			Collections: []string{"players"},
+		})
+		So(err, ShouldBeNil)
+
+		ctx := ContextWithManager(context.Background(), manager)
+		Notify(ctx, &Event{Type: EventObjectPut, Collection: "players", ObjectID: "1"})
+		Notify(ctx, &Event{Type: EventObjectPatch, Collection: "players", ObjectID: "1"})
+		Notify(ctx, &Event{Type: EventObjectPut, Collection: "teams", ObjectID: "2"})
+
+		d := NewDispatcher(manager, DispatcherWithBackoff(time.Millisecond, time.Millisecond), DispatcherWithMaxAttempts(2))
+		d.DispatchPending()
+
+		So(received, ShouldHaveLength, 1)
+		So(received[0].Header.Get(common.HttpHeaderWebhookEvent), ShouldEqual, EventObjectPut)
+		So(received[0].Header.Get(common.HttpHeaderWebhookSignature), ShouldEqual, "sha256="+Sign("secret", bodies[0]))
+
+		deliveries, err := manager.ListDeliveries(DeliveriesOptions{HookID: "players-hook", Status: DeliveryDelivered})
+		So(err, ShouldBeNil)
+		So(deliveries, ShouldHaveLength, 1)
+		So(deliveries[0].Attempts, ShouldEqual, 1)
+	})
+
+	Convey("Failed deliveries are retried then moved to dead letters", t, func() {
+		initManager()
+		received = nil
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = append(received, r)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		hook, err := manager.GetHook("players-hook")
+		So(err, ShouldBeNil)
+		hook.URL = server.URL
+		So(manager.SaveHook(hook), ShouldBeNil)
+
+		Notify(ContextWithManager(context.Background(), manager), &Event{Type: EventObjectDelete, Collection: "players", ObjectID: "1"})
+
+		d := NewDispatcher(manager, DispatcherWithBackoff(time.Millisecond, time.Millisecond), DispatcherWithMaxAttempts(2))
+		d.DispatchPending()
+		So(received, ShouldHaveLength, 1)
+
+		deliveries, err := manager.ListDeliveries(DeliveriesOptions{Status: DeliveryPending})
+		So(err, ShouldBeNil)
+		So(deliveries, ShouldHaveLength, 1)
+		So(deliveries[0].ResponseStatus, ShouldEqual, http.StatusServiceUnavailable)
+
+		time.Sleep(time.Millisecond * 5)
+		d.DispatchPending()
+		So(received, ShouldHaveLength, 2)
+
+		deadLetters, err := manager.ListDeliveries(DeliveriesOptions{Status: DeliveryDead})
+		So(err, ShouldBeNil)
+		So(deadLetters, ShouldHaveLength, 1)
+		So(deadLetters[0].Attempts, ShouldEqual, 2)
+	})
+
+	Convey("Deliveries to disabled or deleted hooks are canceled without retry", t, func() {
+		initManager()
+		received = nil
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = append(received, r)
+		}))
+		defer server.Close()
+
+		So(manager.SaveHook(&Hook{ID: "coaches-hook", URL: server.URL, Collections: []string{"coaches"}}), ShouldBeNil)
+		Notify(ContextWithManager(context.Background(), manager), &Event{Type: EventObjectPut, Collection: "coaches", ObjectID: "1"})
+
+		hook, err := manager.GetHook("coaches-hook")
+		So(err, ShouldBeNil)
+		hook.Disabled = true
+		So(manager.SaveHook(hook), ShouldBeNil)
+
+		d := NewDispatcher(manager, DispatcherWithBackoff(time.Millisecond, time.Millisecond), DispatcherWithMaxAttempts(2))
+		d.DispatchPending()
+		So(received, ShouldBeEmpty)
+
+		canceled, err := manager.ListDeliveries(DeliveriesOptions{HookID: "coaches-hook", Status: DeliveryCanceled})
+		So(err, ShouldBeNil)
+		So(canceled, ShouldHaveLength, 1)
+
+		pending, err := manager.ListDeliveries(DeliveriesOptions{Status: DeliveryPending})
+		So(err, ShouldBeNil)
+		So(pending, ShouldBeEmpty)
+
+		// a hook deleted while its delivery is being dispatched
+		hook.Disabled = false
+		So(manager.SaveHook(hook), ShouldBeNil)
+		Notify(ContextWithManager(context.Background(), manager), &Event{Type: EventObjectPut, Collection: "coaches", ObjectID: "2"})
+
+		deliveries, err := manager.NextDeliveries(utime.Now(), 10)
+		So(err, ShouldBeNil)
+		So(deliveries, ShouldHaveLength, 1)
+
+		So(manager.DeleteHook("coaches-hook"), ShouldBeNil)
+		d.dispatch(deliveries[0])
+		So(received, ShouldBeEmpty)
+		So(deliveries[0].Status, ShouldEqual, DeliveryCanceled)
+	})
+}
+
+func TestSQLManager_SaveHook(t *testing.T) {
+	Convey("Hooks replaced without secret keep their stored secret", t, func() {
+		initManager()
+
+		So(manager.SaveHook(&Hook{ID: "teams-hook", URL: "http://localhost/teams", Secret: "teams-secret"}), ShouldBeNil)
+		created, err := manager.GetHook("teams-hook")
+		So(err, ShouldBeNil)
+
+		So(manager.SaveHook(&Hook{ID: "teams-hook", URL: "http://localhost/teams/v2"}), ShouldBeNil)
+		hook, err := manager.GetHook("teams-hook")
+		So(err, ShouldBeNil)
+		So(hook.URL, ShouldEqual, "http://localhost/teams/v2")
+		So(hook.Secret, ShouldEqual, "teams-secret")
+		So(hook.CreatedAt, ShouldEqual, created.CreatedAt)
+
+		So(manager.SaveHook(&Hook{ID: "teams-hook", URL: "http://localhost/teams/v2", Secret: "rotated"}), ShouldBeNil)
+		hook, err = manager.GetHook("teams-hook")
+		So(err, ShouldBeNil)
+		So(hook.Secret, ShouldEqual, "rotated")
+
+		So(manager.DeleteHook("teams-hook"), ShouldBeNil)
+	})
+}
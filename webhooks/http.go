@@ -0,0 +1,232 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"github.com/gorilla/mux"
+	"github.com/omecodes/errors"
+	"github.com/omecodes/libome/logs"
+	"github.com/omecodes/store/auth"
+	"github.com/omecodes/store/common"
+	"github.com/omecodes/store/common/utime"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+func MiddlewareWithManager(manager Manager) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			updatedContext := ContextWithManager(r.Context(), manager)
+			next.ServeHTTP(w, r.WithContext(updatedContext))
+		})
+	}
+}
+
+func MuxRouter(middleware ...mux.MiddlewareFunc) http.Handler {
+	r := mux.NewRouter()
+	r.Name("SaveWebhook").Methods(http.MethodPut).Path(common.ApiSaveWebhookRoute).Handler(http.HandlerFunc(HTTPHandleSaveHook))
+	r.Name("ListWebhooks").Methods(http.MethodGet).Path(common.ApiListWebhooksRoute).Handler(http.HandlerFunc(HTTPHandleListHooks))
+	r.Name("GetWebhook").Methods(http.MethodGet).Path(common.ApiGetWebhookRoute).Handler(http.HandlerFunc(HTTPHandleGetHook))
+	r.Name("DeleteWebhook").Methods(http.MethodDelete).Path(common.ApiDeleteWebhookRoute).Handler(http.HandlerFunc(HTTPHandleDeleteHook))
+	r.Name("ListDeliveries").Methods(http.MethodGet).Path(common.ApiListDeliveriesRoute).Handler(http.HandlerFunc(HTTPHandleListDeliveries))
+	r.Name("ListDeadLetters").Methods(http.MethodGet).Path(common.ApiListDeadLettersRoute).Handler(http.HandlerFunc(HTTPHandleListDeadLetters))
+	r.Name("RetryDelivery").Methods(http.MethodPost).Path(common.ApiRetryDeliveryRoute).Handler(http.HandlerFunc(HTTPHandleRetryDelivery))
+
+	var h http.Handler
+	h = r
+	for _, m := range middleware {
+		h = m(h)
+	}
+	return h
+}
+
+func HTTPHandleSaveHook(w http.ResponseWriter, r *http.Request) {
+	manager, ok := adminManager(w, r)
+	if !ok {
+		return
+	}
+
+	var hook *Hook
+	err := json.NewDecoder(r.Body).Decode(&hook)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if hook == nil || hook.URL == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	u, err := url.Parse(hook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = manager.SaveHook(hook)
+	if err != nil {
+		logs.Error("failed to save webhook", logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+
+	hook.Secret = ""
+	err = json.NewEncoder(w).Encode(hook)
+	if err != nil {
+		logs.Error("failed to send webhook as response", logs.Err(err))
+	}
+}
+
+func HTTPHandleGetHook(w http.ResponseWriter, r *http.Request) {
+	manager, ok := adminManager(w, r)
+	if !ok {
+		return
+	}
+
+	hookId := mux.Vars(r)[common.ApiRouteVarIdName]
+	hook, err := manager.GetHook(hookId)
+	if err != nil {
+		logs.Error("failed to get webhook", logs.Details("id", hookId), logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+
+	hook.Secret = ""
+	err = json.NewEncoder(w).Encode(hook)
+	if err != nil {
+		logs.Error("failed to send webhook as response", logs.Err(err))
+	}
+}
+
+func HTTPHandleListHooks(w http.ResponseWriter, r *http.Request) {
+	manager, ok := adminManager(w, r)
+	if !ok {
+		return
+	}
+
+	hooks, err := manager.ListHooks()
+	if err != nil {
+		logs.Error("failed to list webhooks", logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+
+	for _, hook := range hooks {
+		hook.Secret = ""
+	}
+
+	err = json.NewEncoder(w).Encode(hooks)
+	if err != nil {
+		logs.Error("failed to send webhooks as response", logs.Err(err))
+	}
+}
+
+func HTTPHandleDeleteHook(w http.ResponseWriter, r *http.Request) {
+	manager, ok := adminManager(w, r)
+	if !ok {
+		return
+	}
+
+	hookId := mux.Vars(r)[common.ApiRouteVarIdName]
+	err := manager.DeleteHook(hookId)
+	if err != nil {
+		logs.Error("failed to delete webhook", logs.Details("id", hookId), logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+	}
+}
+
+func HTTPHandleListDeliveries(w http.ResponseWriter, r *http.Request) {
+	listDeliveries(w, r, r.URL.Query().Get(common.ApiParamStatus))
+}
+
+func HTTPHandleListDeadLetters(w http.ResponseWriter, r *http.Request) {
+	listDeliveries(w, r, DeliveryDead)
+}
+
+func HTTPHandleRetryDelivery(w http.ResponseWriter, r *http.Request) {
+	manager, ok := adminManager(w, r)
+	if !ok {
+		return
+	}
+
+	deliveryId := mux.Vars(r)[common.ApiRouteVarIdName]
+	delivery, err := manager.GetDelivery(deliveryId)
+	if err != nil {
+		logs.Error("failed to get delivery", logs.Details("id", deliveryId), logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+
+	delivery.Status = DeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttempt = utime.Now()
+
+	err = manager.UpdateDelivery(delivery)
+	if err != nil {
+		logs.Error("failed to requeue delivery", logs.Details("id", deliveryId), logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+	}
+}
+
+func listDeliveries(w http.ResponseWriter, r *http.Request, status string) {
+	manager, ok := adminManager(w, r)
+	if !ok {
+		return
+	}
+
+	var (
+		err  error
+		opts DeliveriesOptions
+	)
+
+	query := r.URL.Query()
+	opts.HookID = query.Get(common.ApiParamHook)
+	opts.Status = status
+
+	if offset := query.Get(common.ApiParamOffset); offset != "" {
+		opts.Offset, err = strconv.Atoi(offset)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	if count := query.Get(common.ApiParamCount); count != "" {
+		opts.Count, err = strconv.Atoi(count)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	deliveries, err := manager.ListDeliveries(opts)
+	if err != nil {
+		logs.Error("failed to list deliveries", logs.Err(err))
+		w.WriteHeader(errors.HTTPStatus(err))
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(deliveries)
+	if err != nil {
+		logs.Error("failed to send deliveries as response", logs.Err(err))
+	}
+}
+
+func adminManager(w http.ResponseWriter, r *http.Request) (Manager, bool) {
+	ctx := r.Context()
+
+	user := auth.Get(ctx)
+	if user == nil || user.Name != "admin" {
+		w.WriteHeader(http.StatusForbidden)
+		return nil, false
+	}
+
+	manager := GetManager(ctx)
+	if manager == nil {
+		logs.Error("missing webhooks manager in context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+	return manager, true
+}
@@ -0,0 +1,270 @@
+package webhooks
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/omecodes/bome"
+	"github.com/omecodes/errors"
+	"github.com/omecodes/libome/logs"
+	"github.com/omecodes/store/common/utime"
+	"strings"
+)
+
+const (
+	deliveriesTableSchema = `
+create table if not exists $prefix$_deliveries (
+	id varchar(255) not null primary key,
+	hook_id varchar(255) not null,
+	event varchar(255) not null,
+	payload longtext not null,
+	status varchar(32) not null,
+	attempts int not null,
+	next_attempt bigint not null,
+	last_error text not null,
+	response_status int not null,
+	created_at bigint not null,
+	updated_at bigint not null
+)$engine$;
+`
+	queryInsertDelivery   = `insert into $prefix$_deliveries values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`
+	queryUpdateDelivery   = `update $prefix$_deliveries set status=?, attempts=?, next_attempt=?, last_error=?, response_status=?, updated_at=? where id=?;`
+	queryGetDelivery      = `select * from $prefix$_deliveries where id=?;`
+	queryDueDeliveries    = `select * from $prefix$_deliveries where status=? and next_attempt<=? order by next_attempt limit ?;`
+	queryDeleteDeliveries = `delete from $prefix$_deliveries where hook_id=?;`
+	deliveryScanner       = "delivery_scanner_key"
+)
+
+// NewSQLManager creates a Manager that stores hooks and deliveries in SQL tables prefixed with tablePrefix
+func NewSQLManager(db *sql.DB, dialect string, tablePrefix string) (Manager, error) {
+	hooks, err := bome.Build().
+		SetConn(db).
+		SetDialect(dialect).
+		SetTableName(tablePrefix + "_hooks").
+		JSONMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var bm *bome.DB
+	if dialect == bome.MySQL {
+		bm, err = bome.New(db)
+	} else {
+		bm, err = bome.NewLite(db)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	bm.SetTablePrefix(tablePrefix)
+	bm.AddTableDefinition(deliveriesTableSchema)
+	bm.RegisterScanner(deliveryScanner, bome.NewScannerFunc(func(row bome.Row) (interface{}, error) {
+		d := new(Delivery)
+		err := row.Scan(&d.ID, &d.HookID, &d.Event, &d.Payload, &d.Status, &d.Attempts, &d.NextAttempt, &d.LastError, &d.ResponseStatus, &d.CreatedAt, &d.UpdatedAt)
+		return d, err
+	}))
+
+	err = bm.Init()
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlManager{hooks: hooks, deliveries: bm}, nil
+}
+
+type sqlManager struct {
+	hooks      *bome.JSONMap
+	deliveries *bome.DB
+}
+
+func (s *sqlManager) SaveHook(hook *Hook) error {
+	if hook.ID == "" {
+		hook.ID = uuid.New().String()
+
+	} else if hook.Secret == "" || hook.CreatedAt == 0 {
+		stored, err := s.GetHook(hook.ID)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+
+		if stored != nil {
+			if hook.Secret == "" {
+				hook.Secret = stored.Secret
+			}
+			if hook.CreatedAt == 0 {
+				hook.CreatedAt = stored.CreatedAt
+			}
+		}
+	}
+
+	if hook.CreatedAt == 0 {
+		hook.CreatedAt = utime.Now()
+	}
+
+	data, err := json.Marshal(hook)
+	if err != nil {
+		return err
+	}
+
+	return s.hooks.Upsert(&bome.MapEntry{
+		Key:   hook.ID,
+		Value: string(data),
+	})
+}
+
+func (s *sqlManager) GetHook(id string) (*Hook, error) {
+	strEncoded, err := s.hooks.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var hook *Hook
+	err = json.NewDecoder(bytes.NewBufferString(strEncoded)).Decode(&hook)
+	return hook, err
+}
+
+func (s *sqlManager) ListHooks() ([]*Hook, error) {
+	c, err := s.hooks.List()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if cer := c.Close(); cer != nil {
+			logs.Error("webhooks: hooks list cursor close", logs.Err(cer))
+		}
+	}()
+
+	var hooks []*Hook
+	for c.HasNext() {
+		o, err := c.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		entry := o.(*bome.MapEntry)
+		var hook *Hook
+		err = json.NewDecoder(bytes.NewBufferString(entry.Value)).Decode(&hook)
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, hook)
+	}
+	return hooks, nil
+}
+
+func (s *sqlManager) DeleteHook(id string) error {
+	err := s.hooks.Delete(id)
+	if err != nil {
+		return err
+	}
+	return s.deliveries.Exec(queryDeleteDeliveries, id).Error
+}
+
+func (s *sqlManager) Enqueue(event *Event) error {
+	hooks, err := s.ListHooks()
+	if err != nil {
+		return err
+	}
+
+	var payload string
+	for _, hook := range hooks {
+		if !hook.Matches(event) {
+			continue
+		}
+
+		if payload == "" {
+			payload, err = encodeEvent(event)
+			if err != nil {
+				return err
+			}
+		}
+
+		now := utime.Now()
+		err = s.deliveries.Exec(queryInsertDelivery,
+			uuid.New().String(), hook.ID, event.Type, payload, DeliveryPending, 0, now, "", 0, now, now).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sqlManager) NextDeliveries(now int64, count int) ([]*Delivery, error) {
+	c, err := s.deliveries.Query(queryDueDeliveries, deliveryScanner, DeliveryPending, now, count)
+	if err != nil {
+		return nil, err
+	}
+	return s.readDeliveries(c)
+}
+
+func (s *sqlManager) UpdateDelivery(d *Delivery) error {
+	d.UpdatedAt = utime.Now()
+	return s.deliveries.Exec(queryUpdateDelivery, d.Status, d.Attempts, d.NextAttempt, d.LastError, d.ResponseStatus, d.UpdatedAt, d.ID).Error
+}
+
+func (s *sqlManager) GetDelivery(id string) (*Delivery, error) {
+	o, err := s.deliveries.QueryFirst(queryGetDelivery, deliveryScanner, id)
+	if err != nil {
+		return nil, err
+	}
+	return o.(*Delivery), nil
+}
+
+func (s *sqlManager) ListDeliveries(opts DeliveriesOptions) ([]*Delivery, error) {
+	var (
+		conditions []string
+		params     []interface{}
+	)
+
+	if opts.HookID != "" {
+		conditions = append(conditions, "hook_id=?")
+		params = append(params, opts.HookID)
+	}
+
+	if opts.Status != "" {
+		conditions = append(conditions, "status=?")
+		params = append(params, opts.Status)
+	}
+
+	query := "select * from $prefix$_deliveries"
+	if len(conditions) > 0 {
+		query += " where " + strings.Join(conditions, " and ")
+	}
+
+	if opts.Count <= 0 {
+		opts.Count = 100
+	}
+
+	if opts.Offset < 0 {
+		return nil, errors.BadRequest("offset must be positive", errors.Details{Key: "offset", Value: opts.Offset})
+	}
+
+	query += " order by created_at desc limit ? offset ?;"
+	params = append(params, opts.Count, opts.Offset)
+
+	c, err := s.deliveries.Query(query, deliveryScanner, params...)
+	if err != nil {
+		return nil, err
+	}
+	return s.readDeliveries(c)
+}
+
+func (s *sqlManager) readDeliveries(c bome.Cursor) ([]*Delivery, error) {
+	defer func() {
+		if cer := c.Close(); cer != nil {
+			logs.Error("webhooks: deliveries cursor close", logs.Err(cer))
+		}
+	}()
+
+	var deliveries []*Delivery
+	for c.HasNext() {
+		o, err := c.Next()
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, o.(*Delivery))
+	}
+	return deliveries, nil
+}
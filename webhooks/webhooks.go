@@ -0,0 +1,178 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/omecodes/libome/logs"
+	"github.com/omecodes/store/auth"
+	"github.com/omecodes/store/common/utime"
+	"strings"
+)
+
+const (
+	EventObjectPut    = "object.put"
+	EventObjectPatch  = "object.patch"
+	EventObjectMove   = "object.move"
+	EventObjectDelete = "object.delete"
+
+	EventFileWrite  = "file.write"
+	EventFileDelete = "file.delete"
+	EventFileMove   = "file.move"
+)
+
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryDead      = "dead"
+
+	// DeliveryCanceled marks deliveries whose hook was deleted or disabled before they were sent
+	DeliveryCanceled = "canceled"
+)
+
+// Hook is an endpoint registered by an admin to be notified of store events
+type Hook struct {
+	ID     string `json:"id,omitempty"`
+	URL    string `json:"url,omitempty"`
+	Secret string `json:"secret,omitempty"`
+
+	// Events restricts notified events. Items are event names or prefixes like "object.*". Empty means all events
+	Events []string `json:"events,omitempty"`
+
+	// Collections restricts object events to the listed collections. Empty means all collections
+	Collections []string `json:"collections,omitempty"`
+
+	// Accesses restricts file events to the listed file accesses. Empty means all accesses
+	Accesses []string `json:"accesses,omitempty"`
+
+	Disabled  bool  `json:"disabled,omitempty"`
+	CreatedAt int64 `json:"created_at,omitempty"`
+}
+
+// Event is the payload delivered to hooks
+type Event struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Time       int64  `json:"time"`
+	User       string `json:"user,omitempty"`
+	Collection string `json:"collection,omitempty"`
+	ObjectID   string `json:"object_id,omitempty"`
+	AccessID   string `json:"access_id,omitempty"`
+	Filename   string `json:"filename,omitempty"`
+	Target     string `json:"target,omitempty"`
+}
+
+// Delivery is an attempt to send an event to a hook
+type Delivery struct {
+	ID             string `json:"id"`
+	HookID         string `json:"hook_id"`
+	Event          string `json:"event"`
+	Payload        string `json:"payload"`
+	Status         string `json:"status"`
+	Attempts       int    `json:"attempts"`
+	NextAttempt    int64  `json:"next_attempt"`
+	LastError      string `json:"last_error,omitempty"`
+	ResponseStatus int    `json:"response_status,omitempty"`
+	CreatedAt      int64  `json:"created_at"`
+	UpdatedAt      int64  `json:"updated_at"`
+}
+
+type DeliveriesOptions struct {
+	HookID string
+	Status string
+	Offset int
+	Count  int
+}
+
+type Manager interface {
+	// SaveHook creates or replaces a hook. A hook replaced without secret keeps the secret it was stored with, since
+	// the secret is never sent back to clients
+	SaveHook(hook *Hook) error
+	GetHook(id string) (*Hook, error)
+	ListHooks() ([]*Hook, error)
+	DeleteHook(id string) error
+
+	// Enqueue creates a pending delivery of the event for each matching hook
+	Enqueue(event *Event) error
+
+	// NextDeliveries returns at most count pending deliveries that are due at the given time
+	NextDeliveries(now int64, count int) ([]*Delivery, error)
+	UpdateDelivery(delivery *Delivery) error
+	GetDelivery(id string) (*Delivery, error)
+	ListDeliveries(opts DeliveriesOptions) ([]*Delivery, error)
+}
+
+// Matches tells if the hook must be notified of the given event
+func (h *Hook) Matches(event *Event) bool {
+	if h.Disabled {
+		return false
+	}
+
+	if len(h.Events) > 0 {
+		matched := false
+		for _, name := range h.Events {
+			if name == event.Type || name == "*" ||
+				(strings.HasSuffix(name, ".*") && strings.HasPrefix(event.Type, strings.TrimSuffix(name, "*"))) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if event.Collection != "" && len(h.Collections) > 0 {
+		if !contains(h.Collections, event.Collection) && !(event.Type == EventObjectMove && contains(h.Collections, event.Target)) {
+			return false
+		}
+	}
+
+	if event.AccessID != "" && len(h.Accesses) > 0 && !contains(h.Accesses, event.AccessID) {
+		return false
+	}
+	return true
+}
+
+// Notify enqueues deliveries of the event to hooks using the manager resolved from context.
+// Failures are logged and never returned, so that store operations are not affected by hooks.
+func Notify(ctx context.Context, event *Event) {
+	manager := GetManager(ctx)
+	if manager == nil {
+		return
+	}
+
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
+
+	if event.Time == 0 {
+		event.Time = utime.Now()
+	}
+
+	if user := auth.Get(ctx); user != nil && event.User == "" {
+		event.User = user.Name
+	}
+
+	err := manager.Enqueue(event)
+	if err != nil {
+		logs.Error("webhooks: could not enqueue event", logs.Details("type", event.Type), logs.Err(err))
+	}
+}
+
+func encodeEvent(event *Event) (string, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}